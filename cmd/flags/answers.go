@@ -0,0 +1,78 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package flags
+
+import (
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+const (
+	AnswersFlag = "answers"
+	RecordFlag  = "record"
+)
+
+// AnswersFiles holds the values of the answers flags of a wizard command
+type AnswersFiles struct {
+	Answers string
+	Record  string
+}
+
+// AddAnswersFlags adds to [cmd] the flags to replay the wizard from an answers file,
+// and to record the answers given into a new one
+func AddAnswersFlags(cmd *cobra.Command, files *AnswersFiles) {
+	cmd.Flags().StringVar(
+		&files.Answers,
+		AnswersFlag,
+		"",
+		"answer the wizard prompts from the given YAML answers file",
+	)
+	cmd.Flags().StringVar(
+		&files.Record,
+		RecordFlag,
+		"",
+		"save all answers given to the wizard prompts into the given YAML answers file",
+	)
+}
+
+// RunWithAnswers wraps [run] so that the prompts done through [prompter] are answered
+// from the answers file given in [files], using [keys] to find each answer, and that the
+// answers given are saved into the record file once [run] succeeds.
+// Prompts not found in the answers file are still asked with the original prompter.
+func RunWithAnswers(
+	prompter *prompts.Prompter,
+	files *AnswersFiles,
+	run func(*cobra.Command, []string) error,
+	keys ...prompts.AnswerKeys,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if files.Answers == "" && files.Record == "" {
+			return run(cmd, args)
+		}
+		answersPrompter := prompts.NewAnswersPrompter(*prompter, keys...)
+		if files.Answers != "" {
+			if err := answersPrompter.LoadAnswers(files.Answers); err != nil {
+				return err
+			}
+		}
+		if files.Record != "" {
+			answersPrompter.StartRecording()
+		}
+		originalPrompter := *prompter
+		*prompter = answersPrompter
+		defer func() {
+			*prompter = originalPrompter
+		}()
+		if err := run(cmd, args); err != nil {
+			return err
+		}
+		if files.Record != "" {
+			if err := answersPrompter.SaveRecording(files.Record); err != nil {
+				return err
+			}
+			ux.Logger.PrintToUser("Answers saved to %s", files.Record)
+		}
+		return nil
+	}
+}
@@ -13,11 +13,15 @@ import (
 )
 
 const (
-	forceFlag = "force"
+	forceFlag   = "force"
+	encryptFlag = "encrypt"
+	hdFlag      = "hd"
 )
 
 var (
 	forceCreate bool
+	encryptKey  bool
+	hdKey       bool
 	filename    string
 )
 
@@ -32,23 +36,54 @@ func createKey(_ *cobra.Command, args []string) error {
 		return errors.New("key already exists. Use --" + forceFlag + " parameter to overwrite")
 	}
 
+	if hdKey && filename != "" {
+		return errors.New("--" + hdFlag + " can not be used together with --file")
+	}
+
 	if filename == "" {
 		// Create key from scratch
 		ux.Logger.PrintToUser("Generating new key...")
-		k, err := key.NewSoft(0)
+		opts := []key.SOpOption{}
+		mnemonic := ""
+		if hdKey {
+			var err error
+			mnemonic, err = key.NewMnemonic()
+			if err != nil {
+				return err
+			}
+			opts = append(opts, key.WithMnemonic(mnemonic))
+		}
+		k, err := key.NewSoft(0, opts...)
 		if err != nil {
 			return err
 		}
-		keyPath := app.GetKeyPath(keyName)
-		if err := k.Save(keyPath); err != nil {
+		if err := saveKey(k, keyName); err != nil {
 			return err
 		}
 		ux.Logger.PrintToUser("Key created")
+		if hdKey {
+			ux.Logger.PrintToUser("")
+			ux.Logger.PrintToUser("Mnemonic of the HD key. Store it safely, as it gives access to all key addresses:")
+			ux.Logger.PrintToUser(mnemonic)
+			ux.Logger.PrintToUser("")
+		}
 	} else {
 		// Load key from file
 		// TODO add validation that key is legal
 		ux.Logger.PrintToUser("Loading user key...")
-		if err := app.CopyKeyFile(filename, keyName); err != nil {
+		isEncrypted, err := key.IsEncryptedFile(filename)
+		if err != nil {
+			return err
+		}
+		if encryptKey && !isEncrypted {
+			k, err := key.LoadSoft(0, filename)
+			if err != nil {
+				return err
+			}
+			if err := saveKey(k, keyName); err != nil {
+				return err
+			}
+		} else if err := app.CopyKeyFile(filename, keyName); err != nil {
 			return err
 		}
 		keyPath := app.GetKeyPath(keyName)
@@ -63,12 +98,25 @@ func createKey(_ *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		printAddrInfos(addrInfos)
+		return printAddrInfos(addrInfos)
 	}
 
 	return nil
 }
 
+// saveKey stores [k] under [keyName], encrypting it if requested by the user
+func saveKey(k *key.SoftKey, keyName string) error {
+	keyPath := app.GetKeyPath(keyName)
+	if !encryptKey {
+		return k.Save(keyPath)
+	}
+	passphrase, err := app.GetNewKeyPassphrase(keyName)
+	if err != nil {
+		return err
+	}
+	return k.SaveEncrypted(keyPath, passphrase)
+}
+
 func newCreateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [keyName]",
@@ -81,7 +129,16 @@ The command works by generating a secp256 key and storing it with the provided k
 can use this key in other commands by providing this keyName.
 
 If you'd like to import an existing key instead of generating one from scratch, provide the
---file flag.`,
+--file flag. Both hex encoded keys and Ethereum keystore v3 JSON files are accepted.
+
+If you provide the --encrypt flag, the key is stored encrypted with a passphrase, using the
+Ethereum keystore v3 format. The passphrase is taken from the ODYSSEY_CLI_KEY_PASSPHRASE env var,
+from the file pointed to by the ODYSSEY_CLI_KEY_PASSPHRASE_FILE env var, or otherwise prompted.
+The same sources are used by all commands that need to decrypt the key.
+
+If you provide the --hd flag, the key is generated as an HD key backed by a BIP-39 mnemonic,
+from which multiple addresses can be derived (using the same derivation path as ledger devices).
+Commands that pay fees with an HD key look for funds in several derived addresses.`,
 		Args:         cobra.ExactArgs(1),
 		RunE:         createKey,
 		SilenceUsage: true,
@@ -93,6 +150,18 @@ If you'd like to import an existing key instead of generating one from scratch,
 		"",
 		"import the key from an existing key file",
 	)
+	cmd.Flags().BoolVar(
+		&encryptKey,
+		encryptFlag,
+		false,
+		"store the key encrypted with a passphrase",
+	)
+	cmd.Flags().BoolVar(
+		&hdKey,
+		hdFlag,
+		false,
+		"generate an HD key backed by a mnemonic",
+	)
 	cmd.Flags().BoolVarP(
 		&forceCreate,
 		forceFlag,
@@ -3,10 +3,12 @@
 package keycmd
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
 
 	"github.com/spf13/cobra"
 )
@@ -19,7 +21,11 @@ func newExportCmd() *cobra.Command {
 applications or import it into another instance of Odyssey-CLI.
 
 By default, the tool writes the hex encoded key to stdout. If you provide the --output
-flag, the command writes the key to a file of your choosing.`,
+flag, the command writes the key to a file of your choosing.
+
+Encrypted keys are exported as Ethereum keystore v3 JSON. If you'd like to export
+them hex encoded instead, provide the --plaintext flag. HD keys are exported as their
+mnemonic, so they can be imported back as HD keys.`,
 		Args:         cobra.ExactArgs(1),
 		RunE:         exportKey,
 		SilenceUsage: true,
@@ -32,10 +38,18 @@ flag, the command writes the key to a file of your choosing.`,
 		"",
 		"write the key to the provided file path",
 	)
+	cmd.Flags().BoolVar(
+		&exportPlaintext,
+		"plaintext",
+		false,
+		"decrypt an encrypted key and export it hex encoded, or as a mnemonic for HD keys",
+	)
 
 	return cmd
 }
 
+var exportPlaintext bool
+
 func exportKey(_ *cobra.Command, args []string) error {
 	keyName := args[0]
 
@@ -45,10 +59,30 @@ func exportKey(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	if exportPlaintext && key.IsEncrypted(keyBytes) {
+		k, err := app.LoadKey(0, keyPath)
+		if err != nil {
+			return err
+		}
+		keyBytes = []byte(hex.EncodeToString(k.Raw()))
+		if k.IsHD() {
+			keyBytes = []byte(k.Mnemonic())
+		}
+	}
+
 	if filename == "" {
 		fmt.Println(string(keyBytes))
 		return nil
 	}
 
-	return os.WriteFile(filename, keyBytes, constants.WriteReadReadPerms)
+	perms := os.FileMode(constants.WriteReadReadPerms)
+	if !key.IsEncrypted(keyBytes) {
+		// a plaintext key must only be readable by the user
+		perms = constants.WriteReadUserOnlyPerms
+	}
+	// os.WriteFile keeps the permissions of an existing file
+	if err := os.Chmod(filename, perms); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.WriteFile(filename, keyBytes, perms)
 }
@@ -0,0 +1,331 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package keycmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	mnemonicSource   = "Mnemonic"
+	keystoreSource   = "Keystore JSON file"
+	privateKeySource = "Private key (CB58 or hex)"
+
+	importSourcePrompt  = "Which kind of key do you want to import?"
+	importNetworkPrompt = "Network of the expected addresses"
+)
+
+var (
+	importMnemonic       string
+	importDerivationPath string
+	importKeystoreFile   string
+	importPrivateKey     string
+	expectedOAddress     string
+	expectedAAddress     string
+	expectedDAddress     string
+
+	errMutuallyExclusiveImportSources = errors.New("--mnemonic, --keystore and --private-key are mutually exclusive")
+)
+
+// odyssey key import
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keyName]",
+		Short: "Import a signing key",
+		Long: `The key import command imports an existing private key and stores it with the provided
+keyName, so it can be used in other commands.
+
+The key can be given as a BIP-39 mnemonic (using the --derivation-path flag to select the
+address, by default the first Odyssey wallet address), as an Ethereum keystore v3 JSON file,
+or as a private key either CB58 encoded with the PrivateKey- prefix or hex encoded.
+If no source flag is given, the command prompts for it. The keystore passphrase is taken
+from the ODYSSEY_CLI_KEY_PASSPHRASE env var, from the file pointed to by the
+ODYSSEY_CLI_KEY_PASSPHRASE_FILE env var, or otherwise prompted.
+
+If you provide the --hd flag together with a mnemonic, the whole HD wallet is imported
+instead of a single derived key, so that multiple addresses can be used from it.
+
+To make sure the right key is imported, you can provide the addresses the key is
+expected to control with the --o-address, --a-address and --d-address flags. The command
+fails if any of them does not match. The O-Chain and A-Chain addresses must belong to the
+network given with --local, --testnet, --mainnet or --network, which is prompted for
+if none is set.`,
+		Args:         cobra.ExactArgs(1),
+		RunE:         importKey,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(
+		&importMnemonic,
+		"mnemonic",
+		"",
+		"import the key derived from the given BIP-39 mnemonic",
+	)
+	cmd.Flags().StringVar(
+		&importDerivationPath,
+		"derivation-path",
+		key.DefaultDerivationPath,
+		"BIP-32 derivation path to use with --mnemonic",
+	)
+	cmd.Flags().StringVar(
+		&importKeystoreFile,
+		"keystore",
+		"",
+		"import the key from the given Ethereum keystore v3 JSON file",
+	)
+	cmd.Flags().StringVar(
+		&importPrivateKey,
+		"private-key",
+		"",
+		"import the given private key (CB58 with PrivateKey- prefix, or hex)",
+	)
+	cmd.Flags().StringVar(
+		&expectedOAddress,
+		"o-address",
+		"",
+		"fail if the key does not control the given O-Chain address",
+	)
+	cmd.Flags().StringVar(
+		&expectedAAddress,
+		"a-address",
+		"",
+		"fail if the key does not control the given A-Chain address",
+	)
+	cmd.Flags().StringVar(
+		&expectedDAddress,
+		"d-address",
+		"",
+		"fail if the key does not control the given D-Chain address",
+	)
+	cmd.Flags().BoolVarP(
+		&local,
+		localFlag,
+		"l",
+		false,
+		"expect --o-address and --a-address of the local network",
+	)
+	cmd.Flags().BoolVarP(
+		&testnet,
+		testnetFlag,
+		"t",
+		false,
+		"expect --o-address and --a-address of testnet",
+	)
+	cmd.Flags().BoolVarP(
+		&mainnet,
+		mainnetFlag,
+		"m",
+		false,
+		"expect --o-address and --a-address of mainnet",
+	)
+	cmd.Flags().StringVar(
+		&networkName,
+		networkFlag,
+		"",
+		"expect --o-address and --a-address of the given network, registered with network add",
+	)
+	cmd.Flags().BoolVar(
+		&hdKey,
+		hdFlag,
+		false,
+		"import the mnemonic as an HD key",
+	)
+	cmd.Flags().BoolVar(
+		&encryptKey,
+		encryptFlag,
+		false,
+		"store the key encrypted with a passphrase",
+	)
+	cmd.Flags().BoolVarP(
+		&forceCreate,
+		forceFlag,
+		"f",
+		false,
+		"overwrite an existing key with the same name",
+	)
+	prompts.RegisterFlagHint(cmd, importSourcePrompt, "--mnemonic", "--keystore", "--private-key")
+	prompts.RegisterFlagHint(cmd, importNetworkPrompt, "--"+localFlag, "--"+testnetFlag, "--"+mainnetFlag, "--"+networkFlag)
+	return cmd
+}
+
+func importKey(_ *cobra.Command, args []string) error {
+	keyName := args[0]
+
+	if match, _ := regexp.MatchString("\\s", keyName); match {
+		return errors.New("key name contains whitespace")
+	}
+
+	if app.KeyExists(keyName) && !forceCreate {
+		return errors.New("key already exists. Use --" + forceFlag + " parameter to overwrite")
+	}
+
+	if !flags.EnsureMutuallyExclusive([]bool{importMnemonic != "", importKeystoreFile != "", importPrivateKey != ""}) {
+		return errMutuallyExclusiveImportSources
+	}
+
+	if importMnemonic == "" && importKeystoreFile == "" && importPrivateKey == "" {
+		if err := promptImportSource(); err != nil {
+			return err
+		}
+	}
+
+	if hdKey && importMnemonic == "" {
+		return errors.New("--" + hdFlag + " can only be used with a mnemonic")
+	}
+
+	if !flags.EnsureMutuallyExclusive([]bool{local, testnet, mainnet, networkName != ""}) {
+		return errors.New("--" + localFlag + ", --" + testnetFlag + ", --" + mainnetFlag + " and --" + networkFlag + " are mutually exclusive")
+	}
+
+	k, err := loadImportedKey()
+	if err != nil {
+		return err
+	}
+
+	var networkID uint32
+	if expectedOAddress != "" || expectedAAddress != "" {
+		network, err := getExpectedAddressesNetwork()
+		if err != nil {
+			return err
+		}
+		networkID = network.ID
+	}
+	if err := checkExpectedAddresses(k, networkID); err != nil {
+		return err
+	}
+
+	if err := saveKey(k, keyName); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Key imported")
+
+	networks := []models.Network{models.TestnetNetwork, models.MainnetNetwork}
+	dchain := true
+	oClients, dClients, err := getClients(networks, dchain)
+	if err != nil {
+		return err
+	}
+	addrInfos, err := getStoredKeyInfo(oClients, dClients, networks, app.GetKeyPath(keyName), dchain)
+	if err != nil {
+		return err
+	}
+	return printAddrInfos(addrInfos)
+}
+
+func promptImportSource() error {
+	source, err := app.Prompt.CaptureList(
+		importSourcePrompt,
+		[]string{mnemonicSource, keystoreSource, privateKeySource},
+	)
+	if err != nil {
+		return err
+	}
+	switch source {
+	case mnemonicSource:
+		importMnemonic, err = app.Prompt.CapturePassword("Enter mnemonic")
+	case keystoreSource:
+		importKeystoreFile, err = app.Prompt.CaptureExistingFilepath("Enter keystore file path")
+	case privateKeySource:
+		importPrivateKey, err = app.Prompt.CapturePassword("Enter private key")
+	}
+	return err
+}
+
+func loadImportedKey() (*key.SoftKey, error) {
+	switch {
+	case importMnemonic != "" && hdKey:
+		return key.NewSoft(0, key.WithMnemonic(importMnemonic))
+	case importMnemonic != "":
+		privKey, err := key.PrivateKeyFromMnemonic(importMnemonic, importDerivationPath)
+		if err != nil {
+			return nil, err
+		}
+		return key.NewSoft(0, key.WithPrivateKey(privKey))
+	case importKeystoreFile != "":
+		kb, err := os.ReadFile(importKeystoreFile)
+		if err != nil {
+			return nil, err
+		}
+		if !key.IsEncrypted(kb) {
+			return nil, fmt.Errorf("%s is not an Ethereum keystore v3 file", importKeystoreFile)
+		}
+		passphrase, err := app.GetKeystorePassphrase(importKeystoreFile)
+		if err != nil {
+			return nil, err
+		}
+		return key.LoadSoftFromKeystore(0, kb, passphrase)
+	default:
+		privKey := strings.TrimPrefix(strings.TrimSpace(importPrivateKey), "0x")
+		k, err := key.LoadSoftFromBytes(0, []byte(privKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		return k, nil
+	}
+}
+
+// getExpectedAddressesNetwork returns the network given by the network flags, or prompts for it
+func getExpectedAddressesNetwork() (models.Network, error) {
+	switch {
+	case local:
+		return models.LocalNetwork, nil
+	case testnet:
+		return models.TestnetNetwork, nil
+	case mainnet:
+		return models.MainnetNetwork, nil
+	case networkName != "":
+		return models.GetNetworkByName(networkName)
+	}
+	networkStr, err := app.Prompt.CaptureList(importNetworkPrompt, models.GetNetworkNames(promptNetworkKinds))
+	if err != nil {
+		return models.UndefinedNetwork, err
+	}
+	return models.NetworkFromString(networkStr), nil
+}
+
+// checkExpectedAddresses verifies that [k] controls the addresses given by the user, if any,
+// the O-Chain and A-Chain ones being addresses of network [networkID]
+func checkExpectedAddresses(k *key.SoftKey, networkID uint32) error {
+	shortAddr := k.Addresses()[0]
+	hrp := key.GetHRP(networkID)
+	for chain, expectedAddr := range map[string]string{"O": expectedOAddress, "A": expectedAAddress} {
+		if expectedAddr == "" {
+			continue
+		}
+		addrChain, addrHRP, addrBytes, err := address.Parse(expectedAddr)
+		if err != nil {
+			return fmt.Errorf("invalid %s-Chain address %s: %w", chain, expectedAddr, err)
+		}
+		if addrChain != chain {
+			return fmt.Errorf("address %s is not a %s-Chain address", expectedAddr, chain)
+		}
+		if addrHRP != hrp {
+			return fmt.Errorf("address %s is not an address of network %d, expected HRP %s", expectedAddr, networkID, hrp)
+		}
+		if !bytes.Equal(addrBytes, shortAddr[:]) {
+			return fmt.Errorf("imported key does not control %s-Chain address %s", chain, expectedAddr)
+		}
+	}
+	if expectedDAddress != "" {
+		if !common.IsHexAddress(expectedDAddress) {
+			return fmt.Errorf("invalid D-Chain address %s", expectedDAddress)
+		}
+		if common.HexToAddress(expectedDAddress) != common.HexToAddress(k.D()) {
+			return fmt.Errorf("imported key does not control D-Chain address %s", expectedDAddress)
+		}
+	}
+	return nil
+}
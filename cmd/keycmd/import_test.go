@@ -0,0 +1,92 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package keycmd
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckExpectedAddresses(t *testing.T) {
+	k, err := key.NewSoft(0)
+	require.NoError(t, err)
+	otherKey, err := key.NewSoft(0)
+	require.NoError(t, err)
+	formatAddr := func(chain string, hrp string, k *key.SoftKey) string {
+		addr, err := address.Format(chain, hrp, k.Addresses()[0][:])
+		require.NoError(t, err)
+		return addr
+	}
+
+	tests := []struct {
+		name       string
+		oAddress   string
+		aAddress   string
+		dAddress   string
+		networkID  uint32
+		errMessage string
+	}{
+		{
+			name: "no expected addresses",
+		},
+		{
+			name:      "matching addresses",
+			oAddress:  formatAddr("O", constants.MainnetHRP, k),
+			aAddress:  formatAddr("A", constants.MainnetHRP, k),
+			dAddress:  k.D(),
+			networkID: constants.MainnetID,
+		},
+		{
+			name:      "matching testnet address",
+			oAddress:  formatAddr("O", constants.TestnetHRP, k),
+			networkID: constants.TestnetID,
+		},
+		{
+			name:       "address of another network",
+			oAddress:   formatAddr("O", constants.TestnetHRP, k),
+			networkID:  constants.MainnetID,
+			errMessage: "is not an address of network 1",
+		},
+		{
+			name:       "address of another chain",
+			aAddress:   formatAddr("O", constants.MainnetHRP, k),
+			networkID:  constants.MainnetID,
+			errMessage: "is not a A-Chain address",
+		},
+		{
+			name:       "address of another key",
+			oAddress:   formatAddr("O", constants.MainnetHRP, otherKey),
+			networkID:  constants.MainnetID,
+			errMessage: "imported key does not control O-Chain address",
+		},
+		{
+			name:       "D-Chain address of another key",
+			dAddress:   otherKey.D(),
+			errMessage: "imported key does not control D-Chain address",
+		},
+		{
+			name:       "invalid address",
+			oAddress:   "O-invalid",
+			networkID:  constants.MainnetID,
+			errMessage: "invalid O-Chain address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectedOAddress, expectedAAddress, expectedDAddress = tt.oAddress, tt.aAddress, tt.dAddress
+			t.Cleanup(func() {
+				expectedOAddress, expectedAAddress, expectedDAddress = "", "", ""
+			})
+			err := checkExpectedAddresses(k, tt.networkID)
+			if tt.errMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errMessage)
+			}
+		})
+	}
+}
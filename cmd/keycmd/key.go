@@ -34,6 +34,9 @@ To get started, use the key create command.`,
 	// odyssey key create
 	cmd.AddCommand(newCreateCmd())
 
+	// odyssey key import
+	cmd.AddCommand(newImportCmd())
+
 	// odyssey key list
 	cmd.AddCommand(newListCmd())
 
@@ -13,7 +13,9 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/key"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
 	ledger "github.com/DioneProtocol/odysseygo/utils/crypto/ledger"
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
@@ -29,9 +31,13 @@ const (
 	testnetFlag       = "testnet"
 	mainnetFlag       = "mainnet"
 	allFlag           = "all-networks"
+	networkFlag       = "network"
 	dchainFlag        = "dchain"
 	ledgerIndicesFlag = "ledger"
+	keyIndicesFlag    = "indices"
 	useNanoDioneFlag  = "use-nano-dione"
+
+	listNetworkPrompt = "Choose network for which to list addresses"
 )
 
 var (
@@ -39,9 +45,14 @@ var (
 	testnet       bool
 	mainnet       bool
 	all           bool
+	networkName   string
 	dchain        bool
 	useNanoDione  bool
 	ledgerIndices []uint
+	keyIndices    []uint
+
+	// promptNetworkKinds are the networks to prompt for, registered ones last
+	promptNetworkKinds = []models.NetworkKind{models.Mainnet, models.Testnet, models.Local, models.Custom}
 )
 
 // odyssey subnet list
@@ -50,7 +61,10 @@ func newListCmd() *cobra.Command {
 		Use:   "list",
 		Short: "List stored signing keys or ledger addresses",
 		Long: `The key list command prints information for all stored signing
-keys or for the ledger addresses associated to certain indices.`,
+keys or for the ledger addresses associated to certain indices.
+
+For stored HD keys, the addresses of index 0 are listed by default. Use the
+--indices flag to list the addresses of other derived indices.`,
 		RunE:         listKeys,
 		SilenceUsage: true,
 	}
@@ -82,6 +96,12 @@ keys or for the ledger addresses associated to certain indices.`,
 		false,
 		"list all network addresses",
 	)
+	cmd.Flags().StringVar(
+		&networkName,
+		networkFlag,
+		"",
+		"list addresses of the given network, registered with network add",
+	)
 	cmd.Flags().BoolVarP(
 		&dchain,
 		dchainFlag,
@@ -103,6 +123,17 @@ keys or for the ledger addresses associated to certain indices.`,
 		[]uint{},
 		"list ledger addresses for the given indices",
 	)
+	cmd.Flags().UintSliceVar(
+		&keyIndices,
+		keyIndicesFlag,
+		[]uint{},
+		"list addresses for the given indices of stored HD keys",
+	)
+	prompts.RegisterFlagHint(
+		cmd,
+		listNetworkPrompt,
+		"--"+localFlag, "--"+testnetFlag, "--"+mainnetFlag, "--"+allFlag, "--"+networkFlag,
+	)
 	return cmd
 }
 
@@ -126,13 +157,15 @@ func getClients(networks []models.Network, dchain bool) (
 	return oClients, dClients, nil
 }
 
+// addressInfo is the --output json|yaml schema of key list.
+// Balance is given in DIONE, or in nDIONE if --nano is set.
 type addressInfo struct {
-	kind    string
-	name    string
-	chain   string
-	address string
-	balance string
-	network string
+	Kind    string `json:"kind" yaml:"kind"`
+	Name    string `json:"name" yaml:"name"`
+	Chain   string `json:"chain" yaml:"chain"`
+	Address string `json:"address" yaml:"address"`
+	Balance string `json:"balance" yaml:"balance"`
+	Network string `json:"network" yaml:"network"`
 }
 
 func listKeys(*cobra.Command, []string) error {
@@ -147,12 +180,21 @@ func listKeys(*cobra.Command, []string) error {
 	if mainnet || all {
 		networks = append(networks, models.MainnetNetwork)
 	}
+	if all {
+		for _, customNetworkName := range models.GetCustomNetworkNames() {
+			customNetwork, _ := models.GetCustomNetwork(customNetworkName)
+			networks = append(networks, customNetwork.Network())
+		}
+	} else if networkName != "" {
+		network, err := models.GetNetworkByName(networkName)
+		if err != nil {
+			return err
+		}
+		networks = append(networks, network)
+	}
 	if len(networks) == 0 {
 		// no flag was set, prompt user
-		networkStr, err := app.Prompt.CaptureList(
-			"Choose network for which to list addresses",
-			[]string{models.Mainnet.String(), models.Testnet.String(), models.Local.String()},
-		)
+		networkStr, err := app.Prompt.CaptureList(listNetworkPrompt, models.GetNetworkNames(promptNetworkKinds))
 		if err != nil {
 			return err
 		}
@@ -182,8 +224,7 @@ func listKeys(*cobra.Command, []string) error {
 			return err
 		}
 	}
-	printAddrInfos(addrInfos)
-	return nil
+	return printAddrInfos(addrInfos)
 }
 
 func getStoredKeysInfo(
@@ -223,30 +264,70 @@ func getStoredKeyInfo(
 	addrInfos := []addressInfo{}
 	for _, network := range networks {
 		keyName := strings.TrimSuffix(filepath.Base(keyPath), constants.KeySuffix)
-		sk, err := key.LoadSoft(network.ID, keyPath)
+		sk, err := app.LoadKey(network.ID, keyPath)
 		if err != nil {
 			return nil, err
 		}
-		if dchain {
-			dChainAddr := sk.D()
-			addrInfo, err := getDChainAddrInfo(dClients, network, dChainAddr, "stored", keyName)
+		kind := "stored"
+		if sk.IsHD() {
+			kind = "stored HD"
+		}
+		if isEncrypted, err := key.IsEncryptedFile(keyPath); err == nil && isEncrypted {
+			kind += " (encrypted)"
+		}
+		if !sk.IsHD() || len(keyIndices) == 0 {
+			keyAddrInfos, err := getSoftKeyInfo(oClients, dClients, network, sk, kind, keyName, dchain)
 			if err != nil {
 				return nil, err
 			}
-			addrInfos = append(addrInfos, addrInfo)
+			addrInfos = append(addrInfos, keyAddrInfos...)
+			continue
 		}
-		oChainAddrs := sk.O()
-		for _, oChainAddr := range oChainAddrs {
-			addrInfo, err := getOChainAddrInfo(oClients, network, oChainAddr, "stored", keyName)
+		for _, index := range keyIndices {
+			indexKey, err := sk.DeriveIndex(network.ID, uint32(index))
 			if err != nil {
 				return nil, err
 			}
-			addrInfos = append(addrInfos, addrInfo)
+			indexName := fmt.Sprintf("%s (index %d)", keyName, index)
+			keyAddrInfos, err := getSoftKeyInfo(oClients, dClients, network, indexKey, kind, indexName, dchain)
+			if err != nil {
+				return nil, err
+			}
+			addrInfos = append(addrInfos, keyAddrInfos...)
 		}
 	}
 	return addrInfos, nil
 }
 
+func getSoftKeyInfo(
+	oClients map[models.Network]omegavm.Client,
+	dClients map[models.Network]ethclient.Client,
+	network models.Network,
+	sk *key.SoftKey,
+	kind string,
+	name string,
+	dchain bool,
+) ([]addressInfo, error) {
+	addrInfos := []addressInfo{}
+	if dchain {
+		dChainAddr := sk.D()
+		addrInfo, err := getDChainAddrInfo(dClients, network, dChainAddr, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		addrInfos = append(addrInfos, addrInfo)
+	}
+	oChainAddrs := sk.O()
+	for _, oChainAddr := range oChainAddrs {
+		addrInfo, err := getOChainAddrInfo(oClients, network, oChainAddr, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		addrInfos = append(addrInfos, addrInfo)
+	}
+	return addrInfos, nil
+}
+
 func getLedgerIndicesInfo(
 	oClients map[models.Network]omegavm.Client,
 	ledgerIndices []uint32,
@@ -317,12 +398,12 @@ func getOChainAddrInfo(
 		}
 	}
 	return addressInfo{
-		kind:    kind,
-		name:    name,
-		chain:   "O-Chain (Bech32 format)",
-		address: oChainAddr,
-		balance: balance,
-		network: network.Name(),
+		Kind:    kind,
+		Name:    name,
+		Chain:   "O-Chain (Bech32 format)",
+		Address: oChainAddr,
+		Balance: balance,
+		Network: network.Name(),
 	}, nil
 }
 
@@ -341,16 +422,16 @@ func getDChainAddrInfo(
 		}
 	}
 	return addressInfo{
-		kind:    kind,
-		name:    name,
-		chain:   "D-Chain (Ethereum hex format)",
-		address: dChainAddr,
-		balance: dChainBalance,
-		network: network.Name(),
+		Kind:    kind,
+		Name:    name,
+		Chain:   "D-Chain (Ethereum hex format)",
+		Address: dChainAddr,
+		Balance: dChainBalance,
+		Network: network.Name(),
 	}, nil
 }
 
-func printAddrInfos(addrInfos []addressInfo) {
+func printAddrInfos(addrInfos []addressInfo) error {
 	header := []string{"Kind", "Name", "Chain", "Address", "Balance", "Network"}
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader(header)
@@ -358,15 +439,15 @@ func printAddrInfos(addrInfos []addressInfo) {
 	table.SetAutoMergeCellsByColumnIndex([]int{0, 1, 2})
 	for _, addrInfo := range addrInfos {
 		table.Append([]string{
-			addrInfo.kind,
-			addrInfo.name,
-			addrInfo.chain,
-			addrInfo.address,
-			addrInfo.balance,
-			addrInfo.network,
+			addrInfo.Kind,
+			addrInfo.Name,
+			addrInfo.Chain,
+			addrInfo.Address,
+			addrInfo.Balance,
+			addrInfo.Network,
 		})
 	}
-	table.Render()
+	return ux.Render(addrInfos, table.Render)
 }
 
 func getDChainBalanceStr(dClient ethclient.Client, addrStr string) (string, error) {
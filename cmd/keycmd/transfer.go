@@ -5,12 +5,15 @@ package keycmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	clikeychain "github.com/DioneProtocol/odyssey-cli/pkg/keychain"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
+	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -38,6 +41,13 @@ const (
 	amountFlag              = "amount"
 	wrongLedgerIndexVal     = 32768
 	receiveRecoveryStepFlag = "receive-recovery-step"
+	outputTxPathFlag        = "output-tx-path"
+
+	transferNetworkPrompt     = "Network to use"
+	transferStepPrompt        = "Step of the transfer"
+	transferLedgerIndexPrompt = "Ledger index to use"
+	transferReceiverPrompt    = "Receiver address"
+	transferConfirmPrompt     = "Confirm transfer"
 )
 
 var (
@@ -49,6 +59,7 @@ var (
 	receiverAddrStr     string
 	amountFlt           float64
 	receiveRecoveryStep uint64
+	outputTxPath        string
 )
 
 func newTransferCmd() *cobra.Command {
@@ -88,6 +99,12 @@ func newTransferCmd() *cobra.Command {
 		false,
 		"transfer between mainnet addresses",
 	)
+	cmd.Flags().StringVar(
+		&networkName,
+		networkFlag,
+		"",
+		"transfer between addresses of the given network, registered with network add",
+	)
 	cmd.Flags().BoolVarP(
 		&send,
 		sendFlag,
@@ -137,6 +154,21 @@ func newTransferCmd() *cobra.Command {
 		0,
 		"amount to send or receive (DIONE units)",
 	)
+	cmd.Flags().StringVar(
+		&outputTxPath,
+		outputTxPathFlag,
+		"",
+		"file path to save the O-Chain tx of the step (export on send, import on the last receive step) "+
+			"to be signed and committed later, instead of issuing it",
+	)
+	prompts.RegisterFlagHint(cmd, transferNetworkPrompt, "--"+localFlag, "--"+testnetFlag, "--"+mainnetFlag, "--"+networkFlag)
+	prompts.RegisterFlagHint(cmd, transferStepPrompt, "--"+sendFlag, "--"+receiveFlag)
+	prompts.RegisterFlagHint(cmd, "Which key source should be used to", "--"+keyNameFlag, "--"+ledgerIndexFlag)
+	prompts.RegisterFlagHint(cmd, "Which stored key should be used to", "--"+keyNameFlag)
+	prompts.RegisterFlagHint(cmd, transferLedgerIndexPrompt, "--"+ledgerIndexFlag)
+	prompts.RegisterFlagHint(cmd, "Amount to", "--"+amountFlag)
+	prompts.RegisterFlagHint(cmd, transferReceiverPrompt, "--"+receiverAddrFlag)
+	prompts.RegisterFlagHint(cmd, transferConfirmPrompt, "--"+forceFlag)
 	return cmd
 }
 
@@ -149,6 +181,10 @@ func transferF(*cobra.Command, []string) error {
 		return fmt.Errorf("only one between a keyname or a ledger index must be given")
 	}
 
+	if outputTxPath != "" && utils.FileExists(outputTxPath) {
+		return fmt.Errorf("outputTxPath %q already exists", outputTxPath)
+	}
+
 	var network models.Network
 	switch {
 	case local:
@@ -157,11 +193,14 @@ func transferF(*cobra.Command, []string) error {
 		network = models.TestnetNetwork
 	case mainnet:
 		network = models.MainnetNetwork
+	case networkName != "":
+		var err error
+		network, err = models.GetNetworkByName(networkName)
+		if err != nil {
+			return err
+		}
 	default:
-		networkStr, err := app.Prompt.CaptureList(
-			"Network to use",
-			[]string{models.Mainnet.String(), models.Testnet.String(), models.Local.String()},
-		)
+		networkStr, err := app.Prompt.CaptureList(transferNetworkPrompt, models.GetNetworkNames(promptNetworkKinds))
 		if err != nil {
 			return err
 		}
@@ -172,7 +211,7 @@ func transferF(*cobra.Command, []string) error {
 
 	if !send && !receive {
 		option, err := app.Prompt.CaptureList(
-			"Step of the transfer",
+			transferStepPrompt,
 			[]string{"Send", "Receive"},
 		)
 		if err != nil {
@@ -198,7 +237,7 @@ func transferF(*cobra.Command, []string) error {
 			return err
 		}
 		if useLedger {
-			ledgerIndex, err = app.Prompt.CaptureUint32("Ledger index to use")
+			ledgerIndex, err = app.Prompt.CaptureUint32(transferLedgerIndexPrompt)
 			if err != nil {
 				return err
 			}
@@ -226,13 +265,23 @@ func transferF(*cobra.Command, []string) error {
 
 	fee := network.GenesisParams().TxFee
 
-	var kc keychain.Keychain
+	var (
+		kc          keychain.Keychain
+		senderAddrs []ids.ShortID
+	)
 	if keyName != "" {
 		keyPath := app.GetKeyPath(keyName)
-		sk, err := key.LoadSoft(network.ID, keyPath)
+		sk, err := app.LoadKey(network.ID, keyPath)
 		if err != nil {
 			return err
 		}
+		if send && sk.IsHD() {
+			// spend from as many derived addresses as needed
+			if err := clikeychain.AddFundedSoftKeyIndices(network, sk, amount+4*fee); err != nil {
+				return err
+			}
+			senderAddrs = sk.Addresses()
+		}
 		kc = sk.KeyChain()
 	} else {
 		ledgerDevice, err := ledger.New()
@@ -249,7 +298,7 @@ func transferF(*cobra.Command, []string) error {
 	var receiverAddr ids.ShortID
 	if send {
 		if receiverAddrStr == "" {
-			receiverAddrStr, err = app.Prompt.CaptureOChainAddress("Receiver address", network)
+			receiverAddrStr, err = app.Prompt.CaptureOChainAddress(transferReceiverPrompt, network)
 			if err != nil {
 				return err
 			}
@@ -269,14 +318,21 @@ func transferF(*cobra.Command, []string) error {
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("this operation is going to:")
 	if send {
-		addr := kc.Addresses().List()[0]
-		addrStr, err := address.Format("O", key.GetHRP(network.ID), addr[:])
-		if err != nil {
-			return err
+		if len(senderAddrs) == 0 {
+			senderAddrs = kc.Addresses().List()
 		}
-		if addr == receiverAddr {
-			return fmt.Errorf("sender addr is the same as receiver addr")
+		addrStrs := []string{}
+		for _, addr := range senderAddrs {
+			addrStr, err := address.Format("O", key.GetHRP(network.ID), addr[:])
+			if err != nil {
+				return err
+			}
+			if addr == receiverAddr {
+				return fmt.Errorf("sender addr is the same as receiver addr")
+			}
+			addrStrs = append(addrStrs, addrStr)
 		}
+		addrStr := strings.Join(addrStrs, ", ")
 		ux.Logger.PrintToUser("- send %.9f DIONE from %s to target address %s", float64(amount)/float64(units.Dione), addrStr, receiverAddrStr)
 		ux.Logger.PrintToUser("- take a fee of %.9f DIONE from source address %s", float64(4*fee)/float64(units.Dione), addrStr)
 	} else {
@@ -285,8 +341,7 @@ func transferF(*cobra.Command, []string) error {
 	ux.Logger.PrintToUser("")
 
 	if !force {
-		confStr := "Confirm transfer"
-		conf, err := app.Prompt.CaptureNoYes(confStr)
+		conf, err := app.Prompt.CaptureNoYes(transferConfirmPrompt)
 		if err != nil {
 			return err
 		}
@@ -337,6 +392,9 @@ func transferF(*cobra.Command, []string) error {
 		if err := wallet.O().Signer().Sign(context.Background(), &tx); err != nil {
 			return fmt.Errorf("error signing tx: %w", err)
 		}
+		if outputTxPath != "" {
+			return txutils.SaveNotFullySignedInputsTx(app, "Export", &tx, outputTxPath, false)
+		}
 
 		ctx, cancel := utils.GetAPIContext()
 		defer cancel()
@@ -445,6 +503,19 @@ func transferF(*cobra.Command, []string) error {
 				ux.Logger.PrintToUser(logging.LightRed.Wrap(fmt.Sprintf("ERROR: restart from this step by using the same command with extra arguments: --%s %d", receiveRecoveryStepFlag, receiveRecoveryStep)))
 				return err
 			}
+			if outputTxPath != "" {
+				tx, err := subnet.CreateOFromAImportTx(
+					wallet,
+					ledgerIndex != wrongLedgerIndexVal,
+					true,
+					&to,
+				)
+				if err != nil {
+					ux.Logger.PrintToUser(logging.LightRed.Wrap(fmt.Sprintf("ERROR: restart from this step by using the same command with extra arguments: --%s %d", receiveRecoveryStepFlag, receiveRecoveryStep)))
+					return err
+				}
+				return txutils.SaveNotFullySignedInputsTx(app, "Import", tx, outputTxPath, false)
+			}
 			ux.Logger.PrintToUser("Issuing ImportTx A -> O")
 			_, err = subnet.IssueOFromAImportTx(
 				wallet,
@@ -0,0 +1,177 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	odygoconstants "github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	networkID             uint32
+	networkEndpoint       string
+	networkDChainEndpoint string
+	networkHRP            string
+	genesisParamsPreset   string
+	genesisParamsFile     string
+
+	networkNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+	// genesisParamsPresets are the genesis params that user-defined networks can be based on
+	genesisParamsPresets = map[string]genesis.Params{
+		"local":   genesis.LocalParams,
+		"testnet": genesis.TestnetParams,
+		"mainnet": genesis.MainnetParams,
+	}
+)
+
+// odyssey network add
+func newAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [networkName]",
+		Short: "Register a private Odyssey network",
+		Long: `The network add command registers a private Odyssey network, so that it can be
+targeted by name with the --network flag of subnet deploy, join, addValidator and stats,
+and of key list and transfer.
+
+The network is defined by its network ID and API endpoint. The D-Chain RPC endpoint is
+derived from the API endpoint unless --dchain-endpoint is given. Addresses on the network
+use the --hrp prefix. Fees and staking amounts are taken from the genesis params of
+--genesis-params, or from the JSON file of --genesis-params-file.`,
+		RunE:         addNetwork,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint32Var(&networkID, "network-id", 0, "network ID of the network")
+	cmd.Flags().StringVar(&networkEndpoint, "endpoint", "", "API endpoint of the network nodes (ex: https://api.example.com)")
+	cmd.Flags().StringVar(&networkDChainEndpoint, "dchain-endpoint", "", "RPC endpoint of the D-Chain")
+	cmd.Flags().StringVar(&networkHRP, "hrp", odygoconstants.FallbackHRP, "human readable prefix of the network addresses")
+	cmd.Flags().StringVar(&genesisParamsPreset, "genesis-params", "local", fmt.Sprintf("use the genesis params of %s", strings.Join(getGenesisParamsPresetNames(), ", ")))
+	cmd.Flags().StringVar(&genesisParamsFile, "genesis-params-file", "", "file with the genesis params of the network, in JSON")
+	return cmd
+}
+
+func addNetwork(_ *cobra.Command, args []string) error {
+	networkName := args[0]
+	customNetworksConfig, err := app.LoadCustomNetworksConfig()
+	if err != nil {
+		return err
+	}
+	if err := validateNetworkName(networkName); err != nil {
+		return err
+	}
+	if err := validateNetworkID(networkID); err != nil {
+		return err
+	}
+	if err := validateEndpoint(networkEndpoint); err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if networkDChainEndpoint != "" {
+		if err := validateEndpoint(networkDChainEndpoint); err != nil {
+			return fmt.Errorf("invalid D-Chain endpoint: %w", err)
+		}
+	}
+	if networkHRP == "" {
+		return errors.New("the HRP of the network can't be empty")
+	}
+	genesisParams, err := getGenesisParams(genesisParamsPreset, genesisParamsFile)
+	if err != nil {
+		return err
+	}
+	customNetworksConfig.Networks[networkName] = models.CustomNetwork{
+		Name:           networkName,
+		NetworkID:      networkID,
+		Endpoint:       strings.TrimSuffix(networkEndpoint, "/"),
+		DChainEndpoint: networkDChainEndpoint,
+		HRP:            networkHRP,
+		GenesisParams:  genesisParams,
+	}
+	if err := app.WriteCustomNetworksConfig(customNetworksConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Network %s added. Use --network %s to target it", networkName, networkName)
+	return nil
+}
+
+// validateNetworkName checks that [networkName] is a valid name not used by other networks
+func validateNetworkName(networkName string) error {
+	if !networkNameRegexp.MatchString(networkName) {
+		return fmt.Errorf("invalid network name %q: only letters, digits, '-' and '_' are allowed", networkName)
+	}
+	for _, kind := range []models.NetworkKind{models.Mainnet, models.Testnet, models.Local, models.Devnet} {
+		if strings.EqualFold(networkName, kind.String()) || strings.EqualFold(networkName, strings.Fields(kind.String())[0]) {
+			return fmt.Errorf("network name %s is reserved for %s", networkName, kind)
+		}
+	}
+	if _, ok := models.GetCustomNetwork(networkName); ok {
+		return fmt.Errorf("network %s already exists", networkName)
+	}
+	return nil
+}
+
+// validateNetworkID checks that [networkID] is not used by other networks
+func validateNetworkID(networkID uint32) error {
+	if networkID == 0 {
+		return errors.New("the network ID is required and can't be 0")
+	}
+	if _, ok := odygoconstants.NetworkIDToHRP[networkID]; ok {
+		return fmt.Errorf("network ID %d is reserved for %s", networkID, odygoconstants.NetworkName(networkID))
+	}
+	if network := models.NetworkFromNetworkID(networkID); network.Kind != models.Undefined {
+		return fmt.Errorf("network ID %d is already used by %s", networkID, network.Name())
+	}
+	return nil
+}
+
+func validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("the endpoint is required")
+	}
+	endpointURL, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return err
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q in %s", endpointURL.Scheme, endpoint)
+	}
+	return nil
+}
+
+// getGenesisParams returns the genesis params of [preset], or the ones in [paramsFile] if given
+func getGenesisParams(preset string, paramsFile string) (genesis.Params, error) {
+	if paramsFile != "" {
+		paramsBytes, err := os.ReadFile(paramsFile)
+		if err != nil {
+			return genesis.Params{}, err
+		}
+		var params genesis.Params
+		if err := json.Unmarshal(paramsBytes, &params); err != nil {
+			return genesis.Params{}, fmt.Errorf("invalid genesis params file %s: %w", paramsFile, err)
+		}
+		return params, nil
+	}
+	params, ok := genesisParamsPresets[preset]
+	if !ok {
+		return genesis.Params{}, fmt.Errorf("unknown genesis params %q: use one of %s", preset, strings.Join(getGenesisParamsPresetNames(), ", "))
+	}
+	return params, nil
+}
+
+func getGenesisParamsPresetNames() []string {
+	names := maps.Keys(genesisParamsPresets)
+	slices.Sort(names)
+	return names
+}
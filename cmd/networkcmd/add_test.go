@@ -0,0 +1,166 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+const testNetworkName = "private"
+
+func setupNetworksTest(t *testing.T) {
+	app = testutils.SetupTestInTempDir(t)
+	ux.NewUserLog(logging.NoLog{}, os.Stdout)
+	t.Cleanup(func() { models.SetCustomNetworks(nil) })
+	networkID = 4242
+	networkEndpoint = "http://10.0.0.1:9650/"
+	networkDChainEndpoint = ""
+	networkHRP = "private"
+	genesisParamsPreset = "testnet"
+	genesisParamsFile = ""
+	forceRemove = false
+}
+
+func TestAddNetwork(t *testing.T) {
+	require := require.New(t)
+	setupNetworksTest(t)
+
+	require.NoError(addNetwork(nil, []string{testNetworkName}))
+
+	// the registry is persisted and known to the network lookups
+	models.SetCustomNetworks(nil)
+	customNetworksConfig, err := app.LoadCustomNetworksConfig()
+	require.NoError(err)
+	require.Contains(customNetworksConfig.Networks, testNetworkName)
+	network := models.NetworkFromString(testNetworkName)
+	require.Equal(models.Custom, network.Kind)
+	require.Equal(uint32(4242), network.ID)
+	require.Equal("http://10.0.0.1:9650", network.Endpoint)
+	require.Equal(testNetworkName, network.Name())
+	require.Equal("http://10.0.0.1:9650/ext/bc/D/rpc", network.DChainEndpoint())
+	require.Equal("network-4242", network.NetworkIDFlagValue())
+	require.Equal(genesis.TestnetParams, *network.GenesisParams())
+	require.Equal(network, models.NetworkFromNetworkID(4242))
+	require.Equal("private", key.GetHRP(4242))
+}
+
+func TestAddNetworkDChainEndpoint(t *testing.T) {
+	require := require.New(t)
+	setupNetworksTest(t)
+	networkDChainEndpoint = "https://rpc.example.com/dchain"
+
+	require.NoError(addNetwork(nil, []string{testNetworkName}))
+	require.Equal("https://rpc.example.com/dchain", models.NetworkFromString(testNetworkName).DChainEndpoint())
+}
+
+func TestAddNetworkInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		networkName string
+		setup       func()
+		expectedErr string
+	}{
+		{
+			name:        "invalid name",
+			networkName: "my network",
+			expectedErr: "invalid network name",
+		},
+		{
+			name:        "reserved name",
+			networkName: "Testnet",
+			expectedErr: "reserved for Testnet",
+		},
+		{
+			name:        "no network ID",
+			networkName: testNetworkName,
+			setup:       func() { networkID = 0 },
+			expectedErr: "network ID is required",
+		},
+		{
+			name:        "reserved network ID",
+			networkName: testNetworkName,
+			setup:       func() { networkID = 1 },
+			expectedErr: "network ID 1 is reserved",
+		},
+		{
+			name:        "local network ID",
+			networkName: testNetworkName,
+			setup:       func() { networkID = 1337 },
+			expectedErr: "already used by Local Network",
+		},
+		{
+			name:        "no endpoint",
+			networkName: testNetworkName,
+			setup:       func() { networkEndpoint = "" },
+			expectedErr: "endpoint is required",
+		},
+		{
+			name:        "invalid endpoint",
+			networkName: testNetworkName,
+			setup:       func() { networkEndpoint = "ftp://10.0.0.1" },
+			expectedErr: "unsupported scheme",
+		},
+		{
+			name:        "unknown genesis params",
+			networkName: testNetworkName,
+			setup:       func() { genesisParamsPreset = "devnet" },
+			expectedErr: "unknown genesis params",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			setupNetworksTest(t)
+			if tt.setup != nil {
+				tt.setup()
+			}
+			err := addNetwork(nil, []string{tt.networkName})
+			require.ErrorContains(err, tt.expectedErr)
+			require.NoFileExists(app.GetCustomNetworksConfigPath())
+		})
+	}
+}
+
+func TestAddNetworkDuplicate(t *testing.T) {
+	require := require.New(t)
+	setupNetworksTest(t)
+
+	require.NoError(addNetwork(nil, []string{testNetworkName}))
+	networkID = 4343
+	require.ErrorContains(addNetwork(nil, []string{testNetworkName}), "already exists")
+	networkID = 4242
+	require.ErrorContains(addNetwork(nil, []string{"another"}), "already used by "+testNetworkName)
+}
+
+func TestRemoveNetwork(t *testing.T) {
+	require := require.New(t)
+	setupNetworksTest(t)
+
+	require.ErrorContains(removeNetwork(nil, []string{testNetworkName}), "not found")
+	require.NoError(addNetwork(nil, []string{testNetworkName}))
+	require.NoError(app.CreateSidecar(&models.Sidecar{
+		Name: "test1",
+		Networks: map[string]models.NetworkData{
+			testNetworkName: {SubnetID: ids.GenerateTestID()},
+		},
+	}))
+
+	require.ErrorContains(removeNetwork(nil, []string{testNetworkName}), "subnets test1 are deployed")
+	forceRemove = true
+	require.NoError(removeNetwork(nil, []string{testNetworkName}))
+
+	customNetworksConfig, err := app.LoadCustomNetworksConfig()
+	require.NoError(err)
+	require.Empty(customNetworksConfig.Networks)
+	require.Equal(models.Undefined, models.NetworkFromString(testNetworkName).Kind)
+}
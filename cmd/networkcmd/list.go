@@ -0,0 +1,71 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type networkInfo struct {
+	Name           string `json:"name" yaml:"name"`
+	NetworkID      uint32 `json:"networkID" yaml:"networkID"`
+	Endpoint       string `json:"endpoint" yaml:"endpoint"`
+	DChainEndpoint string `json:"dChainEndpoint" yaml:"dChainEndpoint"`
+	HRP            string `json:"hrp" yaml:"hrp"`
+}
+
+// odyssey network list
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the registered private networks",
+		Long: `The network list command lists the private networks registered with network add,
+with their network ID, endpoints and HRP.`,
+		RunE:         listNetworks,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+	}
+}
+
+func listNetworks(*cobra.Command, []string) error {
+	if _, err := app.LoadCustomNetworksConfig(); err != nil {
+		return err
+	}
+	networkInfos := []networkInfo{}
+	for _, networkName := range models.GetCustomNetworkNames() {
+		customNetwork, _ := models.GetCustomNetwork(networkName)
+		networkInfos = append(networkInfos, networkInfo{
+			Name:           customNetwork.Name,
+			NetworkID:      customNetwork.NetworkID,
+			Endpoint:       customNetwork.Endpoint,
+			DChainEndpoint: customNetwork.Network().DChainEndpoint(),
+			HRP:            customNetwork.HRP,
+		})
+	}
+	return ux.Render(networkInfos, func() {
+		if len(networkInfos) == 0 {
+			ux.Logger.PrintToUser("No networks registered. Use network add to register one")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Network ID", "Endpoint", "D-Chain Endpoint", "HRP"})
+		table.SetRowLine(true)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, info := range networkInfos {
+			table.Append([]string{
+				info.Name,
+				strconv.FormatUint(uint64(info.NetworkID), 10),
+				info.Endpoint,
+				info.DChainEndpoint,
+				info.HRP,
+			})
+		}
+		table.Render()
+	})
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odyssey-network-runner/server"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// time layout of the odysseygo plain text logs
+	logTimeFormat      = "[01-02|15:04:05.000]"
+	logsPollInterval   = 500 * time.Millisecond
+	nodeLogsDirName    = "logs"
+	logFileExtension   = ".log"
+	mainLogSourceName  = "main"
+	logSourceSeparator = "/"
+)
+
+var (
+	logsNodes  []string
+	logsChain  string
+	logsFollow bool
+	logsLevel  string
+	logsSince  time.Duration
+
+	errNoLocalNetwork = errors.New("no local network running")
+)
+
+// odyssey network logs
+func newLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Show the logs of the local network nodes",
+		Long: `The network logs command shows the logs of the nodes of the running local network,
+merged and prefixed with the node and chain they come from.
+
+By default, the logs of all nodes and chains are shown. Use --node to select some nodes,
+and --chain to select a chain, given as O, A, D, a blockchain ID, or the name of a locally
+deployed Subnet. Use --level to hide the lines below a log level, and --since to hide the
+lines older than the given duration.
+
+With --follow, the command keeps printing new log lines as they are written, including
+the ones of log files created meanwhile, like the ones of new chains, until interrupted.`,
+		RunE:         showLogs,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSliceVar(&logsNodes, "node", nil, "show only the logs of these nodes (ex: node1)")
+	cmd.Flags().StringVar(&logsChain, "chain", "", "show only the logs of this chain (O, A, D, blockchain ID or Subnet name)")
+	cmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "keep printing new log lines")
+	cmd.Flags().StringVar(&logsLevel, "level", "", "show only lines of this log level or above (ex: warn)")
+	cmd.Flags().DurationVar(&logsSince, "since", 0, "show only lines newer than this duration (ex: 5m)")
+	return cmd
+}
+
+// logSource is a log file of a node of the local network
+type logSource struct {
+	// <node>/<chain> prefix of the lines of the file
+	name string
+	path string
+}
+
+type logLine struct {
+	source string
+	time   time.Time
+	level  logging.Level
+	text   string
+}
+
+// logFilter decides which log lines are shown
+type logFilter struct {
+	minLevel logging.Level
+	since    time.Time
+}
+
+func (f logFilter) match(line logLine) bool {
+	return line.level >= f.minLevel && !line.time.Before(f.since)
+}
+
+func showLogs(*cobra.Command, []string) error {
+	filter := logFilter{minLevel: logging.Verbo}
+	if logsLevel != "" {
+		level, err := logging.ToLevel(logsLevel)
+		if err != nil {
+			return err
+		}
+		filter.minLevel = level
+	}
+	if logsSince != 0 {
+		filter.since = time.Now().Add(-logsSince)
+	}
+
+	clusterInfo, err := getClusterInfo()
+	if err != nil {
+		return err
+	}
+	chainFile, err := getChainLogFileName(clusterInfo, logsChain)
+	if err != nil {
+		return err
+	}
+	sources, err := getLogSources(clusterInfo, logsNodes, chainFile)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no log files found")
+	}
+
+	offsets := map[string]int64{}
+	if logsFollow && logsSince == 0 {
+		// when following without --since, only the new lines are shown
+		for _, source := range sources {
+			offsets[source.path], err = getLogFileSize(source.path)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		lines, err := readNewLogLines(sources, offsets)
+		if err != nil {
+			return err
+		}
+		sort.SliceStable(lines, func(i, j int) bool {
+			return lines[i].time.Before(lines[j].time)
+		})
+		printLogLines(lines, filter)
+	}
+	if !logsFollow {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ticker := time.NewTicker(logsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		// log files created after the start, as the ones of new chains, are followed too
+		sources, err = getLogSources(clusterInfo, logsNodes, chainFile)
+		if err != nil {
+			return err
+		}
+		lines, err := readNewLogLines(sources, offsets)
+		if err != nil {
+			return err
+		}
+		printLogLines(lines, filter)
+	}
+}
+
+func getClusterInfo() (*rpcpb.ClusterInfo, error) {
+	cli, err := binutils.NewGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	status, err := cli.Status(ctx)
+	if err != nil {
+		if server.IsServerError(err, server.ErrNotBootstrapped) {
+			return nil, errNoLocalNetwork
+		}
+		return nil, err
+	}
+	if status == nil || status.ClusterInfo == nil {
+		return nil, errNoLocalNetwork
+	}
+	return status.ClusterInfo, nil
+}
+
+// getChainLogFileName returns the name of the log file of [chain] in the node logs dir.
+// [chain] can be a primary network chain alias, a blockchain ID, a custom chain name,
+// or the name of a locally deployed subnet. Returns an empty name if [chain] is empty.
+func getChainLogFileName(clusterInfo *rpcpb.ClusterInfo, chain string) (string, error) {
+	if chain == "" {
+		return "", nil
+	}
+	switch strings.ToUpper(chain) {
+	case "O", "A", "D":
+		return strings.ToUpper(chain) + logFileExtension, nil
+	}
+	if _, ok := clusterInfo.CustomChains[chain]; ok {
+		return chain + logFileExtension, nil
+	}
+	for blockchainID, chainInfo := range clusterInfo.CustomChains {
+		if chainInfo.ChainName == chain {
+			return blockchainID + logFileExtension, nil
+		}
+	}
+	if app.SidecarExists(chain) {
+		sc, err := app.LoadSidecar(chain)
+		if err != nil {
+			return "", err
+		}
+		if network, ok := sc.Networks[models.Local.String()]; ok {
+			return network.BlockchainID.String() + logFileExtension, nil
+		}
+		return "", fmt.Errorf("subnet %s is not deployed to the local network", chain)
+	}
+	return "", fmt.Errorf("unknown chain %s", chain)
+}
+
+// getLogSources returns the log files of [nodeNames], or of all nodes if empty.
+// Only the [chainFile] log is included if given.
+func getLogSources(clusterInfo *rpcpb.ClusterInfo, nodeNames []string, chainFile string) ([]logSource, error) {
+	if len(nodeNames) == 0 {
+		nodeNames = clusterInfo.NodeNames
+	}
+	chainNames := map[string]string{}
+	for blockchainID, chainInfo := range clusterInfo.CustomChains {
+		chainNames[blockchainID] = chainInfo.ChainName
+	}
+	sources := []logSource{}
+	for _, nodeName := range nodeNames {
+		if !slices.Contains(clusterInfo.NodeNames, nodeName) {
+			return nil, fmt.Errorf("node %s not found in the local network. Nodes: %s", nodeName, strings.Join(clusterInfo.NodeNames, ", "))
+		}
+		logDir := filepath.Join(clusterInfo.RootDataDir, nodeName, nodeLogsDirName)
+		if nodeInfo, ok := clusterInfo.NodeInfos[nodeName]; ok && nodeInfo.LogDir != "" {
+			logDir = nodeInfo.LogDir
+		}
+		entries, err := os.ReadDir(logDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != logFileExtension {
+				continue
+			}
+			if chainFile != "" && entry.Name() != chainFile {
+				continue
+			}
+			sourceName := strings.TrimSuffix(entry.Name(), logFileExtension)
+			if chainName, ok := chainNames[sourceName]; ok {
+				sourceName = chainName
+			}
+			sources = append(sources, logSource{
+				name: nodeName + logSourceSeparator + sourceName,
+				path: filepath.Join(logDir, entry.Name()),
+			})
+		}
+	}
+	return sources, nil
+}
+
+// getLogFileSize returns the size of the log file at [path], or 0 if it doesn't exist
+func getLogFileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// readNewLogLines reads the lines of [sources] written after their [offsets], and updates
+// them. Sources without an offset are read from the start.
+func readNewLogLines(sources []logSource, offsets map[string]int64) ([]logLine, error) {
+	lines := []logLine{}
+	for _, source := range sources {
+		sourceLines, offset, err := readLogLines(source, offsets[source.path])
+		if err != nil {
+			return nil, err
+		}
+		offsets[source.path] = offset
+		lines = append(lines, sourceLines...)
+	}
+	return lines, nil
+}
+
+// readLogLines reads the complete lines of [source] starting at [offset], and returns
+// them with the offset to continue reading from. If the file was truncated or rotated
+// below [offset], it is read again from the start.
+func readLogLines(source logSource, offset int64) ([]logLine, int64, error) {
+	file, err := os.Open(source.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, offset, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, offset, err
+	}
+	if info.Size() < offset {
+		offset = 0
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, err
+	}
+	lines := []logLine{}
+	reader := bufio.NewReader(file)
+	now := time.Now()
+	var last logLine
+	for {
+		text, err := reader.ReadString('\n')
+		if errors.Is(err, io.EOF) {
+			// partial lines are read again on the next call
+			return lines, offset, nil
+		}
+		if err != nil {
+			return nil, offset, err
+		}
+		offset += int64(len(text))
+		text = strings.TrimRight(text, "\r\n")
+		line := parseLogLine(text, now)
+		if line.time.IsZero() {
+			// continuation of a multiline entry
+			line.time = last.time
+			line.level = last.level
+		}
+		line.source = source.name
+		lines = append(lines, line)
+		last = line
+	}
+}
+
+// parseLogLine obtains the time and level of an odysseygo plain text log line
+// "[01-02|15:04:05.000] INFO ...". The time is left zero if [text] doesn't follow the format.
+// As the log time doesn't include the year, it is taken from [now].
+func parseLogLine(text string, now time.Time) logLine {
+	line := logLine{text: text, level: logging.Info}
+	if len(text) < len(logTimeFormat) {
+		return line
+	}
+	t, err := time.ParseInLocation(logTimeFormat, text[:len(logTimeFormat)], now.Location())
+	if err != nil {
+		return line
+	}
+	t = t.AddDate(now.Year(), 0, 0)
+	if t.After(now.AddDate(0, 0, 1)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	line.time = t
+	fields := strings.Fields(text[len(logTimeFormat):])
+	if len(fields) > 0 {
+		if level, err := logging.ToLevel(fields[0]); err == nil {
+			line.level = level
+		}
+	}
+	return line
+}
+
+func printLogLines(lines []logLine, filter logFilter) {
+	for _, line := range lines {
+		if filter.match(line) {
+			fmt.Printf("%s | %s\n", line.source, line.text)
+		}
+	}
+}
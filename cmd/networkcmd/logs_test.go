@@ -0,0 +1,135 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseLogLine(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	line := parseLogLine("[10-16|11:55:00.250] WARN <O Chain> node/node.go:10 slow", now)
+	require.Equal(time.Date(2023, 10, 16, 11, 55, 0, 250_000_000, time.UTC), line.time)
+	require.Equal(logging.Warn, line.level)
+
+	// a log written at the end of the previous year
+	line = parseLogLine("[12-31|23:59:59.000] ERROR failed", time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC))
+	require.Equal(2023, line.time.Year())
+	require.Equal(logging.Error, line.level)
+
+	line = parseLogLine("goroutine 1 [running]:", now)
+	require.True(line.time.IsZero())
+}
+
+func Test_readLogLines(t *testing.T) {
+	require := require.New(t)
+	logPath := filepath.Join(t.TempDir(), "main.log")
+	content := "[10-16|11:55:00.000] INFO started\n[10-16|11:56:00.000] ERROR failed\nstack line\n[10-16|11:57"
+	require.NoError(os.WriteFile(logPath, []byte(content), constants.WriteReadReadPerms))
+	source := logSource{name: "node1/main", path: logPath}
+
+	lines, offset, err := readLogLines(source, 0)
+	require.NoError(err)
+	require.Len(lines, 3)
+	require.Equal("node1/main", lines[2].source)
+	// continuation lines take the time and level of their entry
+	require.Equal(logging.Error, lines[2].level)
+	require.Equal(lines[1].time, lines[2].time)
+
+	filter := logFilter{minLevel: logging.Warn}
+	require.False(filter.match(lines[0]))
+	require.True(filter.match(lines[2]))
+
+	// the partial last line is returned once it is completed
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(err)
+	_, err = f.WriteString(":00.000] WARN done\n")
+	require.NoError(err)
+	require.NoError(f.Close())
+	lines, _, err = readLogLines(source, offset)
+	require.NoError(err)
+	require.Len(lines, 1)
+	require.Equal("[10-16|11:57:00.000] WARN done", lines[0].text)
+	require.Equal(logging.Warn, lines[0].level)
+}
+
+func Test_getLogSources(t *testing.T) {
+	require := require.New(t)
+	rootDir := t.TempDir()
+	blockchainID := "2oqJR5EEkHdAxJ8U7yHyA8zJwwM9o5n1UDWzUMGJm6KJcBwqSk"
+	for _, nodeName := range []string{"node1", "node2"} {
+		logDir := filepath.Join(rootDir, nodeName, nodeLogsDirName)
+		require.NoError(os.MkdirAll(logDir, constants.DefaultPerms755))
+		for _, logFile := range []string{"main.log", "O.log", blockchainID + ".log", "main.log.1.gz"} {
+			require.NoError(os.WriteFile(filepath.Join(logDir, logFile), nil, constants.WriteReadReadPerms))
+		}
+	}
+	clusterInfo := &rpcpb.ClusterInfo{
+		RootDataDir:  rootDir,
+		NodeNames:    []string{"node1", "node2"},
+		CustomChains: map[string]*rpcpb.CustomChainInfo{blockchainID: {ChainName: "testSubnet"}},
+	}
+
+	sources, err := getLogSources(clusterInfo, nil, "")
+	require.NoError(err)
+	require.Len(sources, 6)
+
+	chainFile, err := getChainLogFileName(clusterInfo, "testSubnet")
+	require.NoError(err)
+	sources, err = getLogSources(clusterInfo, []string{"node2"}, chainFile)
+	require.NoError(err)
+	require.Equal([]logSource{{
+		name: "node2/testSubnet",
+		path: filepath.Join(rootDir, "node2", nodeLogsDirName, blockchainID+".log"),
+	}}, sources)
+
+	chainFile, err = getChainLogFileName(clusterInfo, "o")
+	require.NoError(err)
+	require.Equal("O.log", chainFile)
+
+	_, err = getLogSources(clusterInfo, []string{"node3"}, "")
+	require.ErrorContains(err, "node node3 not found")
+}
+
+func Test_readNewLogLines(t *testing.T) {
+	require := require.New(t)
+	logDir := t.TempDir()
+	mainSource := logSource{name: "node1/main", path: filepath.Join(logDir, "main.log")}
+	require.NoError(os.WriteFile(mainSource.path, []byte("[10-16|11:55:00.000] INFO old\n"), constants.WriteReadReadPerms))
+
+	// following starts at the end of the existing files
+	size, err := getLogFileSize(mainSource.path)
+	require.NoError(err)
+	offsets := map[string]int64{mainSource.path: size}
+	lines, err := readNewLogLines([]logSource{mainSource}, offsets)
+	require.NoError(err)
+	require.Empty(lines)
+
+	// a file that shows up later is read from the start
+	chainSource := logSource{name: "node1/O", path: filepath.Join(logDir, "O.log")}
+	size, err = getLogFileSize(chainSource.path)
+	require.NoError(err)
+	require.Zero(size)
+	require.NoError(os.WriteFile(chainSource.path, []byte("[10-16|11:56:00.000] INFO new chain\n"), constants.WriteReadReadPerms))
+	f, err := os.OpenFile(mainSource.path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(err)
+	_, err = f.WriteString("[10-16|11:56:00.000] INFO new\n")
+	require.NoError(err)
+	require.NoError(f.Close())
+	lines, err = readNewLogLines([]logSource{mainSource, chainSource}, offsets)
+	require.NoError(err)
+	require.Len(lines, 2)
+	require.Equal("[10-16|11:56:00.000] INFO new", lines[0].text)
+	require.Equal("node1/O", lines[1].source)
+	require.Equal("[10-16|11:56:00.000] INFO new chain", lines[1].text)
+}
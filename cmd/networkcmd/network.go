@@ -15,7 +15,7 @@ func NewCmd(injectedApp *application.Odyssey) *cobra.Command {
 	app = injectedApp
 	cmd := &cobra.Command{
 		Use:   "network",
-		Short: "Manage locally deployed subnets",
+		Short: "Manage locally deployed subnets and private networks",
 		Long: `The network command suite provides a collection of tools for managing local Subnet
 deployments.
 
@@ -23,7 +23,10 @@ When you deploy a Subnet locally, it runs on a local, multi-node Odyssey network
 subnet deploy command starts this network in the background. This command suite allows you
 to shutdown, restart, and clear that network.
 
-This network currently supports multiple, concurrently deployed Subnets.`,
+This network currently supports multiple, concurrently deployed Subnets.
+
+The add, list and remove commands manage a registry of private Odyssey networks, which
+other commands can target by name with the --network flag.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := cmd.Help()
 			if err != nil {
@@ -40,5 +43,15 @@ This network currently supports multiple, concurrently deployed Subnets.`,
 	cmd.AddCommand(newCleanCmd())
 	// network status
 	cmd.AddCommand(newStatusCmd())
+	// network logs
+	cmd.AddCommand(newLogsCmd())
+	// network snapshot
+	cmd.AddCommand(newSnapshotCmd())
+	// network add
+	cmd.AddCommand(newAddCmd())
+	// network list
+	cmd.AddCommand(newListCmd())
+	// network remove
+	cmd.AddCommand(newRemoveCmd())
 	return cmd
 }
@@ -0,0 +1,75 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+var forceRemove bool
+
+// odyssey network remove
+func newRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove [networkName]",
+		Short: "Remove a registered private network",
+		Long: `The network remove command removes a private network registered with network add.
+
+Subnets deployed to the network keep their deployment info, but can't be operated on
+until a network with the same name is added again. Because of this, networks with
+deployed Subnets are only removed with --force.`,
+		RunE:         removeNetwork,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	cmd.Flags().BoolVar(&forceRemove, "force", false, "remove the network even if Subnets are deployed to it")
+	return cmd
+}
+
+func removeNetwork(_ *cobra.Command, args []string) error {
+	networkName := args[0]
+	customNetworksConfig, err := app.LoadCustomNetworksConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := customNetworksConfig.Networks[networkName]; !ok {
+		return fmt.Errorf("network %s not found", networkName)
+	}
+	deployedSubnets, err := getDeployedSubnets(networkName)
+	if err != nil {
+		return err
+	}
+	if len(deployedSubnets) > 0 && !forceRemove {
+		return fmt.Errorf("subnets %s are deployed to network %s. Use --force to remove it anyway",
+			strings.Join(deployedSubnets, ", "), networkName)
+	}
+	delete(customNetworksConfig.Networks, networkName)
+	if err := app.WriteCustomNetworksConfig(customNetworksConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Network %s removed", networkName)
+	return nil
+}
+
+// getDeployedSubnets returns the names of the subnets deployed to [networkName]
+func getDeployedSubnets(networkName string) ([]string, error) {
+	sidecarNames, err := app.GetSidecarNames()
+	if err != nil {
+		return nil, err
+	}
+	deployedSubnets := []string{}
+	for _, sidecarName := range sidecarNames {
+		sc, err := app.LoadSidecar(sidecarName)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := sc.Networks[networkName]; ok {
+			deployedSubnets = append(deployedSubnets, sidecarName)
+		}
+	}
+	return deployedSubnets, nil
+}
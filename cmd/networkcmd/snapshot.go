@@ -0,0 +1,48 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/spf13/cobra"
+)
+
+// odyssey network snapshot
+func newSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage local network snapshots",
+		Long: `The network snapshot command suite provides a collection of tools for managing
+the snapshots of the local network.
+
+A snapshot is saved by network stop --snapshot-name <snapshotName> and loaded by
+network start --snapshot-name <snapshotName>. These commands allow you to list, describe
+and delete the saved snapshots, and to export and import them as portable archives, to
+share a pre-deployed network state with other users or CI.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			if err != nil {
+				fmt.Println(err)
+			}
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	// network snapshot list
+	cmd.AddCommand(newSnapshotListCmd())
+	// network snapshot describe
+	cmd.AddCommand(newSnapshotDescribeCmd())
+	// network snapshot delete
+	cmd.AddCommand(newSnapshotDeleteCmd())
+	// network snapshot export
+	cmd.AddCommand(newSnapshotExportCmd())
+	// network snapshot import
+	cmd.AddCommand(newSnapshotImportCmd())
+	return cmd
+}
+
+// snapshotNameArg checks that the snapshot name given as the only argument is valid
+var snapshotNameArg = cobra.MatchAll(cobra.ExactArgs(1), func(_ *cobra.Command, args []string) error {
+	return snapshot.ValidateName(args[0])
+})
@@ -0,0 +1,65 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+var (
+	forceDeleteSnapshot bool
+
+	errDeleteDefaultSnapshot = errors.New("the default snapshot can't be deleted, use network clean to reset it")
+)
+
+// odyssey network snapshot delete
+func newSnapshotDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [snapshotName]",
+		Short: "Delete a saved snapshot",
+		Long: `The network snapshot delete command deletes a saved snapshot of the local network.
+
+The command prompts for confirmation before deleting the snapshot. To skip the
+confirmation, provide the --force flag. The default snapshot can't be deleted,
+use network clean to reset it instead.`,
+		RunE:         deleteSnapshot,
+		Args:         snapshotNameArg,
+		SilenceUsage: true,
+	}
+	cmd.Flags().BoolVarP(&forceDeleteSnapshot, "force", "f", false, "delete the snapshot without confirmation")
+	return cmd
+}
+
+func deleteSnapshot(_ *cobra.Command, args []string) error {
+	snapshotName := args[0]
+	if snapshotName == constants.DefaultSnapshotName {
+		return errDeleteDefaultSnapshot
+	}
+	exists, err := snapshot.Exists(app.GetSnapshotsDir(), snapshotName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return snapshot.ErrSnapshotNotFound
+	}
+	if !forceDeleteSnapshot {
+		conf, err := app.Prompt.CaptureNoYes("Are you sure you want to delete snapshot " + snapshotName + "?")
+		if err != nil {
+			return err
+		}
+		if !conf {
+			ux.Logger.PrintToUser("Delete cancelled")
+			return nil
+		}
+	}
+	if err := snapshot.Delete(app.GetSnapshotsDir(), snapshotName); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Snapshot %s deleted", snapshotName)
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// odyssey network snapshot describe
+func newSnapshotDescribeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "describe [snapshotName]",
+		Short: "Show the contents of a saved snapshot",
+		Long: `The network snapshot describe command shows the odysseygo binary and version, the nodes,
+and the Subnets and blockchains contained in a saved snapshot of the local network.
+
+Subnets and blockchains are shown by name when they match a locally deployed Subnet.`,
+		RunE:         describeSnapshot,
+		Args:         snapshotNameArg,
+		SilenceUsage: true,
+	}
+}
+
+func describeSnapshot(_ *cobra.Command, args []string) error {
+	desc, err := snapshot.Describe(app.GetSnapshotsDir(), args[0])
+	if err != nil {
+		return err
+	}
+	subnetNames, err := getLocalSubnetNames()
+	if err != nil {
+		return err
+	}
+	blockchainNames := map[string]string{}
+	for _, subnetName := range subnetNames {
+		sc, err := app.LoadSidecar(subnetName)
+		if err != nil {
+			return err
+		}
+		blockchainNames[sc.Networks[models.Local.String()].BlockchainID.String()] = subnetName
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Parameter", "Value"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Append([]string{"Snapshot", desc.Name})
+	table.Append([]string{"Path", desc.Path})
+	table.Append([]string{"Size", fmt.Sprintf("%.2f MB", float64(desc.Size)/(1<<20))})
+	table.Append([]string{"OdysseyGo Version", versionLabel(desc)})
+	table.Append([]string{"OdysseyGo Binary", desc.BinaryPath})
+	table.Append([]string{"Nodes", strings.Join(desc.NodeNames, ", ")})
+	for _, subnetID := range desc.SubnetIDs {
+		table.Append([]string{"Subnet", subnetLabel(subnetNames, subnetID)})
+	}
+	for _, blockchainID := range desc.BlockchainIDs {
+		table.Append([]string{"Blockchain", subnetLabel(blockchainNames, blockchainID)})
+	}
+	table.Render()
+	return nil
+}
+
+// getLocalSubnetNames maps the subnet IDs of locally deployed subnets to their names
+func getLocalSubnetNames() (map[string]string, error) {
+	deployedSubnets, err := subnet.GetLocallyDeployedSubnetsFromFile(app)
+	if err != nil {
+		return nil, err
+	}
+	subnetNames := map[string]string{}
+	for _, subnetName := range deployedSubnets {
+		sc, err := app.LoadSidecar(subnetName)
+		if err != nil {
+			return nil, err
+		}
+		subnetNames[sc.Networks[models.Local.String()].SubnetID.String()] = subnetName
+	}
+	return subnetNames, nil
+}
+
+func subnetLabel(names map[string]string, id string) string {
+	if name, ok := names[id]; ok {
+		return fmt.Sprintf("%s (%s)", name, id)
+	}
+	return id
+}
+
+func versionLabel(desc *snapshot.Description) string {
+	if desc.OdysseyGoVersion == "" {
+		return "unknown"
+	}
+	return desc.OdysseyGoVersion
+}
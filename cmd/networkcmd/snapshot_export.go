@@ -0,0 +1,43 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+var snapshotArchivePath string
+
+// odyssey network snapshot export
+func newSnapshotExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [snapshotName]",
+		Short: "Package a saved snapshot as a portable archive",
+		Long: `The network snapshot export command packages a saved snapshot of the local network
+as a tar.gz archive, that can be loaded on another machine with network snapshot import.
+
+The archive includes the sha256 sums of all the snapshot files, that are verified on
+import. The sum of the archive itself is written next to it, to <archive>.sha256.`,
+		RunE:         exportSnapshot,
+		Args:         snapshotNameArg,
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&snapshotArchivePath, "archive-path", "", "write the archive to this path (default <snapshotName>.tar.gz)")
+	return cmd
+}
+
+func exportSnapshot(_ *cobra.Command, args []string) error {
+	snapshotName := args[0]
+	if snapshotArchivePath == "" {
+		snapshotArchivePath = snapshotName + ".tar.gz"
+	}
+	sum, err := snapshot.Export(app.GetSnapshotsDir(), snapshotName, snapshotArchivePath)
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Snapshot %s exported to %s", snapshotName, snapshotArchivePath)
+	ux.Logger.PrintToUser("SHA256: %s", sum)
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+)
+
+var importedSnapshotName string
+
+// odyssey network snapshot import
+func newSnapshotImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [archivePath]",
+		Short: "Import a snapshot archive",
+		Long: `The network snapshot import command installs a snapshot archive created with
+network snapshot export, so it can be loaded with network start --snapshot-name <snapshotName>.
+
+All the snapshot files are verified against the sums included in the archive. If the
+<archive>.sha256 file is next to the archive, the archive is verified against it too.
+The snapshot keeps its exported name unless the --snapshot-name flag is given.`,
+		RunE:         importSnapshot,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVar(&importedSnapshotName, "snapshot-name", "", "import the snapshot under this name")
+	return cmd
+}
+
+func importSnapshot(_ *cobra.Command, args []string) error {
+	if importedSnapshotName != "" {
+		if err := snapshot.ValidateName(importedSnapshotName); err != nil {
+			return err
+		}
+	}
+	snapshotName, err := snapshot.Import(app.GetSnapshotsDir(), args[0], importedSnapshotName)
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Snapshot imported as %s", snapshotName)
+	ux.Logger.PrintToUser("Start it with: odyssey network start --snapshot-name %s", snapshotName)
+	return nil
+}
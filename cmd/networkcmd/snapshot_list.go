@@ -0,0 +1,70 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package networkcmd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/snapshot"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// odyssey network snapshot list
+func newSnapshotListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the saved snapshots of the local network",
+		Long: `The network snapshot list command lists all the saved snapshots of the local
+network, with their odysseygo version, number of nodes and tracked Subnets.`,
+		RunE:         listSnapshots,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+	}
+}
+
+func listSnapshots(*cobra.Command, []string) error {
+	snapshotNames, err := snapshot.List(app.GetSnapshotsDir())
+	if err != nil {
+		return err
+	}
+	if len(snapshotNames) == 0 {
+		ux.Logger.PrintToUser("No snapshots found")
+		return nil
+	}
+	subnetNames, err := getLocalSubnetNames()
+	if err != nil {
+		return err
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Snapshot", "OdysseyGo Version", "Nodes", "Subnets"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, snapshotName := range snapshotNames {
+		desc, err := snapshot.Describe(app.GetSnapshotsDir(), snapshotName)
+		if err != nil {
+			table.Append([]string{snapshotName, "invalid snapshot: " + err.Error(), "", ""})
+			continue
+		}
+		name := snapshotName
+		if snapshotName == constants.DefaultSnapshotName {
+			name += " (default)"
+		}
+		subnets := []string{}
+		for _, subnetID := range desc.SubnetIDs {
+			subnets = append(subnets, subnetLabel(subnetNames, subnetID))
+		}
+		table.Append([]string{
+			name,
+			versionLabel(desc),
+			strconv.Itoa(len(desc.NodeNames)),
+			strings.Join(subnets, "\n"),
+		})
+	}
+	table.Render()
+	return nil
+}
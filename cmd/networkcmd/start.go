@@ -4,8 +4,13 @@ package networkcmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
@@ -15,15 +20,22 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
 	"github.com/DioneProtocol/odyssey-network-runner/client"
+	"github.com/DioneProtocol/odyssey-network-runner/local"
 	"github.com/DioneProtocol/odyssey-network-runner/server"
 	onrutils "github.com/DioneProtocol/odyssey-network-runner/utils"
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
 var (
 	userProvidedOdygoVersion string
 	snapshotName             string
 	odygoBinaryPath          string
+	numNodes                 uint32
+	nodeConfigPath           string
+	perNodeConfigDir         string
+
+	errTopologyNeedsSnapshotName = errors.New("a network started with a custom topology must be saved under a new snapshot, provide it with --snapshot-name")
 )
 
 const latest = "latest"
@@ -36,7 +48,17 @@ func newStartCmd() *cobra.Command {
 
 By default, the command loads the default snapshot. If you provide the --snapshot-name
 flag, the network loads that snapshot instead. The command fails if the local network is
-already running.`,
+already running.
+
+If you provide any of the --num-nodes, --node-config or --per-node-config flags, the command
+instead builds a fresh network with the requested topology, saves it under the new snapshot
+given by --snapshot-name, and starts it. Later runs of network start --snapshot-name <snapshotName>
+reuse the same topology.
+
+--node-config is a JSON file with odysseygo flags applied to all nodes. --per-node-config is a
+directory with files node1.json ... nodeN.json, each one with odysseygo flags applied only to
+that node, for example staking-tls-key-file and staking-tls-cert-file to set its staking keys.
+Nodes without a file use the default configuration.`,
 
 		RunE:         StartNetwork,
 		Args:         cobra.ExactArgs(0),
@@ -46,6 +68,9 @@ already running.`,
 	cmd.Flags().StringVar(&userProvidedOdygoVersion, "odysseygo-version", latest, "use this version of odysseygo (ex: v1.10.10)")
 	cmd.Flags().StringVar(&odygoBinaryPath, "odysseygo-path", "", "use this odysseygo binary path")
 	cmd.Flags().StringVar(&snapshotName, "snapshot-name", constants.DefaultSnapshotName, "name of snapshot to use to start the network from")
+	cmd.Flags().Uint32Var(&numNodes, "num-nodes", 0, "number of nodes of the new network (default 5)")
+	cmd.Flags().StringVar(&nodeConfigPath, "node-config", "", "path to a JSON file with odysseygo flags for all nodes of the new network")
+	cmd.Flags().StringVar(&perNodeConfigDir, "per-node-config", "", "path to a directory with node<i>.json files with odysseygo flags for each node of the new network")
 
 	return cmd
 }
@@ -98,14 +123,6 @@ func StartNetwork(*cobra.Command, []string) error {
 		}
 	}
 
-	var startMsg string
-	if snapshotName == constants.DefaultSnapshotName {
-		startMsg = "Starting previously deployed and stopped snapshot"
-	} else {
-		startMsg = fmt.Sprintf("Starting previously deployed and stopped snapshot %s...", snapshotName)
-	}
-	ux.Logger.PrintToUser(startMsg)
-
 	outputDirPrefix := path.Join(app.GetRunDir(), "network")
 	outputDir, err := onrutils.MkDirWithTimestamp(outputDirPrefix)
 	if err != nil {
@@ -114,6 +131,26 @@ func StartNetwork(*cobra.Command, []string) error {
 
 	pluginDir := app.GetPluginsDir()
 
+	// load global node configs if they exist
+	configStr, err := app.Conf.LoadNodeConfig()
+	if err != nil {
+		return err
+	}
+
+	if numNodes != 0 || nodeConfigPath != "" || perNodeConfigDir != "" {
+		if err := createTopologySnapshot(ctx, cli, odysseyGoBinPath, outputDir, pluginDir, configStr); err != nil {
+			return err
+		}
+	}
+
+	var startMsg string
+	if snapshotName == constants.DefaultSnapshotName {
+		startMsg = "Starting previously deployed and stopped snapshot"
+	} else {
+		startMsg = fmt.Sprintf("Starting previously deployed and stopped snapshot %s...", snapshotName)
+	}
+	ux.Logger.PrintToUser(startMsg)
+
 	loadSnapshotOpts := []client.OpOption{
 		client.WithExecPath(odysseyGoBinPath),
 		client.WithRootDataDir(outputDir),
@@ -121,11 +158,6 @@ func StartNetwork(*cobra.Command, []string) error {
 		client.WithPluginDir(pluginDir),
 	}
 
-	// load global node configs if they exist
-	configStr, err := app.Conf.LoadNodeConfig()
-	if err != nil {
-		return err
-	}
 	if configStr != "" {
 		loadSnapshotOpts = append(loadSnapshotOpts, client.WithGlobalNodeConfig(configStr))
 	}
@@ -152,6 +184,163 @@ func StartNetwork(*cobra.Command, []string) error {
 	return nil
 }
 
+// createTopologySnapshot builds a fresh network with the topology given by the
+// --num-nodes, --node-config and --per-node-config flags, waits for it to be healthy
+// and saves it as snapshot [snapshotName]. Saving the snapshot stops the network.
+func createTopologySnapshot(
+	ctx context.Context,
+	cli client.Client,
+	odysseyGoBinPath string,
+	outputDir string,
+	pluginDir string,
+	globalConfigStr string,
+) error {
+	if snapshotName == constants.DefaultSnapshotName {
+		return errTopologyNeedsSnapshotName
+	}
+	snapshotNames, err := cli.GetSnapshotNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range snapshotNames {
+		if name == snapshotName {
+			return fmt.Errorf("snapshot %q already exists, use a new snapshot name to save a custom topology", snapshotName)
+		}
+	}
+
+	n := numNodes
+	if n == 0 {
+		n = local.DefaultNumNodes
+	}
+	globalConfigStr, err = mergeNodeConfigFile(globalConfigStr, nodeConfigPath)
+	if err != nil {
+		return err
+	}
+	customNodeConfigs, err := loadPerNodeConfigs(perNodeConfigDir, n)
+	if err != nil {
+		return err
+	}
+
+	startOpts := []client.OpOption{
+		client.WithNumNodes(n),
+		client.WithRootDataDir(outputDir),
+		client.WithReassignPortsIfUsed(true),
+		client.WithPluginDir(pluginDir),
+	}
+	if globalConfigStr != "" {
+		startOpts = append(startOpts, client.WithGlobalNodeConfig(globalConfigStr))
+	}
+	if customNodeConfigs != nil {
+		startOpts = append(startOpts, client.WithCustomNodeConfigs(customNodeConfigs))
+	}
+
+	ux.Logger.PrintToUser("Building a new %d node network. Wait until healthy...", n)
+	if _, err := cli.Start(ctx, odysseyGoBinPath, startOpts...); err != nil {
+		return fmt.Errorf("failed to start network with the requested topology: %w", err)
+	}
+	if _, err := subnet.WaitForHealthy(ctx, cli); err != nil {
+		stopTopologyNetwork(cli, outputDir)
+		return fmt.Errorf("failed waiting for the network to be healthy: %w", err)
+	}
+	if _, err := cli.SaveSnapshot(ctx, snapshotName); err != nil {
+		stopTopologyNetwork(cli, outputDir)
+		return fmt.Errorf("failed to save network with the requested topology: %w", err)
+	}
+	ux.Logger.PrintToUser("Network topology saved as snapshot %s", snapshotName)
+	return nil
+}
+
+// stopTopologyNetwork stops the network started by createTopologySnapshot when its snapshot
+// can't be saved, so that the server is left without a network, and removes its data at
+// [outputDir]. A new context is used, as the one of the failed calls may have expired
+func stopTopologyNetwork(cli client.Client, outputDir string) {
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	if _, err := cli.Stop(ctx); err != nil {
+		app.Log.Warn("failed stopping the network of the requested topology", zap.Error(err))
+	}
+	if err := app.ResetPluginsDir(); err != nil {
+		app.Log.Warn("failed resetting the plugins dir", zap.Error(err))
+	}
+	if err := os.RemoveAll(outputDir); err != nil {
+		app.Log.Warn("failed removing the network of the requested topology", zap.Error(err))
+	}
+}
+
+// mergeNodeConfigFile overrides the flags of the JSON node config [configStr]
+// with the ones in the JSON file at [configPath], if given
+func mergeNodeConfigFile(configStr string, configPath string) (string, error) {
+	if configPath == "" {
+		return configStr, nil
+	}
+	config := map[string]interface{}{}
+	if configStr != "" {
+		if err := json.Unmarshal([]byte(configStr), &config); err != nil {
+			return "", fmt.Errorf("invalid global node config: %w", err)
+		}
+	}
+	fileConfig, err := loadNodeConfigFile(configPath)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range fileConfig {
+		config[k] = v
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(configBytes), nil
+}
+
+// loadPerNodeConfigs reads the node<i>.json files in [dir] into custom node configs
+// for a network of [n] nodes. Nodes without a file get an empty config, as the
+// network runner sizes the network after the number of custom configs.
+func loadPerNodeConfigs(dir string, n uint32) (map[string]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	customNodeConfigs := map[string]string{}
+	for i := uint32(1); i <= n; i++ {
+		customNodeConfigs[fmt.Sprintf("node%d", i)] = "{}"
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		nodeName := strings.TrimSuffix(entry.Name(), ".json")
+		if _, ok := customNodeConfigs[nodeName]; !ok {
+			return nil, fmt.Errorf("unexpected per node config file %s: expected node1.json to node%d.json", entry.Name(), n)
+		}
+		nodeConfig, err := loadNodeConfigFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		configBytes, err := json.Marshal(nodeConfig)
+		if err != nil {
+			return nil, err
+		}
+		customNodeConfigs[nodeName] = string(configBytes)
+	}
+	return customNodeConfigs, nil
+}
+
+func loadNodeConfigFile(configPath string) (map[string]interface{}, error) {
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return nil, fmt.Errorf("invalid node config file %s: %w", configPath, err)
+	}
+	return config, nil
+}
+
 func determineOdygoVersion(userProvidedOdygoVersion string) (string, error) {
 	// a specific user provided version should override this calculation, so just return
 	if userProvidedOdygoVersion != latest {
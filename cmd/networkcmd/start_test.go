@@ -4,11 +4,17 @@
 package networkcmd
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
 	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -156,3 +162,88 @@ func Test_determineOdygoVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_loadPerNodeConfigs(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	require.NoError(os.WriteFile(filepath.Join(dir, "node2.json"), []byte(`{"staking-tls-key-file":"/keys/node2.key"}`), constants.WriteReadReadPerms))
+	require.NoError(os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), constants.WriteReadReadPerms))
+
+	customNodeConfigs, err := loadPerNodeConfigs(dir, 3)
+	require.NoError(err)
+	require.Equal(map[string]string{
+		"node1": "{}",
+		"node2": `{"staking-tls-key-file":"/keys/node2.key"}`,
+		"node3": "{}",
+	}, customNodeConfigs)
+
+	_, err = loadPerNodeConfigs(dir, 1)
+	require.ErrorContains(err, "unexpected per node config file node2.json")
+
+	customNodeConfigs, err = loadPerNodeConfigs("", 3)
+	require.NoError(err)
+	require.Nil(customNodeConfigs)
+}
+
+func Test_mergeNodeConfigFile(t *testing.T) {
+	require := require.New(t)
+	configPath := filepath.Join(t.TempDir(), "node-config.json")
+	require.NoError(os.WriteFile(configPath, []byte(`{"log-level":"debug","index-enabled":true}`), constants.WriteReadReadPerms))
+
+	merged, err := mergeNodeConfigFile(`{"log-level":"info","http-host":""}`, configPath)
+	require.NoError(err)
+	require.JSONEq(`{"log-level":"debug","index-enabled":true,"http-host":""}`, merged)
+
+	merged, err = mergeNodeConfigFile(`{"log-level":"info"}`, "")
+	require.NoError(err)
+	require.Equal(`{"log-level":"info"}`, merged)
+}
+
+func Test_createTopologySnapshotStopsFailedNetwork(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name             string
+		waitForHealthErr error
+		saveSnapshotErr  error
+		expectedErr      string
+	}{
+		{
+			name:             "not healthy",
+			waitForHealthErr: errFailed,
+			expectedErr:      "failed waiting for the network to be healthy",
+		},
+		{
+			name:            "snapshot not saved",
+			saveSnapshotErr: errFailed,
+			expectedErr:     "failed to save network with the requested topology",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			app = testutils.SetupTestInTempDir(t)
+			snapshotName, numNodes = "topology", 2
+			defer func() {
+				snapshotName, numNodes = constants.DefaultSnapshotName, 0
+			}()
+			pluginDir := app.GetPluginsDir()
+			require.NoError(os.MkdirAll(pluginDir, constants.DefaultPerms755))
+			require.NoError(os.WriteFile(filepath.Join(pluginDir, "vm"), []byte{}, constants.WriteReadReadPerms))
+			outputDir := t.TempDir()
+
+			cli := &mocks.Client{}
+			cli.On("GetSnapshotNames", mock.Anything).Return([]string{}, nil)
+			cli.On("Start", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(&rpcpb.StartResponse{}, nil)
+			cli.On("WaitForHealthy", mock.Anything).Return(&rpcpb.WaitForHealthyResponse{}, tt.waitForHealthErr)
+			cli.On("SaveSnapshot", mock.Anything, snapshotName).Return(&rpcpb.SaveSnapshotResponse{}, tt.saveSnapshotErr)
+			cli.On("Stop", mock.Anything).Return(&rpcpb.StopResponse{}, nil)
+
+			err := createTopologySnapshot(context.Background(), cli, "odysseygo", outputDir, pluginDir, "")
+			require.ErrorContains(err, tt.expectedErr)
+			cli.AssertCalled(t, "Stop", mock.Anything)
+			require.NoDirExists(outputDir)
+			require.NoFileExists(filepath.Join(pluginDir, "vm"))
+		})
+	}
+}
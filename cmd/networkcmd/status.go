@@ -3,9 +3,13 @@
 package networkcmd
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
 	"github.com/DioneProtocol/odyssey-network-runner/server"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +27,31 @@ network is running and some basic stats about the network.`,
 	}
 }
 
+// localNetworkStatus is the --output json|yaml schema of network status.
+// Only Running is set if there is no local network running.
+type localNetworkStatus struct {
+	Running          bool                    `json:"running" yaml:"running"`
+	Healthy          bool                    `json:"healthy" yaml:"healthy"`
+	CustomVMsHealthy bool                    `json:"customVMsHealthy" yaml:"customVMsHealthy"`
+	Nodes            []localNodeStatus       `json:"nodes" yaml:"nodes"`
+	Blockchains      []localBlockchainStatus `json:"blockchains" yaml:"blockchains"`
+}
+
+type localNodeStatus struct {
+	Name   string `json:"name" yaml:"name"`
+	NodeID string `json:"nodeID" yaml:"nodeID"`
+	URI    string `json:"uri" yaml:"uri"`
+}
+
+// localBlockchainStatus describes a custom VM blockchain deployed to the local network.
+// Endpoints maps node names to the blockchain RPC endpoint of the node.
+type localBlockchainStatus struct {
+	BlockchainID string            `json:"blockchainID" yaml:"blockchainID"`
+	ChainName    string            `json:"chainName" yaml:"chainName"`
+	VMID         string            `json:"vmID" yaml:"vmID"`
+	Endpoints    map[string]string `json:"endpoints" yaml:"endpoints"`
+}
+
 func networkStatus(*cobra.Command, []string) error {
 	ux.Logger.PrintToUser("Requesting network status...")
 
@@ -36,14 +65,23 @@ func networkStatus(*cobra.Command, []string) error {
 	status, err := cli.Status(ctx)
 	if err != nil {
 		if server.IsServerError(err, server.ErrNotBootstrapped) {
-			ux.Logger.PrintToUser("No local network running")
-			return nil
+			return ux.Render(localNetworkStatus{}, func() {
+				ux.Logger.PrintToUser("No local network running")
+			})
 		}
 		return err
 	}
 
-	// TODO: This layout may break some screens, is there a "failsafe" way?
-	if status != nil && status.ClusterInfo != nil {
+	if status == nil || status.ClusterInfo == nil {
+		return ux.Render(localNetworkStatus{}, func() {
+			ux.Logger.PrintToUser("No local network running")
+		})
+	}
+
+	// TODO: verbose output?
+	// ux.Logger.PrintToUser(status.String())
+	return ux.Render(getLocalNetworkStatus(status.ClusterInfo), func() {
+		// TODO: This layout may break some screens, is there a "failsafe" way?
 		ux.Logger.PrintToUser("Network is Up. Network information:")
 		ux.Logger.PrintToUser("==================================================================================================")
 		ux.Logger.PrintToUser("Healthy: %t", status.ClusterInfo.Healthy)
@@ -60,12 +98,42 @@ func networkStatus(*cobra.Command, []string) error {
 				ux.Logger.PrintToUser("Endpoint at %s for blockchain %q: %s/ext/bc/%s/rpc", nodeInfo.Name, blockchainID, nodeInfo.GetUri(), blockchainID)
 			}
 		}
-	} else {
-		ux.Logger.PrintToUser("No local network running")
-	}
-
-	// TODO: verbose output?
-	// ux.Logger.PrintToUser(status.String())
+	})
+}
 
-	return nil
+func getLocalNetworkStatus(clusterInfo *rpcpb.ClusterInfo) localNetworkStatus {
+	networkStatus := localNetworkStatus{
+		Running:          true,
+		Healthy:          clusterInfo.Healthy,
+		CustomVMsHealthy: clusterInfo.CustomChainsHealthy,
+		Nodes:            []localNodeStatus{},
+		Blockchains:      []localBlockchainStatus{},
+	}
+	for _, nodeName := range clusterInfo.NodeNames {
+		nodeInfo, ok := clusterInfo.NodeInfos[nodeName]
+		if !ok {
+			continue
+		}
+		networkStatus.Nodes = append(networkStatus.Nodes, localNodeStatus{
+			Name:   nodeInfo.Name,
+			NodeID: nodeInfo.Id,
+			URI:    nodeInfo.Uri,
+		})
+	}
+	for blockchainID, chainInfo := range clusterInfo.CustomChains {
+		blockchainStatus := localBlockchainStatus{
+			BlockchainID: blockchainID,
+			ChainName:    chainInfo.ChainName,
+			VMID:         chainInfo.VmId,
+			Endpoints:    map[string]string{},
+		}
+		for _, node := range networkStatus.Nodes {
+			blockchainStatus.Endpoints[node.Name] = fmt.Sprintf("%s/ext/bc/%s/rpc", node.URI, blockchainID)
+		}
+		networkStatus.Blockchains = append(networkStatus.Blockchains, blockchainStatus)
+	}
+	sort.Slice(networkStatus.Blockchains, func(i, j int) bool {
+		return networkStatus.Blockchains[i].BlockchainID < networkStatus.Blockchains[j].BlockchainID
+	})
+	return networkStatus
 }
@@ -0,0 +1,173 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+func newAddHostCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-host [clusterName] [IP]...",
+		Short: "(ALPHA Warning) Set up validators on existing machines",
+		Long: `(ALPHA Warning) This command is currently in experimental mode.
+
+The node add-host command registers already running machines (e.g. bare-metal
+or on-premise servers) into the cluster <clusterName>, creating it if needed,
+and sets up a validator on each of them, the same way node create does on
+cloud servers. The machines can then be managed with the node subcommands
+like cloud ones.
+
+The machines must run Ubuntu and be reachable through SSH as user ubuntu,
+using the key given with --ssh-key or the ssh agent.`,
+		SilenceUsage: true,
+		Args:         cobra.MinimumNArgs(2),
+		RunE:         addHosts,
+	}
+	cmd.Flags().StringVar(&sshKeyPath, "ssh-key", "", "ssh private key to use for the machines")
+	cmd.Flags().BoolVar(&useSSHAgent, "use-ssh-agent", false, "use ssh agent(ex: Yubikey) for ssh auth")
+	cmd.Flags().StringVar(&sshIdentity, "ssh-agent-identity", "", "use given ssh identity(only for ssh agent). If not set, default will be used.")
+	cmd.Flags().BoolVar(&createOnTestnet, "testnet", false, "set up nodes in Testnet Network (for new clusters)")
+	cmd.Flags().BoolVar(&createDevnet, "devnet", false, "set up nodes into a new Devnet (for new clusters)")
+	cmd.Flags().BoolVar(&useLatestOdysseygoVersion, "latest-odysseygo-version", false, "install latest odysseygo version on node/s")
+	cmd.Flags().StringVar(&useCustomOdysseygoVersion, "custom-odysseygo-version", "", "install given odysseygo version on node/s")
+	cmd.Flags().StringVar(&useOdysseygoVersionFromSubnet, "odysseygo-version-from-subnet", "", "install latest odysseygo version, that is compatible with the given subnet, on node/s")
+	cmd.Flags().BoolVar(&sameMonitoringInstance, "same-monitoring-instance", false, "host monitoring for the machines on the same machine")
+	cmd.Flags().BoolVar(&skipMonitoring, "skip-monitoring", false, "don't set up monitoring in the machines")
+	return cmd
+}
+
+func addHosts(cmd *cobra.Command, args []string) error {
+	clusterName := args[0]
+	existingHosts = args[1:]
+	exists, err := clusterExists(clusterName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		// hosts are added with the network of the cluster
+		clustersConfig, err := app.LoadClustersConfig()
+		if err != nil {
+			return err
+		}
+		switch clustersConfig.Clusters[clusterName].Network.Kind {
+		case models.Testnet:
+			createOnTestnet = true
+		case models.Devnet:
+			createDevnet = true
+		}
+	}
+	return createNodes(cmd, []string{clusterName})
+}
+
+func preExistingHostsChecks() error {
+	if existingHostsFile != "" && len(existingHosts) > 0 {
+		return fmt.Errorf("could not use both existing hosts file and existing hosts list")
+	}
+	if useAWS || useGCP {
+		return fmt.Errorf("could not use cloud options for existing hosts")
+	}
+	if len(cmdLineRegion) > 0 || len(numNodes) > 0 || nodeType != "" {
+		return fmt.Errorf("could not use region, number of nodes or node type for existing hosts")
+	}
+	if separateMonitoringInstance {
+		return fmt.Errorf("separate monitoring instance is not supported for existing hosts")
+	}
+	if sshKeyPath != "" && useSSHAgent {
+		return fmt.Errorf("could not use both ssh key and ssh agent")
+	}
+	if sshKeyPath == "" && !useSSHAgent {
+		return fmt.Errorf("an ssh key or the ssh agent is required to access existing hosts")
+	}
+	if sshKeyPath != "" && !utils.FileExists(sshKeyPath) {
+		return fmt.Errorf("ssh key %s not found", sshKeyPath)
+	}
+	if sshIdentity != "" && !useSSHAgent {
+		return fmt.Errorf("could not use ssh identity without using ssh agent")
+	}
+	if useSSHAgent && !utils.IsSSHAgentAvailable() {
+		return fmt.Errorf("ssh agent is not available")
+	}
+	return nil
+}
+
+// getExistingHostsConfig returns the cloud config and public IP map describing
+// the existing hosts given either in the existing hosts file or in the command line
+func getExistingHostsConfig() (models.CloudConfig, map[string]string, error) {
+	ips := existingHosts
+	if existingHostsFile != "" {
+		var err error
+		ips, err = parseExistingHostsFile(existingHostsFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := validateExistingHosts(ips); err != nil {
+		return nil, nil, err
+	}
+	// existing hosts are always reached at the given IPs
+	useStaticIP = true
+	publicIPMap := map[string]string{}
+	for _, ip := range ips {
+		publicIPMap[ip] = ip
+	}
+	return models.CloudConfig{
+		constants.ExistingHostsRegion: {
+			InstanceIDs:  ips,
+			PublicIPs:    ips,
+			CertFilePath: sshKeyPath,
+			NumNodes:     len(ips),
+		},
+	}, publicIPMap, nil
+}
+
+// parseExistingHostsFile reads the IPs listed in the existing hosts file at [path],
+// one per line. Empty lines and lines starting with # are ignored.
+func parseExistingHostsFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read existing hosts file: %w", err)
+	}
+	defer file.Close()
+	ips := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ips = append(ips, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no hosts found in existing hosts file %s", path)
+	}
+	return ips, nil
+}
+
+// validateExistingHosts checks that [ips] are unique valid IPs not already registered in any cluster
+func validateExistingHosts(ips []string) error {
+	if len(utils.Unique(ips)) != len(ips) {
+		return fmt.Errorf("existing hosts list contains duplicated IPs")
+	}
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid IP %q for existing host", ip)
+		}
+		if utils.DirectoryExists(app.GetNodeInstanceDirPath(ip)) {
+			return fmt.Errorf("host %s is already registered", ip)
+		}
+	}
+	return nil
+}
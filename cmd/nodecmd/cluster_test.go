@@ -0,0 +1,668 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
+	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils/fakenode"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud/fake"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClusterName      = "cluster1"
+	testSubnetName       = "subnet1"
+	testOdysseyGoVersion = "v1.10.10"
+	testSubnetEVMVersion = "v0.5.6"
+	testRPCVersion       = 28
+)
+
+var testClusterNetwork = models.NewDevnetNetwork(fakenode.LocalIP, constants.OdysseygoAPIPort)
+
+// setupClusterTest creates a cluster of [count] fake nodes, and mocks the release
+// and compatibility downloads
+func setupClusterTest(t *testing.T, count int) *fakenode.Cluster {
+	return setupClusterTestOnNetwork(t, count, testClusterNetwork)
+}
+
+// setupClusterTestOnNetwork creates a cluster of [count] fake nodes on [network], and
+// mocks the release and compatibility downloads
+func setupClusterTestOnNetwork(t *testing.T, count int, network models.Network) *fakenode.Cluster {
+	app = testutils.SetupTestInTempDir(t)
+	subnetcmd.NewCmd(app)
+	// flag default, as the commands are run without parsing their flags
+	upgradeBatchSize = 1
+	mockDownloader := &mocks.Downloader{}
+	mockDownloader.On("Download", constants.SubnetEVMRPCCompatibilityURL).Return(
+		[]byte(fmt.Sprintf(`{"rpcChainVMProtocolVersion":{"v0.5.6":%d,"v0.5.7":%d}}`, testRPCVersion, testRPCVersion)), nil)
+	mockDownloader.On("Download", constants.OdysseyGoCompatibilityURL).Return(
+		[]byte(fmt.Sprintf(`{"%d":["v1.10.10","v1.10.11"]}`, testRPCVersion)), nil)
+	mockDownloader.On("GetLatestReleaseVersion", mock.Anything).Return("v1.10.11", nil)
+	app.Downloader = mockDownloader
+	require.NoError(t, os.MkdirAll(app.GetSubnetDir(), constants.DefaultPerms755))
+	return fakenode.NewCluster(t, app, fake.NewProvider(), testClusterName, network, count, testOdysseyGoVersion)
+}
+
+// createTestSubnet creates a Subnet EVM subnet deployed to the cluster network, and
+// returns its VM ID and blockchain ID
+func createTestSubnet(t *testing.T) (string, ids.ID) {
+	require := require.New(t)
+	blockchainID := ids.GenerateTestID()
+	sc := models.Sidecar{
+		Name:       testSubnetName,
+		VM:         models.SubnetEvm,
+		VMVersion:  testSubnetEVMVersion,
+		RPCVersion: testRPCVersion,
+		Subnet:     testSubnetName,
+		Networks: map[string]models.NetworkData{
+			testClusterNetwork.Name(): {
+				SubnetID:     ids.GenerateTestID(),
+				BlockchainID: blockchainID,
+			},
+		},
+	}
+	require.NoError(app.CreateSidecar(&sc))
+	require.NoError(app.WriteGenesisFile(testSubnetName, []byte(`{"config":{}}`)))
+	vmID, err := sc.GetVMID()
+	require.NoError(err)
+	return vmID, blockchainID
+}
+
+// getTestClusterStatus runs node status on the test cluster and parses its JSON output
+func getTestClusterStatus(t *testing.T, subnet string) clusterStatus {
+	require := require.New(t)
+	ux.SetOutputFormat(ux.OutputJSON)
+	defer ux.SetOutputFormat(ux.OutputTable)
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(err)
+	os.Stdout = w
+	subnetName = subnet
+	statusErr := statusNode(nil, []string{testClusterName})
+	subnetName = ""
+	os.Stdout = stdout
+	require.NoError(w.Close())
+	require.NoError(statusErr)
+	output, err := io.ReadAll(r)
+	require.NoError(err)
+	var result clusterStatus
+	require.NoError(json.Unmarshal(output, &result))
+	return result
+}
+
+// setupCreateTest sets the node create flags to create [count] nodes of the default type
+// on Testnet in [provider], reached through fake cloud machines, and serves the user IP
+func setupCreateTest(t *testing.T, provider *fake.Provider, count int) *fakenode.Cloud {
+	require := require.New(t)
+	usr, err := user.Current()
+	require.NoError(err)
+	setupCloudTest(t, usr)
+	app = testutils.SetupTestInTempDir(t)
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(os.WriteFile(configPath, []byte("{}"), constants.WriteReadReadPerms))
+	viper.SetConfigFile(configPath)
+	t.Cleanup(func() { viper.SetConfigFile("") })
+
+	userIPServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"ip":%q}`, testUserIP)
+	}))
+	t.Cleanup(userIPServer.Close)
+	defaultUserIPAddressURL := userIPAddressURL
+	userIPAddressURL = userIPServer.URL
+	t.Cleanup(func() { userIPAddressURL = defaultUserIPAddressURL })
+
+	createOnTestnet = true
+	useAWS = true
+	authorizeAccess = true
+	cmdLineRegion = []string{fakenode.Location}
+	numNodes = []int{count}
+	nodeType = "default"
+	useCustomOdysseygoVersion = "v1.10.11"
+	skipMonitoring = true
+	t.Cleanup(func() {
+		createOnTestnet = false
+		useAWS = false
+		authorizeAccess = false
+		cmdLineRegion = nil
+		numNodes = nil
+		nodeType = ""
+		useCustomOdysseygoVersion = ""
+		skipMonitoring = false
+	})
+	return fakenode.NewCloud(t, provider)
+}
+
+func TestCreateCluster(t *testing.T) {
+	require := require.New(t)
+	provider := fake.NewProvider()
+	fakeCloud := setupCreateTest(t, provider, 2)
+
+	require.NoError(createCluster(testClusterName, map[string]cloud.Provider{constants.AWSCloudService: provider}))
+
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(err)
+	clusterConfig := clustersConfig.Clusters[testClusterName]
+	require.Equal(models.Testnet, clusterConfig.Network.Kind)
+	require.Len(clusterConfig.Nodes, 2)
+	require.Len(provider.Instances, 2)
+	usr, err := user.Current()
+	require.NoError(err)
+	securityGroup := getCloudResourceNames(fake.CloudService, fakenode.Location, usr).securityGroup
+	require.Contains(provider.SecurityGroups[fakenode.Location+"/"+securityGroup].Rules, testUserIP)
+
+	hosts, err := getClusterHosts(testClusterName)
+	require.NoError(err)
+	defer disconnectHosts(hosts)
+	require.Len(hosts, 2)
+	for _, host := range hosts {
+		instanceID := host.GetCloudID()
+		require.Contains(clusterConfig.Nodes, instanceID)
+		require.Equal(fake.InstanceRunning, provider.Instances[instanceID].State)
+		server := fakeCloud.Server(instanceID)
+		require.NotNil(server)
+		require.Equal("v1.10.11", server.Node.GetOdysseyGoVersion())
+		require.True(server.Node.IsRunning())
+		// the host key presented on the first connection is pinned
+		require.Equal(models.MarshalHostKey(server.HostKey), clusterConfig.HostKeys[host.NodeID])
+		// the staking cert generated for the node is uploaded to it
+		require.NoError(host.Connect())
+		downloadedCert := filepath.Join(t.TempDir(), constants.StakerCertFileName)
+		require.NoError(host.Download(filepath.Join(constants.CloudNodeStakingPath, constants.StakerCertFileName), downloadedCert, constants.SSHFileOpsTimeout))
+		localCert, err := os.ReadFile(filepath.Join(app.GetNodeInstanceDirPath(instanceID), constants.StakerCertFileName))
+		require.NoError(err)
+		remoteCert, err := os.ReadFile(downloadedCert)
+		require.NoError(err)
+		require.Equal(localCert, remoteCert)
+	}
+}
+
+func TestStatusCluster(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+
+	result := getTestClusterStatus(t, "")
+	require.Equal(testClusterName, result.Cluster)
+	require.Len(result.Nodes, 2)
+	for i, node := range result.Nodes {
+		require.Equal(cluster.InstanceIDs[i], node.CloudID)
+		require.Equal(cluster.Node(i).NodeID.String(), node.NodeID)
+		require.Equal(testOdysseyGoVersion, node.OdysseyGoVersion)
+		require.True(node.Healthy)
+		require.True(node.Bootstrapped)
+	}
+
+	cluster.Node(1).Update(func(n *fakenode.Node) {
+		n.Healthy = false
+		n.Bootstrapped = false
+	})
+	result = getTestClusterStatus(t, "")
+	require.True(result.Nodes[0].Healthy)
+	require.False(result.Nodes[1].Healthy)
+	require.False(result.Nodes[1].Bootstrapped)
+}
+
+func TestStatusClusterSubnet(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+	_, blockchainID := createTestSubnet(t)
+	cluster.Node(0).Update(func(n *fakenode.Node) {
+		n.BlockchainStatus[blockchainID.String()] = status.Validating
+	})
+	cluster.Node(1).Update(func(n *fakenode.Node) {
+		n.BlockchainStatus[blockchainID.String()] = status.Syncing
+	})
+
+	result := getTestClusterStatus(t, testSubnetName)
+	require.Equal("VALIDATING", result.Nodes[0].SubnetStatus)
+	require.Equal("SYNCED", result.Nodes[1].SubnetStatus)
+}
+
+func TestSyncCluster(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+	createTestSubnet(t)
+
+	require.NoError(syncSubnet(nil, []string{testClusterName, testSubnetName}))
+	for _, node := range cluster.Nodes {
+		require.Equal([]string{testSubnetName}, node.GetSubnets())
+		require.True(node.IsRunning())
+	}
+}
+
+func TestSyncClusterNotReady(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+	createTestSubnet(t)
+
+	cluster.Node(1).Update(func(n *fakenode.Node) { n.Healthy = false })
+	require.ErrorContains(syncSubnet(nil, []string{testClusterName, testSubnetName}), "not healthy")
+
+	cluster.Node(1).Update(func(n *fakenode.Node) {
+		n.Healthy = true
+		n.Files[constants.CloudNodeOdysseyGoBinaryPath] = "v1.9.0"
+	})
+	require.ErrorContains(syncSubnet(nil, []string{testClusterName, testSubnetName}), "incompatible")
+	for _, node := range cluster.Nodes {
+		require.Empty(node.GetSubnets())
+	}
+}
+
+func TestUpgradeCluster(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 3)
+	vmID, blockchainID := createTestSubnet(t)
+	for _, node := range cluster.Nodes {
+		node.SetVMVersion(vmID, testSubnetEVMVersion)
+		node.Update(func(n *fakenode.Node) {
+			n.BlockchainStatus[blockchainID.String()] = status.Validating
+		})
+	}
+	upgradeBatchSize = 2
+	upgradeOdysseyGoVersion = "v1.10.11"
+	upgradeSubnetEVMVersion = "v0.5.7"
+	defer func() {
+		upgradeBatchSize = 1
+		upgradeOdysseyGoVersion = ""
+		upgradeSubnetEVMVersion = ""
+	}()
+
+	require.NoError(upgrade(nil, []string{testClusterName}))
+	for _, instanceID := range cluster.InstanceIDs {
+		node := cluster.Nodes[instanceID]
+		require.Equal("v1.10.11", node.GetOdysseyGoVersion())
+		require.Equal(map[string]string{vmID: "v0.5.7"}, node.GetVMVersions())
+		require.True(node.IsRunning())
+		nodeConfig, err := app.LoadClusterNodeConfig(instanceID)
+		require.NoError(err)
+		require.Len(nodeConfig.VersionHistory, 1)
+		require.Equal(testOdysseyGoVersion, nodeConfig.VersionHistory[0].OdysseyGoVersion)
+		require.Equal(map[string]string{vmID: testSubnetEVMVersion}, nodeConfig.VersionHistory[0].VMVersions)
+	}
+
+	// nodes are up to date
+	require.NoError(upgrade(nil, []string{testClusterName}))
+
+	require.NoError(rollback(nil, []string{testClusterName}))
+	for _, instanceID := range cluster.InstanceIDs {
+		node := cluster.Nodes[instanceID]
+		require.Equal(testOdysseyGoVersion, node.GetOdysseyGoVersion())
+		require.Equal(map[string]string{vmID: testSubnetEVMVersion}, node.GetVMVersions())
+		nodeConfig, err := app.LoadClusterNodeConfig(instanceID)
+		require.NoError(err)
+		require.Empty(nodeConfig.VersionHistory)
+	}
+}
+
+func TestUpgradeClusterAbort(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 3)
+	upgradeOdysseyGoVersion = "v1.10.11"
+	upgradeSubnetEVMVersion = testSubnetEVMVersion
+	hostRetries = 2
+	defer func() {
+		upgradeOdysseyGoVersion = ""
+		upgradeSubnetEVMVersion = ""
+		hostRetries = 0
+	}()
+	errInstall := errors.New("installer failed")
+	cluster.Node(1).Update(func(n *fakenode.Node) {
+		n.Fail["./odysseygo-installer.sh"] = errInstall
+	})
+
+	// the first batch is upgraded, and the upgrade stops at the failed one
+	require.ErrorContains(upgrade(nil, []string{testClusterName}), "failed to upgrade")
+	require.Equal("v1.10.11", cluster.Node(0).GetOdysseyGoVersion())
+	require.Equal(testOdysseyGoVersion, cluster.Node(1).GetOdysseyGoVersion())
+	require.Equal(testOdysseyGoVersion, cluster.Node(2).GetOdysseyGoVersion())
+	require.Empty(cluster.Node(2).GetScripts())
+	// the failed upgrade is not retried
+	installs := 0
+	for _, script := range cluster.Node(1).GetScripts() {
+		installs += strings.Count(script, "./odysseygo-installer.sh")
+	}
+	require.Equal(1, installs)
+
+	// the other nodes are upgraded with continue-on-error
+	continueOnError = true
+	defer func() { continueOnError = false }()
+	require.ErrorContains(upgrade(nil, []string{testClusterName}), "failed to upgrade")
+	require.Equal(testOdysseyGoVersion, cluster.Node(1).GetOdysseyGoVersion())
+	require.Equal("v1.10.11", cluster.Node(2).GetOdysseyGoVersion())
+}
+
+func TestUpgradeClusterIncompatibleOdysseyGo(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+	vmID, _ := createTestSubnet(t)
+	for _, node := range cluster.Nodes {
+		node.SetVMVersion(vmID, testSubnetEVMVersion)
+	}
+	upgradeOdysseyGoVersion = "v1.10.9"
+	upgradeSubnetEVMVersion = "v0.5.7"
+	defer func() {
+		upgradeOdysseyGoVersion = ""
+		upgradeSubnetEVMVersion = ""
+	}()
+
+	// the pinned odysseygo version does not run the RPC protocol of the Subnet EVM version
+	require.ErrorContains(upgrade(nil, []string{testClusterName}), "failed to upgrade")
+	for _, node := range cluster.Nodes {
+		require.Equal(testOdysseyGoVersion, node.GetOdysseyGoVersion())
+		require.Equal(map[string]string{vmID: testSubnetEVMVersion}, node.GetVMVersions())
+	}
+	require.ErrorContains(checkOdygoVersionForRPC("v1.10.9", testRPCVersion, "v0.5.7"), "not compatible with Subnet EVM v0.5.7")
+	require.NoError(checkOdygoVersionForRPC("v1.10.11", testRPCVersion, "v0.5.7"))
+}
+
+func TestBackupBinary(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 1)
+	hosts, err := getClusterHosts(testClusterName)
+	require.NoError(err)
+	defer disconnectHosts(hosts)
+	host, node := hosts[0], cluster.Node(0)
+	binaryPath := constants.CloudNodeOdysseyGoBinaryPath
+	backupBinaryPath := getBackupBinaryPath(constants.OdysseyGoRepoName, testOdysseyGoVersion)
+
+	// the version of the binary is checked before keeping it
+	require.ErrorContains(backupBinary(host, binaryPath, backupBinaryPath, "v1.10.9"), `is at version "v1.10.10" instead of v1.10.9`)
+	require.NotContains(node.Files, backupBinaryPath)
+
+	require.NoError(backupBinary(host, binaryPath, backupBinaryPath, testOdysseyGoVersion))
+	require.Equal(testOdysseyGoVersion, node.Files[backupBinaryPath])
+
+	// a backup at the same version is kept, and one at another version is never overwritten
+	scriptsCount := len(node.GetScripts())
+	require.NoError(backupBinary(host, binaryPath, backupBinaryPath, testOdysseyGoVersion))
+	for _, script := range node.GetScripts()[scriptsCount:] {
+		require.NotContains(script, "cp ")
+	}
+	node.Update(func(n *fakenode.Node) { n.Files[backupBinaryPath] = "v1.10.11" })
+	require.ErrorContains(backupBinary(host, binaryPath, backupBinaryPath, testOdysseyGoVersion), "refusing to overwrite it")
+	require.Equal("v1.10.11", node.Files[backupBinaryPath])
+}
+
+// setTestVersionHistory records [history] as the upgrades of all the nodes of [cluster],
+// and keeps on the nodes the odysseygo binaries replaced by them
+func setTestVersionHistory(t *testing.T, cluster *fakenode.Cluster, history []models.NodeVersions) {
+	require := require.New(t)
+	for instanceID, node := range cluster.Nodes {
+		nodeConfig, err := app.LoadClusterNodeConfig(instanceID)
+		require.NoError(err)
+		nodeConfig.VersionHistory = history
+		require.NoError(app.CreateNodeCloudConfigFile(instanceID, &nodeConfig))
+		node.Update(func(n *fakenode.Node) {
+			for _, versions := range history {
+				n.Files[getBackupBinaryPath(constants.OdysseyGoRepoName, versions.OdysseyGoVersion)] = versions.OdysseyGoVersion
+			}
+		})
+	}
+}
+
+func TestRollbackClusterToVersion(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+	setTestVersionHistory(t, cluster, []models.NodeVersions{
+		{OdysseyGoVersion: "v1.10.8"},
+		{OdysseyGoVersion: "v1.10.9"},
+	})
+	rollbackToVersion = "v1.10.9"
+	defer func() { rollbackToVersion = "" }()
+
+	// the history entry that replaced v1.10.9 is undone, and the previous one kept
+	require.NoError(rollback(nil, []string{testClusterName}))
+	for _, instanceID := range cluster.InstanceIDs {
+		require.Equal("v1.10.9", cluster.Nodes[instanceID].GetOdysseyGoVersion())
+		require.True(cluster.Nodes[instanceID].IsRunning())
+		nodeConfig, err := app.LoadClusterNodeConfig(instanceID)
+		require.NoError(err)
+		require.Equal([]models.NodeVersions{{OdysseyGoVersion: "v1.10.8"}}, nodeConfig.VersionHistory)
+	}
+
+	rollbackToVersion = "v1.10.9"
+	require.ErrorContains(rollback(nil, []string{testClusterName}), "no upgrade from version v1.10.9")
+}
+
+func TestRollbackClusterNoHistory(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+
+	require.ErrorContains(rollback(nil, []string{testClusterName}), "no upgrades recorded for the node")
+	for _, node := range cluster.Nodes {
+		require.Empty(node.GetScripts())
+		require.True(node.IsRunning())
+	}
+}
+
+func TestRollbackClusterPartialHistory(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 3)
+	setTestVersionHistory(t, cluster, []models.NodeVersions{{OdysseyGoVersion: "v1.10.9"}})
+	// the second node was left out of the upgrade
+	nodeConfig, err := app.LoadClusterNodeConfig(cluster.InstanceIDs[1])
+	require.NoError(err)
+	nodeConfig.VersionHistory = nil
+	require.NoError(app.CreateNodeCloudConfigFile(cluster.InstanceIDs[1], &nodeConfig))
+
+	require.NoError(rollback(nil, []string{testClusterName}))
+	require.Equal("v1.10.9", cluster.Node(0).GetOdysseyGoVersion())
+	require.Equal(testOdysseyGoVersion, cluster.Node(1).GetOdysseyGoVersion())
+	require.Empty(cluster.Node(1).GetScripts())
+	require.Equal("v1.10.9", cluster.Node(2).GetOdysseyGoVersion())
+
+	// no node has an upgrade left to roll back
+	require.ErrorContains(rollback(nil, []string{testClusterName}), "can't roll back any node")
+}
+
+func TestRollbackClusterAbort(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 3)
+	setTestVersionHistory(t, cluster, []models.NodeVersions{{OdysseyGoVersion: "v1.10.9"}})
+	errRestore := errors.New("restore failed")
+	cluster.Node(1).Update(func(n *fakenode.Node) {
+		n.Fail["cp -f "+getBackupBinaryPath(constants.OdysseyGoRepoName, "v1.10.9")] = errRestore
+	})
+
+	// nodes are rolled back one batch at a time, and the rollback stops at the failed one
+	require.ErrorContains(rollback(nil, []string{testClusterName}), "failed to roll back")
+	require.Equal("v1.10.9", cluster.Node(0).GetOdysseyGoVersion())
+	require.Equal(testOdysseyGoVersion, cluster.Node(1).GetOdysseyGoVersion())
+	require.Equal(testOdysseyGoVersion, cluster.Node(2).GetOdysseyGoVersion())
+	require.Empty(cluster.Node(2).GetScripts())
+	require.True(cluster.Node(2).IsRunning())
+}
+
+func TestValidatePrimaryClusterNotReady(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+
+	cluster.Node(0).Update(func(n *fakenode.Node) { n.Bootstrapped = false })
+	require.ErrorContains(validatePrimaryNetwork(nil, []string{testClusterName}), "not bootstrapped")
+
+	cluster.Node(0).Update(func(n *fakenode.Node) {
+		n.Bootstrapped = true
+		n.Healthy = false
+	})
+	require.ErrorContains(validatePrimaryNetwork(nil, []string{testClusterName}), "not healthy")
+}
+
+// setupValidateTest creates a cluster of [count] fake nodes on a devnet served by a fake
+// chain, where the ewoq key is funded and owns the test subnet. The nodes are syncing the
+// subnet. It returns the cluster, the chain and the subnet ID
+func setupValidateTest(t *testing.T, count int) (*fakenode.Cluster, *fakenode.Chain, ids.ID) {
+	require := require.New(t)
+	chain := fakenode.NewChain(t, constants.DevnetNetworkID)
+	cluster := setupClusterTestOnNetwork(t, count, models.NewNetwork(models.Devnet, constants.DevnetNetworkID, chain.URL))
+	ewoq, err := key.LoadEwoq(constants.DevnetNetworkID)
+	require.NoError(err)
+	ewoqAddr := ewoq.Addresses()[0]
+	chain.Fund(ewoqAddr, 100*units.KiloDione)
+
+	_, blockchainID := createTestSubnet(t)
+	sc, err := app.LoadSidecar(testSubnetName)
+	require.NoError(err)
+	subnetID := chain.CreateSubnet(t, ewoqAddr)
+	networkData := sc.Networks[testClusterNetwork.Name()]
+	networkData.SubnetID = subnetID
+	sc.Networks[testClusterNetwork.Name()] = networkData
+	require.NoError(app.UpdateSidecar(&sc))
+	for _, node := range cluster.Nodes {
+		node.Update(func(n *fakenode.Node) { n.BlockchainStatus[blockchainID.String()] = status.Syncing })
+	}
+
+	weight = fakenode.MinValidatorStake
+	useCustomDuration = true
+	duration = 30 * 24 * time.Hour
+	defaultValidatorParams = true
+	t.Cleanup(func() {
+		weight = 0
+		useCustomDuration = false
+		duration = 0
+		defaultValidatorParams = false
+	})
+	return cluster, chain, subnetID
+}
+
+func TestValidateCluster(t *testing.T) {
+	require := require.New(t)
+	cluster, chain, subnetID := setupValidateTest(t, 2)
+
+	require.NoError(validatePrimaryNetwork(nil, []string{testClusterName}))
+	for _, node := range cluster.Nodes {
+		require.True(chain.IsValidator(ids.Empty, node.NodeID))
+		require.False(chain.IsValidator(subnetID, node.NodeID))
+	}
+
+	// the nodes are already Primary Network validators, so only subnet validator txs are issued
+	require.NoError(validateSubnet(nil, []string{testClusterName, testSubnetName}))
+	for _, node := range cluster.Nodes {
+		require.True(chain.IsValidator(subnetID, node.NodeID))
+	}
+}
+
+func TestStopCluster(t *testing.T) {
+	require := require.New(t)
+	cluster := setupClusterTest(t, 2)
+
+	providers := map[string]cloud.Provider{fake.CloudService: cluster.Provider}
+	require.NoError(stopCluster(testClusterName, providers))
+	for _, instanceID := range cluster.InstanceIDs {
+		require.Equal(fake.InstanceStopped, cluster.Provider.Instances[instanceID].State)
+		require.NoDirExists(app.GetNodeInstanceDirPath(instanceID))
+	}
+	require.NoDirExists(app.GetAnsibleInventoryDirPath(testClusterName))
+	exists, err := clusterExists(testClusterName)
+	require.NoError(err)
+	require.False(exists)
+}
+
+func TestAddExistingHosts(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	servers, keyPath := fakenode.NewExistingHosts(t, testClusterName, 2, testOdysseyGoVersion)
+	ips := []string{"127.0.0.1", "127.0.0.2"}
+	existingHosts, sshKeyPath = ips, keyPath
+	defer func() {
+		existingHosts, sshKeyPath, useStaticIP = nil, "", false
+	}()
+
+	// registers the hosts as node create does for --existing-hosts
+	require.NoError(preExistingHostsChecks())
+	cloudConfigMap, publicIPMap, err := getExistingHostsConfig()
+	require.NoError(err)
+	require.NoError(createClusterNodeConfig(testClusterNetwork, cloudConfigMap, models.RegionConfig{}, "", testClusterName, constants.ExistingHostsService, false))
+	inventoryPath := app.GetAnsibleInventoryDirPath(testClusterName)
+	require.NoError(ansible.CreateAnsibleHostInventory(inventoryPath, "", constants.ExistingHostsService, publicIPMap, cloudConfigMap, app.GetSSHKnownHostsPath(testClusterName)))
+	require.ErrorContains(validateExistingHosts(ips[:1]), "already registered")
+
+	inventory, err := ansible.GetInventoryFromAnsibleInventoryFile(inventoryPath)
+	require.NoError(err)
+	require.Len(inventory, len(ips))
+	for i, host := range inventory {
+		require.Equal(constants.ExistingNodeAnsiblePrefix+"_"+ips[i], host.NodeID)
+		require.Equal(ips[i], host.GetCloudID())
+		require.Equal(ips[i], host.IP)
+		require.Equal(constants.AnsibleSSHUser, host.SSHUser)
+		require.Equal(keyPath, host.SSHPrivateKeyPath)
+		require.Equal(fmt.Sprintf(constants.AnsibleSSHKnownHostsParams, app.GetSSHKnownHostsPath(testClusterName)), host.SSHCommonArgs)
+	}
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(err)
+	require.Equal(ips, clustersConfig.Clusters[testClusterName].Nodes)
+	require.Equal(testClusterNetwork, clustersConfig.Clusters[testClusterName].Network)
+	for _, ip := range ips {
+		nodeConfig, err := app.LoadClusterNodeConfig(ip)
+		require.NoError(err)
+		require.Equal(constants.ExistingHostsService, nodeConfig.CloudService)
+		require.Equal(ip, nodeConfig.ElasticIP)
+		require.True(nodeConfig.UseStaticIP)
+		require.Empty(getNodeCloudResources(nodeConfig))
+	}
+
+	// the fake hosts can't listen on the default SSH port, so the inventory is
+	// pointed to their servers
+	records := []string{}
+	for i, host := range inventory {
+		host.IP = fakenode.LocalIP
+		host.SSHPort = servers[i].Addr.Port
+		records = append(records, host.GetAnsibleInventoryRecord())
+	}
+	require.NoError(os.WriteFile(
+		filepath.Join(inventoryPath, constants.AnsibleHostInventoryFileName),
+		[]byte(strings.Join(records, "\n")+"\n"),
+		constants.WriteReadReadPerms,
+	))
+
+	// destroy stops odysseygo and forgets the hosts, but leaves the machines running
+	provider := fake.NewProvider()
+	plan, err := getDestroyPlan(testClusterName)
+	require.NoError(err)
+	require.Len(plan.nodes, len(ips))
+	require.Empty(plan.securityGroups)
+	require.Empty(plan.keyPairs)
+	require.NoError(executeDestroyPlan(testClusterName, plan, map[string]cloud.Provider{fake.CloudService: provider}))
+	require.Empty(provider.Instances)
+	exists, err := clusterExists(testClusterName)
+	require.NoError(err)
+	require.False(exists)
+	for i, server := range servers {
+		require.NoDirExists(app.GetNodeInstanceDirPath(ips[i]))
+		require.False(server.Node.IsRunning())
+		host := &models.Host{
+			NodeID:            inventory[i].NodeID,
+			IP:                fakenode.LocalIP,
+			SSHPort:           server.Addr.Port,
+			SSHUser:           constants.AnsibleSSHUser,
+			SSHPrivateKeyPath: keyPath,
+			HostKey:           models.MarshalHostKey(server.HostKey),
+		}
+		require.NoError(host.Connect())
+		require.NoError(host.Disconnect())
+	}
+}
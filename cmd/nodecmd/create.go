@@ -18,11 +18,11 @@ import (
 	"strings"
 	"sync"
 
-	awsAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/aws"
-
 	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
@@ -43,8 +43,55 @@ const (
 	odysseyGoReferenceChoiceLatest = "latest"
 	odysseyGoReferenceChoiceSubnet = "subnet"
 	odysseyGoReferenceChoiceCustom = "custom"
+
+	cloudServicePrompt       = "Which cloud service would you like to launch your Odyssey Node(s) in?"
+	odysseyGoVersionPrompt   = "What version of Odyssey Go would you like to install in the node?"
+	setUpMonitoringPrompt    = "Do you want to set up monitoring for your instances? (This enables you to monitor validator and machine metrics)"
+	separateMonitoringPrompt = "Do you want to set up a separate instance to host monitoring? (This enables you to monitor all your set up instances in one dashboard)"
+	customOdysseyGoPrompt    = "Which version of OdysseyGo would you like to install? (Use format v1.10.10)"
+	versionSubnetPrompt      = "Which Subnet would you like to use to choose the odyssey go version?"
+	instanceTypePrompt       = "Instance type to use"
+	customInstanceTypePrompt = "What instance type would you like to use?"
+	authorizeAccessPrompt    = "I authorize Odyssey-CLI to access my"
+	regionPrompt             = "Which %s do you want to set up your node(s) in?"
+	customRegionPrompt       = "Which %s do you want to set up your node in?"
+	numNodesPrompt           = "How many nodes do you want to set up in"
+	additionalRegionPrompt   = "Would you like to add additional"
+	sshIdentityPrompt        = "Which SSH identity do you want to use?"
+	keyPairNamePrompt        = "Key Pair Name"
+	gcpCredentialsPrompt     = "What is the filepath to the credentials JSON file?"
+	gcpCustomCredsPrompt     = "What is the custom filepath to the credentials JSON file?"
+	gcpProjectPrompt         = "What is the name of your Google Cloud project?"
+
+	awsLocationName = "AWS Region"
+	gcpLocationName = "Google Zone"
 )
 
+// createAnswerKeys are the stable answers file keys of the node creation prompts
+var createAnswerKeys = prompts.AnswerKeys{
+	cloudServicePrompt:       "node.cloud",
+	odysseyGoVersionPrompt:   "node.odysseyGoVersion",
+	customOdysseyGoPrompt:    "node.customOdysseyGoVersion",
+	versionSubnetPrompt:      "node.odysseyGoVersionSubnet",
+	setUpMonitoringPrompt:    "node.monitoring",
+	separateMonitoringPrompt: "node.separateMonitoring",
+	instanceTypePrompt:       "node.instanceType",
+	customInstanceTypePrompt: "node.customInstanceType",
+	authorizeAccessPrompt:    "node.authorizeAccess",
+	numNodesPrompt:           "node.numNodes",
+	additionalRegionPrompt:   "node.addRegion",
+	sshIdentityPrompt:        "node.sshIdentity",
+	keyPairNamePrompt:        "node.keyPairName",
+	gcpCredentialsPrompt:     "node.gcpCredentials",
+	gcpCustomCredsPrompt:     "node.gcpCustomCredentials",
+	gcpProjectPrompt:         "node.gcpProject",
+
+	fmt.Sprintf(regionPrompt, awsLocationName):       "node.regions",
+	fmt.Sprintf(regionPrompt, gcpLocationName):       "node.regions",
+	fmt.Sprintf(customRegionPrompt, awsLocationName): "node.customRegions",
+	fmt.Sprintf(customRegionPrompt, gcpLocationName): "node.customRegions",
+}
+
 var (
 	createOnTestnet               bool
 	createDevnet                  bool
@@ -66,6 +113,9 @@ var (
 	sshIdentity                   string
 	setUpMonitoring               bool
 	skipMonitoring                bool
+	existingHostsFile             string
+	existingHosts                 []string
+	sshKeyPath                    string
 )
 
 func newCreateCmd() *cobra.Command {
@@ -85,7 +135,13 @@ status by running odyssey node status
 
 The created node will be part of group of validators called <clusterName>
 and users can call node commands with <clusterName> so that the command
-will apply to all nodes in the cluster`,
+will apply to all nodes in the cluster
+
+Instead of creating cloud servers, --existing-hosts sets up validators on
+already running machines (e.g. bare-metal or on-premise servers), listed
+by IP address, one per line, in the given file. These machines must run
+Ubuntu and be reachable through SSH as user ubuntu, using the key given
+with --ssh-key or the ssh agent.`,
 		SilenceUsage: true,
 		Args:         cobra.ExactArgs(1),
 		RunE:         createNodes,
@@ -111,6 +167,24 @@ will apply to all nodes in the cluster`,
 	cmd.Flags().BoolVar(&sameMonitoringInstance, "same-monitoring-instance", false, "host monitoring for a cloud servers on the same instance")
 	cmd.Flags().BoolVar(&separateMonitoringInstance, "separate-monitoring-instance", false, "host monitoring for all cloud servers on a separate instance")
 	cmd.Flags().BoolVar(&skipMonitoring, "skip-monitoring", false, "don't set up monitoring in created nodes")
+	cmd.Flags().StringVar(&existingHostsFile, "existing-hosts", "", "set up nodes on the existing machines whose IPs are listed in the given file, instead of creating cloud servers")
+	cmd.Flags().StringVar(&sshKeyPath, "ssh-key", "", "ssh private key to use for the existing machines")
+
+	prompts.RegisterFlagHint(cmd, cloudServicePrompt, "--aws", "--gcp")
+	prompts.RegisterFlagHint(cmd, instanceTypePrompt, "--node-type")
+	prompts.RegisterFlagHint(cmd, customInstanceTypePrompt, "--node-type")
+	prompts.RegisterFlagHint(cmd, odysseyGoVersionPrompt, "--latest-odysseygo-version", "--custom-odysseygo-version", "--odysseygo-version-from-subnet")
+	prompts.RegisterFlagHint(cmd, setUpMonitoringPrompt, "--skip-monitoring", "--same-monitoring-instance", "--separate-monitoring-instance")
+	prompts.RegisterFlagHint(cmd, separateMonitoringPrompt, "--same-monitoring-instance", "--separate-monitoring-instance")
+	prompts.RegisterFlagHint(cmd, authorizeAccessPrompt, "--authorize-access")
+	prompts.RegisterFlagHint(cmd, "Which "+awsLocationName, "--region")
+	prompts.RegisterFlagHint(cmd, "Which "+gcpLocationName, "--region")
+	prompts.RegisterFlagHint(cmd, additionalRegionPrompt, "--region")
+	prompts.RegisterFlagHint(cmd, numNodesPrompt, "--num-nodes")
+	prompts.RegisterFlagHint(cmd, sshIdentityPrompt, "--ssh-agent-identity")
+	prompts.RegisterFlagHint(cmd, gcpCredentialsPrompt, "--gcp-credentials")
+	prompts.RegisterFlagHint(cmd, gcpProjectPrompt, "--gcp-project")
+	prompts.RegisterFlagHint(cmd, keyPairNamePrompt, "--alternative-key-pair-name")
 	return cmd
 }
 
@@ -121,6 +195,12 @@ func preCreateChecks() error {
 	if useAWS && useGCP {
 		return fmt.Errorf("could not use both AWS and GCP cloud options")
 	}
+	if existingHostsFile != "" || len(existingHosts) > 0 {
+		return preExistingHostsChecks()
+	}
+	if sshKeyPath != "" {
+		return fmt.Errorf("ssh key can only be given for existing hosts")
+	}
 	if !useAWS && awsProfile != constants.AWSDefaultCredential {
 		return fmt.Errorf("could not use AWS profile for non AWS cloud option")
 	}
@@ -147,14 +227,19 @@ func createNodes(_ *cobra.Command, args []string) error {
 	if err := preCreateChecks(); err != nil {
 		return err
 	}
-	clusterName := args[0]
+	return createCluster(args[0], map[string]cloud.Provider{})
+}
 
+// createCluster creates the nodes of [clusterName] using the cloud [providers], created as
+// needed, or on existing hosts, and sets them up
+func createCluster(clusterName string, providers map[string]cloud.Provider) error {
 	network, err := subnetcmd.GetNetworkFromCmdLineFlags(
 		false,
 		createDevnet,
 		createOnTestnet,
 		createOnMainnet,
 		"",
+		"",
 		false,
 		[]models.NetworkKind{models.Testnet, models.Devnet},
 	)
@@ -183,8 +268,6 @@ func createNodes(_ *cobra.Command, args []string) error {
 	}
 	cloudConfigMap := models.CloudConfig{}
 	publicIPMap := map[string]string{}
-	gcpProjectName := ""
-	gcpCredentialFilepath := ""
 	// set ssh-Key
 	if useSSHAgent && sshIdentity == "" {
 		sshIdentity, err = setSSHIdentity()
@@ -198,86 +281,45 @@ func createNodes(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	if cloudService == constants.AWSCloudService { // Get AWS Credential, region and AMI
-		if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.AWSCloudService) != nil) {
-			return fmt.Errorf("cloud access is required")
-		}
-		ec2SvcMap, ami, numNodesMap, err := getAWSCloudConfig(awsProfile)
-		regions := maps.Keys(ec2SvcMap)
+	if cloudService == constants.ExistingHostsService {
+		cloudConfigMap, publicIPMap, err = getExistingHostsConfig()
 		if err != nil {
 			return err
 		}
-		if existingMonitoringInstance == "" {
-			monitoringHostRegion = regions[0]
-		}
-		if !skipMonitoring {
-			setUpMonitoring, separateMonitoringInstance, err = promptSetUpMonitoring()
-			if err != nil {
-				return err
-			}
-		}
-		cloudConfigMap, err = createAWSInstances(ec2SvcMap, nodeType, numNodesMap, regions, ami, usr, false)
-		if err != nil {
-			return err
-		}
-		monitoringEc2SvcMap := make(map[string]*awsAPI.AwsCloud)
-		if separateMonitoringInstance && existingMonitoringInstance == "" {
-			monitoringEc2SvcMap[monitoringHostRegion] = ec2SvcMap[monitoringHostRegion]
-			monitoringCloudConfig, err := createAWSInstances(monitoringEc2SvcMap, nodeType, map[string]int{monitoringHostRegion: 1}, []string{monitoringHostRegion}, ami, usr, true)
-			if err != nil {
-				return err
-			}
-			monitoringNodeConfig = monitoringCloudConfig[regions[0]]
-		}
 		if existingMonitoringInstance != "" {
 			separateMonitoringInstance = true
 			monitoringNodeConfig, monitoringHostRegion, err = getNodeCloudConfig(existingMonitoringInstance)
 			if err != nil {
 				return err
 			}
-			monitoringEc2SvcMap, err = getAWSMonitoringEC2Svc(awsProfile, monitoringHostRegion)
-			if err != nil {
-				return err
-			}
-		}
-		if !useStaticIP && separateMonitoringInstance {
-			monitoringPublicIPMap, err := monitoringEc2SvcMap[monitoringHostRegion].GetInstancePublicIPs(monitoringNodeConfig.InstanceIDs)
-			if err != nil {
-				return err
+			if len(monitoringNodeConfig.PublicIPs) == 0 {
+				return fmt.Errorf("monitoring instance %s of cluster %s has no static IP", existingMonitoringInstance, clusterName)
 			}
-			monitoringNodeConfig.PublicIPs = []string{monitoringPublicIPMap[monitoringNodeConfig.InstanceIDs[0]]}
-		}
-		for _, region := range regions {
-			if !useStaticIP {
-				tmpIPMap, err := ec2SvcMap[region].GetInstancePublicIPs(cloudConfigMap[region].InstanceIDs)
+		} else if !skipMonitoring {
+			setUpMonitoring = sameMonitoringInstance
+			if !setUpMonitoring {
+				setUpMonitoring, err = app.Prompt.CaptureYesNo(setUpMonitoringPrompt)
 				if err != nil {
 					return err
 				}
-				for node, ip := range tmpIPMap {
-					publicIPMap[node] = ip
-				}
-			} else {
-				for i, node := range cloudConfigMap[region].InstanceIDs {
-					publicIPMap[node] = cloudConfigMap[region].PublicIPs[i]
-				}
-			}
-			if separateMonitoringInstance {
-				if err = AddMonitoringSecurityGroupRule(ec2SvcMap, monitoringNodeConfig.PublicIPs[0], cloudConfigMap[region].SecurityGroup, region); err != nil {
-					return err
-				}
 			}
 		}
 	} else {
-		if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.GCPCloudService) != nil) {
-			return fmt.Errorf("cloud access is required")
+		provider, err := getAuthorizedCloudProvider(providers, cloudService)
+		if err != nil {
+			return err
 		}
-		// Get GCP Credential, zone, Image ID, service account key file path, and GCP project name
-		gcpClient, zones, numNodes, imageID, credentialFilepath, projectName, err := getGCPConfig()
+		numNodesMap, err := getCloudLocationsNodeNum(cloudService)
+		if err != nil {
+			return err
+		}
+		locations := maps.Keys(numNodesMap)
+		imageIDs, err := getCloudImageIDs(provider, locations)
 		if err != nil {
 			return err
 		}
 		if existingMonitoringInstance == "" {
-			monitoringHostRegion = zones[0]
+			monitoringHostRegion = locations[0]
 		}
 		if !skipMonitoring {
 			setUpMonitoring, separateMonitoringInstance, err = promptSetUpMonitoring()
@@ -285,16 +327,20 @@ func createNodes(_ *cobra.Command, args []string) error {
 				return err
 			}
 		}
-		cloudConfigMap, err = createGCPInstance(usr, gcpClient, nodeType, numNodes, zones, imageID, clusterName, false)
+		userIPAddress, err := getIPAddress()
+		if err != nil {
+			return err
+		}
+		cloudConfigMap, err = createCloudInstances(provider, nodeType, numNodesMap, locations, imageIDs, usr, userIPAddress, false)
 		if err != nil {
 			return err
 		}
 		if separateMonitoringInstance && existingMonitoringInstance == "" {
-			monitoringCloudConfig, err := createGCPInstance(usr, gcpClient, nodeType, []int{1}, []string{monitoringHostRegion}, imageID, clusterName, true)
+			monitoringCloudConfig, err := createCloudInstances(provider, nodeType, map[string]int{monitoringHostRegion: 1}, []string{monitoringHostRegion}, imageIDs, usr, userIPAddress, true)
 			if err != nil {
 				return err
 			}
-			monitoringNodeConfig = monitoringCloudConfig[zones[0]]
+			monitoringNodeConfig = monitoringCloudConfig[monitoringHostRegion]
 		}
 		if existingMonitoringInstance != "" {
 			separateMonitoringInstance = true
@@ -302,64 +348,40 @@ func createNodes(_ *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
-		}
-		if !useStaticIP && separateMonitoringInstance {
-			monitoringPublicIPMap, err := gcpClient.GetInstancePublicIPs(monitoringHostRegion, monitoringNodeConfig.InstanceIDs)
-			if err != nil {
-				return err
-			}
-			monitoringNodeConfig.PublicIPs = []string{monitoringPublicIPMap[monitoringNodeConfig.InstanceIDs[0]]}
-		}
-		for _, zone := range zones {
 			if !useStaticIP {
-				tmpIPMap, err := gcpClient.GetInstancePublicIPs(zone, cloudConfigMap[zone].InstanceIDs)
+				monitoringPublicIPMap, err := provider.GetInstancePublicIPs(monitoringHostRegion, monitoringNodeConfig.InstanceIDs)
 				if err != nil {
 					return err
 				}
-				for node, ip := range tmpIPMap {
-					publicIPMap[node] = ip
-				}
-			} else {
-				for i, node := range cloudConfigMap[zone].InstanceIDs {
-					publicIPMap[node] = cloudConfigMap[zone].PublicIPs[i]
-				}
+				monitoringNodeConfig.PublicIPs = []string{monitoringPublicIPMap[monitoringNodeConfig.InstanceIDs[0]]}
+			}
+		}
+		for _, location := range locations {
+			for i, node := range cloudConfigMap[location].InstanceIDs {
+				publicIPMap[node] = cloudConfigMap[location].PublicIPs[i]
 			}
 			if separateMonitoringInstance {
-				networkName := fmt.Sprintf("%s-network", usr.Username+constants.OdysseyCLISuffix)
-				firewallName := fmt.Sprintf("%s-%s-monitoring", networkName, strings.ReplaceAll(monitoringNodeConfig.PublicIPs[0], ".", ""))
-				ports := []string{
-					strconv.Itoa(constants.OdysseygoMachineMetricsPort), strconv.Itoa(constants.OdysseygoAPIPort),
-					strconv.Itoa(constants.OdysseygoMonitoringPort), strconv.Itoa(constants.OdysseygoGrafanaPort),
-				}
-				if err = gcpClient.AddFirewall(
+				if err = provider.AddSecurityGroupRule(
+					location,
+					cloudConfigMap[location].SecurityGroup,
 					monitoringNodeConfig.PublicIPs[0],
-					networkName,
-					projectName,
-					firewallName,
-					ports,
-					true); err != nil {
+					[]int{constants.OdysseygoMachineMetricsPort, constants.OdysseygoAPIPort},
+				); err != nil {
 					return err
 				}
 			}
 		}
-		gcpProjectName = projectName
-		gcpCredentialFilepath = credentialFilepath
 	}
 	if err = createClusterNodeConfig(network, cloudConfigMap, monitoringNodeConfig, monitoringHostRegion, clusterName, cloudService, separateMonitoringInstance); err != nil {
 		return err
 	}
-	if cloudService == constants.GCPCloudService {
-		if err = updateClustersConfigGCPKeyFilepath(gcpProjectName, gcpCredentialFilepath); err != nil {
-			return err
-		}
-	}
 
 	inventoryPath := app.GetAnsibleInventoryDirPath(clusterName)
 	odysseyGoVersion, err := getOdysseyGoVersion()
 	if err != nil {
 		return err
 	}
-	if err = ansible.CreateAnsibleHostInventory(inventoryPath, "", cloudService, publicIPMap, cloudConfigMap); err != nil {
+	if err = ansible.CreateAnsibleHostInventory(inventoryPath, "", cloudService, publicIPMap, cloudConfigMap, app.GetSSHKnownHostsPath(clusterName)); err != nil {
 		return err
 	}
 	monitoringInventoryPath := ""
@@ -367,16 +389,16 @@ func createNodes(_ *cobra.Command, args []string) error {
 	if separateMonitoringInstance {
 		monitoringInventoryPath = filepath.Join(app.GetAnsibleInventoryDirPath(clusterName), constants.MonitoringDir)
 		if existingMonitoringInstance == "" {
-			if err = ansible.CreateAnsibleHostInventory(monitoringInventoryPath, monitoringNodeConfig.CertFilePath, cloudService, map[string]string{monitoringNodeConfig.InstanceIDs[0]: monitoringNodeConfig.PublicIPs[0]}, nil); err != nil {
+			if err = ansible.CreateAnsibleHostInventory(monitoringInventoryPath, monitoringNodeConfig.CertFilePath, cloudService, map[string]string{monitoringNodeConfig.InstanceIDs[0]: monitoringNodeConfig.PublicIPs[0]}, nil, app.GetSSHKnownHostsPath(clusterName)); err != nil {
 				return err
 			}
 		}
-		monitoringHosts, err = ansible.GetInventoryFromAnsibleInventoryFile(monitoringInventoryPath)
+		monitoringHosts, err = getClusterHostsFromInventory(clusterName, monitoringInventoryPath)
 		if err != nil {
 			return err
 		}
 	}
-	allHosts, err := ansible.GetInventoryFromAnsibleInventoryFile(inventoryPath)
+	allHosts, err := getClusterHostsFromInventory(clusterName, inventoryPath)
 	if err != nil {
 		return err
 	}
@@ -390,48 +412,9 @@ func createNodes(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to provision node(s) %s", failedHosts.GetNodeList())
 	}
 	ux.Logger.PrintToUser("Installing OdysseyGo and Odyssey-CLI and starting bootstrap process on the newly created Odyssey node(s) ...")
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if err := host.Connect(); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if err := provideStakingCertAndKey(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if err := ssh.RunSSHSetupNode(host, app.Conf.GetConfigPath(), odysseyGoVersion, network.Kind == models.Devnet); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if separateMonitoringInstance {
-				if err := ssh.RunSSHSetupMachineMetrics(host); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				}
-			} else {
-				if setUpMonitoring {
-					if err := ssh.RunSSHSetupMonitoring(host); err != nil {
-						nodeResults.AddResult(host.NodeID, nil, err)
-						return
-					}
-				}
-			}
-			if err := ssh.RunSSHSetupBuildEnv(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if err := ssh.RunSSHSetupCLIFromSource(host, constants.SetupCLIFromSourceBranch); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
+	wgResults := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		return nil, setupHost(host, network, app.Conf.GetConfigPath(), odysseyGoVersion)
+	})
 	ansibleHostIDs, err := utils.MapWithError(cloudConfigMap.GetAllInstanceIDs(), func(s string) (string, error) { return models.HostCloudIDToAnsibleID(cloudService, s) })
 	if err != nil {
 		return err
@@ -445,7 +428,7 @@ func createNodes(_ *cobra.Command, args []string) error {
 		hosts = utils.Filter(hosts, func(h *models.Host) bool { return h.NodeID != monitoringHost.NodeID })
 		odysseyGoPorts := []string{}
 		machinePorts := []string{}
-		inventoryHosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+		inventoryHosts, err := getClusterHosts(clusterName)
 		if err != nil {
 			return err
 		}
@@ -474,35 +457,23 @@ func createNodes(_ *cobra.Command, args []string) error {
 			}
 		}
 		// download node configs
-		wg := sync.WaitGroup{}
-		wgResults := models.NodeResults{}
-		for _, host := range hosts {
-			wg.Add(1)
-			go func(nodeResults *models.NodeResults, host *models.Host) {
-				defer wg.Done()
-				nodeDirPath := app.GetNodeInstanceOdygoConfigDirPath(host.NodeID)
-				if err := ssh.RunSSHDownloadNodeMonitoringConfig(host, nodeDirPath); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				}
-				if err = addHTTPHostToConfigFile(app.GetNodeConfigJSONFile(host.NodeID)); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				}
-				if err := ssh.RunSSHUploadNodeMonitoringConfig(host, nodeDirPath); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				}
-				if err := ssh.RunSSHRestartNode(host); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				}
-				if err := os.RemoveAll(nodeDirPath); err != nil {
-					return
-				}
-			}(&wgResults, host)
-		}
-		wg.Wait()
+		wgResults := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+			nodeDirPath := app.GetNodeInstanceOdygoConfigDirPath(host.NodeID)
+			if err := ssh.RunSSHDownloadNodeMonitoringConfig(host, nodeDirPath); err != nil {
+				return nil, err
+			}
+			if err := addHTTPHostToConfigFile(app.GetNodeConfigJSONFile(host.NodeID)); err != nil {
+				return nil, err
+			}
+			if err := ssh.RunSSHUploadNodeMonitoringConfig(host, nodeDirPath); err != nil {
+				return nil, err
+			}
+			if err := ssh.RunSSHRestartNode(host); err != nil {
+				return nil, err
+			}
+			_ = os.RemoveAll(nodeDirPath)
+			return nil, nil
+		})
 		for _, node := range hosts {
 			if wgResults.HasNodeIDWithError(node.NodeID) {
 				ux.Logger.PrintToUser("Node %s is ERROR with error: %s", node.NodeID, wgResults.GetErrorHostMap()[node.NodeID])
@@ -535,24 +506,51 @@ func createNodes(_ *cobra.Command, args []string) error {
 		if separateMonitoringInstance {
 			monitoringPublicIP = monitoringNodeConfig.PublicIPs[0]
 		}
-		printResults(cloudConfigMap, publicIPMap, ansibleHostIDs, monitoringPublicIP)
+		printResults(clusterName, cloudConfigMap, publicIPMap, ansibleHostIDs, monitoringPublicIP)
 		ux.Logger.PrintToUser("OdysseyGo and Odyssey-CLI installed and node(s) are bootstrapping!")
 	}
 	return nil
 }
 
+// setupHost provides the staking files of the new [host], installs odysseygo at [odysseyGoVersion]
+// for [network] with the CLI config at [configPath], sets up its monitoring, and installs the CLI
+func setupHost(host *models.Host, network models.Network, configPath, odysseyGoVersion string) error {
+	if err := host.Connect(); err != nil {
+		return err
+	}
+	if err := provideStakingCertAndKey(host); err != nil {
+		return err
+	}
+	if err := ssh.RunSSHSetupNode(host, configPath, odysseyGoVersion, network.Kind == models.Devnet); err != nil {
+		return err
+	}
+	if separateMonitoringInstance {
+		if err := ssh.RunSSHSetupMachineMetrics(host); err != nil {
+			return err
+		}
+	} else if setUpMonitoring {
+		if err := ssh.RunSSHSetupMonitoring(host); err != nil {
+			return err
+		}
+	}
+	if err := ssh.RunSSHSetupBuildEnv(host); err != nil {
+		return err
+	}
+	return ssh.RunSSHSetupCLIFromSource(host, constants.SetupCLIFromSourceBranch)
+}
+
 func promptSetUpMonitoring() (bool, bool, error) {
 	var err error
 	if !separateMonitoringInstance && existingMonitoringInstance == "" {
 		if sameMonitoringInstance {
 			return true, false, nil
 		}
-		setUpMonitoring, err = app.Prompt.CaptureYesNo("Do you want to set up monitoring for your instances? (This enables you to monitor validator and machine metrics)")
+		setUpMonitoring, err = app.Prompt.CaptureYesNo(setUpMonitoringPrompt)
 		if err != nil {
 			return false, false, err
 		}
 		if setUpMonitoring {
-			separateMonitoringInstance, err = app.Prompt.CaptureYesNo("Do you want to set up a separate instance to host monitoring? (This enables you to monitor all your set up instances in one dashboard)")
+			separateMonitoringInstance, err = app.Prompt.CaptureYesNo(separateMonitoringPrompt)
 			if err != nil {
 				return false, false, err
 			}
@@ -604,6 +602,7 @@ func createClusterNodeConfig(network models.Network, cloudConfigMap models.Cloud
 				SecurityGroup: monitorCloudConfig.SecurityGroup,
 				ElasticIP:     publicIP,
 				CloudService:  cloudService,
+				UseStaticIP:   useStaticIP,
 			}
 			if err := app.CreateNodeCloudConfigFile(monitorCloudConfig.InstanceIDs[0], &nodeConfig); err != nil {
 				return err
@@ -711,17 +710,20 @@ func addNodeToClustersConfig(network models.Network, nodeID, clusterName string,
 		}
 	}
 	nodes := clustersConfig.Clusters[clusterName].Nodes
+	hostKeys := clustersConfig.Clusters[clusterName].HostKeys
 	if !isMonitoringInstance {
 		// monitoring instance will always be last in the loop, so no need to set monitoring instance here
 		clustersConfig.Clusters[clusterName] = models.ClusterConfig{
-			Network: network,
-			Nodes:   append(nodes, nodeID),
+			Network:  network,
+			Nodes:    append(nodes, nodeID),
+			HostKeys: hostKeys,
 		}
 	} else {
 		clustersConfig.Clusters[clusterName] = models.ClusterConfig{
 			Network:            network,
 			Nodes:              nodes,
 			MonitoringInstance: nodeID,
+			HostKeys:           hostKeys,
 		}
 	}
 
@@ -795,8 +797,11 @@ func provideStakingCertAndKey(host *models.Host) error {
 	return ssh.RunSSHUploadStakingFiles(host, keyPath)
 }
 
+// userIPAddressURL is the service returning the public IP of the user
+var userIPAddressURL = "https://api.ipify.org?format=json"
+
 func getIPAddress() (string, error) {
-	resp, err := http.Get("https://api.ipify.org?format=json")
+	resp, err := http.Get(userIPAddressURL)
 	if err != nil {
 		return "", err
 	}
@@ -851,7 +856,7 @@ func getOdysseyGoVersion() (string, error) {
 		case odysseyGoReferenceChoiceLatest:
 			version = "latest"
 		case odysseyGoReferenceChoiceCustom:
-			customVersion, err := app.Prompt.CaptureVersion("Which version of OdysseyGo would you like to install? (Use format v1.10.10)")
+			customVersion, err := app.Prompt.CaptureVersion(customOdysseyGoPrompt)
 			if err != nil {
 				return "", err
 			}
@@ -887,7 +892,7 @@ func GetLatestOdygoVersionForRPC(configuredRPCVersion int) (string, error) {
 // wants the cloud server to track
 func promptOdysseyGoReferenceChoice() (string, string, error) {
 	defaultVersion := "Use latest Odyssey Go Version"
-	txt := "What version of Odyssey Go would you like to install in the node?"
+	txt := odysseyGoVersionPrompt
 	versionOptions := []string{defaultVersion, "Use the deployed Subnet's VM version that the node will be validating", "Custom"}
 	versionOption, err := app.Prompt.CaptureList(txt, versionOptions)
 	if err != nil {
@@ -901,7 +906,7 @@ func promptOdysseyGoReferenceChoice() (string, string, error) {
 		return odysseyGoReferenceChoiceCustom, "", nil
 	default:
 		for {
-			subnetName, err := app.Prompt.CaptureString("Which Subnet would you like to use to choose the odyssey go version?")
+			subnetName, err := app.Prompt.CaptureString(versionSubnetPrompt)
 			if err != nil {
 				return "", "", err
 			}
@@ -915,13 +920,16 @@ func promptOdysseyGoReferenceChoice() (string, string, error) {
 }
 
 func setCloudService() (string, error) {
+	if existingHostsFile != "" || len(existingHosts) > 0 {
+		return constants.ExistingHostsService, nil
+	}
 	if useAWS {
 		return constants.AWSCloudService, nil
 	}
 	if useGCP {
 		return constants.GCPCloudService, nil
 	}
-	txt := "Which cloud service would you like to launch your Odyssey Node(s) in?"
+	txt := cloudServicePrompt
 	cloudOptions := []string{constants.AWSCloudService, constants.GCPCloudService}
 	chosenCloudService, err := app.Prompt.CaptureList(txt, cloudOptions)
 	if err != nil {
@@ -931,6 +939,9 @@ func setCloudService() (string, error) {
 }
 
 func setCloudInstanceType(cloudService string) (string, error) {
+	if cloudService == constants.ExistingHostsService {
+		return "", nil
+	}
 	switch { // backwards compatibility
 	case nodeType == "default" && cloudService == constants.AWSCloudService:
 		nodeType = constants.AWSDefaultInstanceType
@@ -956,7 +967,7 @@ func setCloudInstanceType(cloudService string) (string, error) {
 	if nodeType == "" {
 		defaultStr := "[default] (recommended)"
 		nodeTypeStr, err := app.Prompt.CaptureList(
-			"Instance type to use",
+			instanceTypePrompt,
 			[]string{fmt.Sprintf("%s %s", defaultNodeType, defaultStr), nodeTypeOption2, nodeTypeOption3, customNodeType},
 		)
 		if err != nil {
@@ -965,7 +976,7 @@ func setCloudInstanceType(cloudService string) (string, error) {
 		}
 		nodeTypeStr = strings.ReplaceAll(nodeTypeStr, defaultStr, "") // remove (default) if any
 		if nodeTypeStr == customNodeType {
-			nodeTypeStr, err = app.Prompt.CaptureString("What instance type would you like to use?")
+			nodeTypeStr, err = app.Prompt.CaptureString(customInstanceTypePrompt)
 			if err != nil {
 				ux.Logger.PrintToUser("Failed to capture custom node type with error: %s", err.Error())
 				return "", err
@@ -976,13 +987,17 @@ func setCloudInstanceType(cloudService string) (string, error) {
 	return nodeType, nil
 }
 
-func printResults(cloudConfigMap models.CloudConfig, publicIPMap map[string]string, ansibleHostIDs []string, monitoringHostIP string) {
+func printResults(clusterName string, cloudConfigMap models.CloudConfig, publicIPMap map[string]string, ansibleHostIDs []string, monitoringHostIP string) {
 	ux.Logger.PrintToUser("======================================")
 	ux.Logger.PrintToUser("ODYSSEY NODE(S) SUCCESSFULLY SET UP!")
 	ux.Logger.PrintToUser("======================================")
 	ux.Logger.PrintToUser("Please wait until the node(s) are successfully bootstrapped to run further commands on the node(s)")
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("Here are the details of the set up node(s): ")
+	knownHostsPath, err := writeKnownHostsFile(clusterName)
+	if err != nil {
+		ux.Logger.PrintToUser("Failed to write known_hosts file for pinned host keys: %s", err)
+	}
 	for region, cloudConfig := range cloudConfigMap {
 		ux.Logger.PrintToUser(fmt.Sprintf("Don't delete or replace your ssh private key file at %s as you won't be able to access your cloud server without it", cloudConfig.CertFilePath))
 		for i, instanceID := range cloudConfig.InstanceIDs {
@@ -998,7 +1013,7 @@ func printResults(cloudConfigMap models.CloudConfig, publicIPMap map[string]stri
 			ux.Logger.PrintToUser("")
 			ux.Logger.PrintToUser("To ssh to node, run: ")
 			ux.Logger.PrintToUser("")
-			ux.Logger.PrintToUser(utils.GetSSHConnectionString(publicIP, cloudConfig.CertFilePath))
+			ux.Logger.PrintToUser(utils.GetSSHConnectionString(publicIP, cloudConfig.CertFilePath, knownHostsPath))
 			ux.Logger.PrintToUser("")
 			if setUpMonitoring && !separateMonitoringInstance {
 				ux.Logger.PrintToUser("To view monitoring dashboard for this node, visit the following link in your browser: ")
@@ -1044,7 +1059,7 @@ func requestCloudAuth(cloudName string) error {
 	ux.Logger.PrintToUser("- Create Cloud instance(s) and other components (such as elastic IPs)")
 	ux.Logger.PrintToUser("- Start/Stop Cloud instance(s) and other components (such as elastic IPs) previously created by Odyssey-CLI")
 	ux.Logger.PrintToUser("- Delete Cloud instance(s) and other components (such as elastic IPs) previously created by Odyssey-CLI")
-	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("I authorize Odyssey-CLI to access my %s account", cloudName))
+	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("%s %s account", authorizeAccessPrompt, cloudName))
 	if err != nil {
 		return err
 	}
@@ -1070,12 +1085,12 @@ func getRegionsNodeNum(cloudName string) (
 	supportedClouds := map[string]CloudPrompt{
 		constants.AWSCloudService: {
 			defaultLocations: []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2"},
-			locationName:     "AWS Region",
+			locationName:     awsLocationName,
 			locationsListURL: "https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/using-regions-availability-zones.html",
 		},
 		constants.GCPCloudService: {
 			defaultLocations: []string{"us-east1-b", "us-central1-c", "us-west1-b"},
-			locationName:     "Google Zone",
+			locationName:     gcpLocationName,
 			locationsListURL: "https://cloud.google.com/compute/docs/regions-zones/",
 		},
 	}
@@ -1086,22 +1101,22 @@ func getRegionsNodeNum(cloudName string) (
 
 	nodes := map[string]int{}
 	awsCustomRegion := fmt.Sprintf("Choose custom %s (list of %ss available at %s)", supportedClouds[cloudName].locationName, supportedClouds[cloudName].locationName, supportedClouds[cloudName].locationsListURL)
-	additionalRegionPrompt := fmt.Sprintf("Would you like to add additional %s?", supportedClouds[cloudName].locationName)
+	addRegionPrompt := fmt.Sprintf("%s %s?", additionalRegionPrompt, supportedClouds[cloudName].locationName)
 	for {
 		userRegion, err := app.Prompt.CaptureList(
-			fmt.Sprintf("Which %s do you want to set up your node(s) in?", supportedClouds[cloudName].locationName),
+			fmt.Sprintf(regionPrompt, supportedClouds[cloudName].locationName),
 			append(supportedClouds[cloudName].defaultLocations, awsCustomRegion),
 		)
 		if err != nil {
 			return nil, err
 		}
 		if userRegion == awsCustomRegion {
-			userRegion, err = app.Prompt.CaptureString(fmt.Sprintf("Which %s do you want to set up your node in?", supportedClouds[cloudName].locationName))
+			userRegion, err = app.Prompt.CaptureString(fmt.Sprintf(customRegionPrompt, supportedClouds[cloudName].locationName))
 			if err != nil {
 				return nil, err
 			}
 		}
-		numNodes, err := app.Prompt.CaptureUint32(fmt.Sprintf("How many nodes do you want to set up in %s %s?", userRegion, supportedClouds[cloudName].locationName))
+		numNodes, err := app.Prompt.CaptureUint32(fmt.Sprintf("%s %s %s?", numNodesPrompt, userRegion, supportedClouds[cloudName].locationName))
 		if err != nil {
 			return nil, err
 		}
@@ -1112,7 +1127,7 @@ func getRegionsNodeNum(cloudName string) (
 
 		currentInput := utils.Map(maps.Keys(nodes), func(region string) string { return fmt.Sprintf("[%s]:%d", region, nodes[region]) })
 		ux.Logger.PrintToUser("Current selection: " + strings.Join(currentInput, " "))
-		yes, err := app.Prompt.CaptureNoYes(additionalRegionPrompt)
+		yes, err := app.Prompt.CaptureNoYes(addRegionPrompt)
 		if err != nil {
 			return nil, err
 		}
@@ -1137,7 +1152,7 @@ func setSSHIdentity() (string, error) {
 		return id
 	})
 	sshIdentity, err := app.Prompt.CaptureList(
-		"Which SSH identity do you want to use?", sshIdentities,
+		sshIdentityPrompt, sshIdentities,
 	)
 	if err != nil {
 		return "", err
@@ -0,0 +1,340 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"errors"
+	"fmt"
+	"os/user"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	awsAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/aws"
+	gcpAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/gcp"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"golang.org/x/exp/rand"
+	"golang.org/x/net/context"
+)
+
+// cloudResourceNames are the names of the resources created by the CLI for a user in a cloud location
+type cloudResourceNames struct {
+	keyPair       string
+	certName      string // name of the private key file in the .ssh directory
+	securityGroup string
+}
+
+// getCloudProvider returns the provider for [cloudService], loading its credentials
+func getCloudProvider(cloudService string) (cloud.Provider, error) {
+	switch cloudService {
+	case constants.AWSCloudService:
+		return awsAPI.NewProvider(awsProfile), nil
+	case constants.GCPCloudService:
+		gcpClient, projectName, credentialsFilepath, err := getGCPCloudCredentials()
+		if err != nil {
+			return nil, err
+		}
+		gcpCloud, err := gcpAPI.NewGcpCloud(gcpClient, projectName, context.Background())
+		if err != nil {
+			return nil, err
+		}
+		if err := updateClustersConfigGCPKeyFilepath(projectName, credentialsFilepath); err != nil {
+			return nil, err
+		}
+		return gcpAPI.NewProvider(gcpCloud), nil
+	default:
+		return nil, fmt.Errorf("cloud %s is not supported", cloudService)
+	}
+}
+
+// getAuthorizedCloudProvider returns the provider of [cloudService] cached in [providers],
+// creating it once the user authorized the CLI to access the cloud
+func getAuthorizedCloudProvider(providers map[string]cloud.Provider, cloudService string) (cloud.Provider, error) {
+	if provider, ok := providers[cloudService]; ok {
+		return provider, nil
+	}
+	if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(cloudService) != nil) {
+		return nil, fmt.Errorf("cloud access is required")
+	}
+	provider, err := getCloudProvider(cloudService)
+	if err != nil {
+		return nil, err
+	}
+	providers[cloudService] = provider
+	return provider, nil
+}
+
+// getNodeCloudService returns the cloud service of [nodeConfig]
+func getNodeCloudService(nodeConfig models.NodeConfig) string {
+	// node configs created when only AWS was supported have no cloud service
+	if nodeConfig.CloudService == "" {
+		return constants.AWSCloudService
+	}
+	return nodeConfig.CloudService
+}
+
+// getCloudResourceNames returns the names of the resources of [usr] in [location] of [cloudService].
+// AWS resources are regional so their names include the region.
+func getCloudResourceNames(cloudService, location string, usr *user.User) cloudResourceNames {
+	if cloudService == constants.AWSCloudService {
+		prefix := usr.Username + "-" + location + constants.OdysseyCLISuffix
+		return cloudResourceNames{
+			keyPair:       prefix,
+			certName:      prefix + "-" + location + constants.CertSuffix,
+			securityGroup: prefix + "-" + location + constants.AWSSecurityGroupSuffix,
+		}
+	}
+	prefix := usr.Username + constants.OdysseyCLISuffix
+	return cloudResourceNames{
+		keyPair:       prefix + "-keypair",
+		certName:      prefix + "-keypair",
+		securityGroup: prefix + "-network",
+	}
+}
+
+// getCloudLocationsNodeNum returns the number of nodes to create in each location of [cloudService],
+// as given in the command line or prompted
+func getCloudLocationsNodeNum(cloudService string) (map[string]int, error) {
+	switch {
+	case len(numNodes) != len(utils.Unique(cmdLineRegion)):
+		return nil, errors.New("number of regions and number of nodes must be equal. Please make sure list of regions is unique")
+	case len(cmdLineRegion) == 0 && len(numNodes) == 0:
+		return getRegionsNodeNum(cloudService)
+	default:
+		locationsNodeNum := map[string]int{}
+		for i, location := range cmdLineRegion {
+			locationsNodeNum[location] = numNodes[i]
+		}
+		return locationsNodeNum, nil
+	}
+}
+
+// getCloudImageIDs returns the Ubuntu image to use in each of [locations]
+func getCloudImageIDs(provider cloud.Provider, locations []string) (map[string]string, error) {
+	imageIDs := map[string]string{}
+	for _, location := range locations {
+		imageID, err := provider.GetUbuntuImageID(location)
+		if err != nil {
+			if provider.Name() == constants.AWSCloudService {
+				switch {
+				case isExpiredCredentialError(err):
+					printExpiredCredentialsOutput(awsProfile)
+				case isNoCredentialError(err):
+					printNoCredentialsOutput(awsProfile)
+				}
+			}
+			return nil, err
+		}
+		imageIDs[location] = imageID
+	}
+	return imageIDs, nil
+}
+
+// setupCloudSSHKey makes sure that the key pair [names.keyPair] to access the instances in [location]
+// is registered in the cloud and usable locally, either from the .ssh directory or the ssh agent.
+// It returns the key pair name, the private key path (empty for the ssh agent) and the public key.
+func setupCloudSSHKey(provider cloud.Provider, location string, names cloudResourceNames) (string, string, string, error) {
+	keyPairName := names.keyPair
+	certPath, err := app.GetSSHCertFilePath(names.certName)
+	if err != nil {
+		return "", "", "", err
+	}
+	keyPairExists, err := provider.CheckSSHKeyExists(location, keyPairName)
+	if err != nil {
+		return "", "", "", err
+	}
+	certInSSHDir := utils.FileExists(certPath)
+	if keyPairExists && !useSSHAgent && !certInSSHDir {
+		ux.Logger.PrintToUser("Key pair %s already exists in %s[%s] but can't be found in your .ssh directory", keyPairName, provider.Name(), location)
+		ux.Logger.PrintToUser("We need to create a new key pair")
+		keyPairName, err = getNewKeyPairName(provider, location)
+		if err != nil {
+			return "", "", "", err
+		}
+		keyPairExists = false
+		certPath, err = app.GetSSHCertFilePath(keyPairName + constants.CertSuffix)
+		if err != nil {
+			return "", "", "", err
+		}
+		certInSSHDir = utils.FileExists(certPath)
+	}
+	publicKey := ""
+	switch {
+	case useSSHAgent:
+		certPath = ""
+		publicKey, err = utils.ReadSSHAgentIdentityPublicKey(sshIdentity)
+	case certInSSHDir:
+		publicKey, err = utils.ReadSSHPublicKey(certPath)
+	default:
+		ux.Logger.PrintToUser("Creating new SSH key pair %s", certPath)
+		if err := utils.CreateSSHKeyPair(certPath); err != nil {
+			return "", "", "", err
+		}
+		publicKey, err = utils.ReadSSHPublicKey(certPath)
+	}
+	if err != nil {
+		return "", "", "", err
+	}
+	if keyPairExists {
+		ux.Logger.PrintToUser("Using existing key pair %s in %s[%s]", keyPairName, provider.Name(), location)
+	} else if err := provider.ImportSSHKey(location, keyPairName, publicKey); err != nil {
+		return "", "", "", err
+	}
+	return keyPairName, certPath, publicKey, nil
+}
+
+// getNewKeyPairName gets a key pair name not yet used in [location], from the command line or prompted
+func getNewKeyPairName(provider cloud.Provider, location string) (string, error) {
+	newKeyPairName := cmdLineAlternativeKeyPairName
+	for {
+		if newKeyPairName != "" {
+			keyPairExists, err := provider.CheckSSHKeyExists(location, newKeyPairName)
+			if err != nil {
+				return "", err
+			}
+			if !keyPairExists {
+				return newKeyPairName, nil
+			}
+			ux.Logger.PrintToUser("Key Pair named %s already exists", newKeyPairName)
+		}
+		ux.Logger.PrintToUser("What do you want to name your key pair?")
+		var err error
+		newKeyPairName, err = app.Prompt.CaptureString(keyPairNamePrompt)
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func randomString(length int) string {
+	rand.Seed(uint64(time.Now().UnixNano()))
+	chars := "abcdefghijklmnopqrstuvwxyz"
+	result := make([]byte, length)
+	for i := 0; i < length; i++ {
+		result[i] = chars[rand.Intn(len(chars))]
+	}
+	return string(result)
+}
+
+// createCloudInstances creates [numNodes] instances of [instanceType] in each of [locations]
+// using [provider], reachable from [userIPAddress]. If the creation fails, the instances
+// already created are terminated so that the user is not charged for them.
+func createCloudInstances(
+	provider cloud.Provider,
+	instanceType string,
+	numNodes map[string]int,
+	locations []string,
+	imageIDs map[string]string,
+	usr *user.User,
+	userIPAddress string,
+	forMonitoring bool,
+) (models.CloudConfig, error) {
+	if !forMonitoring {
+		ux.Logger.PrintToUser("Creating new instance(s) on %s...", provider.Name())
+	} else {
+		ux.Logger.PrintToUser("Creating separate monitoring instance(s) on %s...", provider.Name())
+	}
+	cloudConfig := models.CloudConfig{}
+	instanceIDs := map[string][]string{}
+	staticIPs := map[string][]cloud.StaticIP{}
+	err := func() error {
+		for _, location := range locations {
+			names := getCloudResourceNames(provider.Name(), location, usr)
+			keyPairName, certPath, publicKey, err := setupCloudSSHKey(provider, location, names)
+			if err != nil {
+				return err
+			}
+			securityGroupID, err := provider.SetupSecurityGroup(location, names.securityGroup, userIPAddress)
+			if err != nil {
+				return err
+			}
+			namePrefix := randomString(5)
+			if useStaticIP {
+				staticIPs[location], err = provider.AllocateStaticIPs(location, namePrefix, numNodes[location])
+				if err != nil {
+					return err
+				}
+			}
+			instanceIDs[location], err = provider.CreateInstances(cloud.InstanceSpec{
+				Location:      location,
+				Count:         numNodes[location],
+				ImageID:       imageIDs[location],
+				InstanceType:  instanceType,
+				NamePrefix:    namePrefix,
+				SSHKeyName:    keyPairName,
+				SSHPublicKey:  publicKey,
+				SecurityGroup: securityGroupID,
+				StaticIPs:     staticIPs[location],
+				ForMonitoring: forMonitoring,
+			})
+			if err != nil {
+				return err
+			}
+			ux.Logger.PrintToUser("Waiting for instance(s) in %s[%s] to be provisioned...", provider.Name(), location)
+			if err := provider.WaitForInstances(location, instanceIDs[location]); err != nil {
+				return err
+			}
+			publicIPs := utils.Map(staticIPs[location], func(ip cloud.StaticIP) string { return ip.Address })
+			if !useStaticIP {
+				publicIPMap, err := provider.GetInstancePublicIPs(location, instanceIDs[location])
+				if err != nil {
+					return err
+				}
+				publicIPs = utils.Map(instanceIDs[location], func(instanceID string) string { return publicIPMap[instanceID] })
+			}
+			cloudConfig[location] = models.RegionConfig{
+				InstanceIDs:   instanceIDs[location],
+				PublicIPs:     publicIPs,
+				KeyPair:       keyPairName,
+				SecurityGroup: names.securityGroup,
+				CertFilePath:  certPath,
+				ImageID:       imageIDs[location],
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		ux.Logger.PrintToUser("Failed to create %s cloud server(s) with error: %s", provider.Name(), err)
+		if err := terminateCreatedInstances(provider, instanceIDs, staticIPs); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	ux.Logger.PrintToUser("New instance(s) successfully created on %s!", provider.Name())
+	return cloudConfig, nil
+}
+
+// terminateCreatedInstances terminates [instanceIDs] created on [provider] before a failure,
+// releasing [staticIPs] associated to them
+func terminateCreatedInstances(provider cloud.Provider, instanceIDs map[string][]string, staticIPs map[string][]cloud.StaticIP) error {
+	ux.Logger.PrintToUser("Terminating all created instances due to error to prevent charge for unused instances...")
+	failedNodes := map[string]error{}
+	for location, locationInstanceIDs := range instanceIDs {
+		for i, instanceID := range locationInstanceIDs {
+			nodeConfig := models.NodeConfig{
+				NodeID: instanceID,
+				Region: location,
+			}
+			if i < len(staticIPs[location]) {
+				nodeConfig.UseStaticIP = true
+				nodeConfig.ElasticIP = staticIPs[location][i].Address
+			}
+			if err := provider.TerminateInstance(nodeConfig); err != nil {
+				failedNodes[instanceID] = err
+				continue
+			}
+			ux.Logger.PrintToUser("Cloud server instance %s terminated in %s[%s]", instanceID, provider.Name(), location)
+		}
+	}
+	if len(failedNodes) > 0 {
+		ux.Logger.PrintToUser("Failed nodes: ")
+		for node, err := range failedNodes {
+			ux.Logger.PrintToUser("Failed to terminate node %s due to %s", node, err)
+		}
+		ux.Logger.PrintToUser("Terminate the above instance(s) on the %s console to prevent charges", provider.Name())
+		return fmt.Errorf("failed to terminate node(s) %s", failedNodes)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud/fake"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const testUserIP = "198.51.100.7"
+
+// setupCloudTest sets up a home dir holding the private key of the fake cloud resources of [usr]
+func setupCloudTest(t *testing.T, usr *user.User) {
+	ux.NewUserLog(logging.NoLog{}, os.Stdout)
+	app = application.New()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	sshDir := filepath.Join(home, ".ssh")
+	require.NoError(t, os.MkdirAll(sshDir, constants.DefaultPerms755))
+	certName := getCloudResourceNames(fake.CloudService, "", usr).certName
+	require.NoError(t, os.WriteFile(filepath.Join(sshDir, certName), pem.EncodeToMemory(block), 0o600))
+	useSSHAgent = false
+	useStaticIP = false
+	t.Cleanup(func() { useStaticIP = false })
+}
+
+func TestCreateCloudInstances(t *testing.T) {
+	require := require.New(t)
+	usr := &user.User{Username: "test"}
+	setupCloudTest(t, usr)
+	useStaticIP = true
+
+	provider := fake.NewProvider()
+	locations := []string{"loc-a", "loc-b"}
+	imageIDs := map[string]string{"loc-a": "image-a", "loc-b": "image-b"}
+	cloudConfig, err := createCloudInstances(provider, "big", map[string]int{"loc-a": 2, "loc-b": 1}, locations, imageIDs, usr, testUserIP, false)
+	require.NoError(err)
+
+	names := getCloudResourceNames(fake.CloudService, "", usr)
+	for location, numNodes := range map[string]int{"loc-a": 2, "loc-b": 1} {
+		regionConfig := cloudConfig[location]
+		require.Len(regionConfig.InstanceIDs, numNodes)
+		require.Len(regionConfig.PublicIPs, numNodes)
+		require.Equal(names.keyPair, regionConfig.KeyPair)
+		require.Equal(names.securityGroup, regionConfig.SecurityGroup)
+		require.Equal(imageIDs[location], regionConfig.ImageID)
+		require.FileExists(regionConfig.CertFilePath)
+		require.Contains(provider.SSHKeys, location+"/"+names.keyPair)
+		require.Contains(provider.SecurityGroups[location+"/"+names.securityGroup].Rules, testUserIP)
+		for i, instanceID := range regionConfig.InstanceIDs {
+			instance := provider.Instances[instanceID]
+			require.Equal(location, instance.Location)
+			require.Equal("big", instance.Spec.InstanceType)
+			require.NotNil(instance.StaticIP)
+			require.Equal(regionConfig.PublicIPs[i], instance.PublicIP)
+		}
+	}
+
+	// the key pair registered in the cloud is reused
+	monitoringConfig, err := createCloudInstances(provider, "big", map[string]int{"loc-a": 1}, []string{"loc-a"}, imageIDs, usr, testUserIP, true)
+	require.NoError(err)
+	require.Equal(names.keyPair, monitoringConfig["loc-a"].KeyPair)
+	require.True(provider.Instances[monitoringConfig["loc-a"].InstanceIDs[0]].Spec.ForMonitoring)
+}
+
+func TestCreateCloudInstancesFailure(t *testing.T) {
+	require := require.New(t)
+	usr := &user.User{Username: "test"}
+	setupCloudTest(t, usr)
+
+	provider := fake.NewProvider()
+	errIPs := errors.New("cannot get IPs")
+	provider.Fail["GetInstancePublicIPs"] = errIPs
+	_, err := createCloudInstances(provider, "big", map[string]int{"loc-a": 2}, []string{"loc-a"}, map[string]string{}, usr, testUserIP, false)
+	require.ErrorIs(err, errIPs)
+
+	// the instances created before the failure are terminated
+	require.Len(provider.Instances, 2)
+	for _, instance := range provider.Instances {
+		require.Equal(fake.InstanceTerminated, instance.State)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"sync"
 	"time"
 
 	coreth_params "github.com/DioneProtocol/coreth/params"
@@ -153,7 +152,7 @@ func setupDevnet(clusterName string, hosts []*models.Host) error {
 	if err != nil {
 		return err
 	}
-	ansibleHosts, err := ansible.GetHostMapfromAnsibleInventory(app.GetAnsibleInventoryDirPath(clusterName))
+	ansibleHosts, err := getClusterHostMap(clusterName)
 	if err != nil {
 		return err
 	}
@@ -224,20 +223,10 @@ func setupDevnet(clusterName string, hosts []*models.Host) error {
 		}
 	}
 	// update node/s genesis + conf and start
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			keyPath := filepath.Join(app.GetNodesDir(), host.GetCloudID())
-			if err := ssh.RunSSHSetupDevNet(host, keyPath); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
+	wgResults := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		keyPath := filepath.Join(app.GetNodesDir(), host.GetCloudID())
+		return nil, ssh.RunSSHSetupDevNet(host, keyPath)
+	})
 	for _, node := range hosts {
 		if wgResults.HasNodeIDWithError(node.NodeID) {
 			ux.Logger.PrintToUser("Node %s is ERROR with error: %s", node.NodeID, wgResults.GetErrorHostMap()[node.NodeID])
@@ -257,8 +246,9 @@ func setupDevnet(clusterName string, hosts []*models.Host) error {
 	}
 	clusterConfig := clustersConfig.Clusters[clusterName]
 	clustersConfig.Clusters[clusterName] = models.ClusterConfig{
-		Network: network,
-		Nodes:   clusterConfig.Nodes,
+		Network:  network,
+		Nodes:    clusterConfig.Nodes,
+		HostKeys: clusterConfig.HostKeys,
 	}
 	return app.WriteClustersConfigFile(&clustersConfig)
 }
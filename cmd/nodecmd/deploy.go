@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/spf13/cobra"
@@ -44,7 +43,7 @@ func deploySubnet(cmd *cobra.Command, args []string) error {
 	if clustersConfig.Clusters[clusterName].Network.Kind != models.Devnet {
 		return fmt.Errorf("node deploy command must be applied to devnet clusters")
 	}
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,313 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func newDestroyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "destroy [clusterName]",
+		Short: "(ALPHA Warning) Destroy all nodes and cloud resources of a cluster",
+		Long: `(ALPHA Warning) This command is currently in experimental mode.
+
+The node destroy command tears down the cluster <clusterName>. It terminates
+all its cloud instances, including the separate monitoring instance, releases
+their static IPs and deletes the security groups (or networks) and key pairs
+created for them, unless they are still used by nodes of other clusters.
+Existing hosts added with node add-host are not touched besides stopping
+odysseygo on them. All local files related to the cluster are removed.
+
+The resources to be destroyed are shown before asking for confirmation.`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE:         destroyNodes,
+	}
+	cmd.Flags().BoolVar(&authorizeAccess, "authorize-access", false, "authorize CLI to release cloud resources")
+	cmd.Flags().BoolVar(&authorizeRemove, "authorize-remove", false, "authorize CLI to destroy the cluster without asking for confirmation")
+	return cmd
+}
+
+// cloudResource is a resource shared by the instances of a cloud location, as a security group or a key pair
+type cloudResource struct {
+	cloudService string
+	location     string
+	name         string
+}
+
+// key identifies the resource in the cloud. Resources names are unique per cloud service
+// for the CLI, as they include the location when the resource is regional.
+func (r cloudResource) key() string {
+	return r.cloudService + "/" + r.name
+}
+
+func (r cloudResource) String() string {
+	return fmt.Sprintf("%s in %s[%s]", r.name, r.cloudService, r.location)
+}
+
+// destroyPlan lists the resources of a cluster to be destroyed
+type destroyPlan struct {
+	nodes []models.NodeConfig
+	// missingNodes are nodes of the cluster without node config, only removed from the cluster
+	missingNodes   []string
+	securityGroups []cloudResource
+	keyPairs       []cloudResource
+	// kept are the shared resources of the cluster still used by other clusters
+	kept []cloudResource
+}
+
+func destroyNodes(_ *cobra.Command, args []string) error {
+	clusterName := args[0]
+	plan, err := getDestroyPlan(clusterName)
+	if err != nil {
+		return err
+	}
+	printDestroyPlan(clusterName, plan)
+	if !authorizeRemove {
+		yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("Do you want to destroy cluster %s? This is irreversible", clusterName))
+		if err != nil {
+			return err
+		}
+		if !yes {
+			return errors.New("abort odyssey node destroy command")
+		}
+	}
+	if err := executeDestroyPlan(clusterName, plan, map[string]cloud.Provider{}); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Cluster %s successfully destroyed!", clusterName)
+	return nil
+}
+
+// getDestroyPlan inventories the resources created for [clusterName], keeping
+// out the security groups and key pairs used by nodes of other clusters
+func getDestroyPlan(clusterName string) (destroyPlan, error) {
+	plan := destroyPlan{}
+	clustersConfig := models.ClustersConfig{}
+	if app.ClustersConfigExists() {
+		var err error
+		clustersConfig, err = app.LoadClustersConfig()
+		if err != nil {
+			return plan, err
+		}
+	}
+	clusterConfig, ok := clustersConfig.Clusters[clusterName]
+	if !ok {
+		return plan, fmt.Errorf("cluster %q does not exist", clusterName)
+	}
+	nodes := clusterConfig.Nodes
+	if clusterConfig.MonitoringInstance != "" {
+		nodes = append(nodes, clusterConfig.MonitoringInstance)
+	}
+	usedResources := map[string]bool{}
+	for otherClusterName, otherClusterConfig := range clustersConfig.Clusters {
+		if otherClusterName == clusterName {
+			continue
+		}
+		otherNodes := otherClusterConfig.Nodes
+		if otherClusterConfig.MonitoringInstance != "" {
+			otherNodes = append(otherNodes, otherClusterConfig.MonitoringInstance)
+		}
+		for _, node := range otherNodes {
+			nodeConfig, err := app.LoadClusterNodeConfig(node)
+			if err != nil {
+				continue
+			}
+			for _, resource := range getNodeCloudResources(nodeConfig) {
+				usedResources[resource.key()] = true
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for _, node := range nodes {
+		nodeConfig, err := app.LoadClusterNodeConfig(node)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return plan, err
+			}
+			plan.missingNodes = append(plan.missingNodes, node)
+			continue
+		}
+		plan.nodes = append(plan.nodes, nodeConfig)
+		resources := getNodeCloudResources(nodeConfig)
+		for i, resource := range resources {
+			if seen[resource.key()] {
+				continue
+			}
+			seen[resource.key()] = true
+			switch {
+			case usedResources[resource.key()]:
+				plan.kept = append(plan.kept, resource)
+			case i == 0:
+				plan.securityGroups = append(plan.securityGroups, resource)
+			default:
+				plan.keyPairs = append(plan.keyPairs, resource)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// getNodeCloudResources returns the security group and key pair of [nodeConfig], in that order.
+// Existing hosts have none.
+func getNodeCloudResources(nodeConfig models.NodeConfig) []cloudResource {
+	if nodeConfig.CloudService == constants.ExistingHostsService || nodeConfig.SecurityGroup == "" {
+		return nil
+	}
+	cloudService := getNodeCloudService(nodeConfig)
+	resources := []cloudResource{
+		{cloudService: cloudService, location: nodeConfig.Region, name: nodeConfig.SecurityGroup},
+	}
+	if nodeConfig.KeyPair != "" {
+		resources = append(resources, cloudResource{cloudService: cloudService, location: nodeConfig.Region, name: nodeConfig.KeyPair})
+	}
+	return resources
+}
+
+func printDestroyPlan(clusterName string, plan destroyPlan) {
+	ux.Logger.PrintToUser("Destroying cluster %s will:", clusterName)
+	for _, nodeConfig := range plan.nodes {
+		switch {
+		case nodeConfig.CloudService == constants.ExistingHostsService:
+			ux.Logger.PrintToUser("- stop odysseygo on existing host %s", nodeConfig.NodeID)
+		case nodeConfig.UseStaticIP:
+			ux.Logger.PrintToUser("- terminate instance %s in %s[%s] and release its static IP %s", nodeConfig.NodeID, getNodeCloudService(nodeConfig), nodeConfig.Region, nodeConfig.ElasticIP)
+		default:
+			ux.Logger.PrintToUser("- terminate instance %s in %s[%s]", nodeConfig.NodeID, getNodeCloudService(nodeConfig), nodeConfig.Region)
+		}
+	}
+	for _, securityGroup := range plan.securityGroups {
+		ux.Logger.PrintToUser("- delete security group %s", securityGroup)
+	}
+	for _, keyPair := range plan.keyPairs {
+		ux.Logger.PrintToUser("- delete key pair %s", keyPair)
+	}
+	for _, resource := range plan.kept {
+		ux.Logger.PrintToUser("- keep %s, used by other clusters", resource)
+	}
+	for _, node := range plan.missingNodes {
+		ux.Logger.PrintToUser("- forget node %s, which has no local config", node)
+	}
+	ux.Logger.PrintToUser("- remove all local files of the cluster from %s", app.GetNodesDir())
+}
+
+// executeDestroyPlan destroys the resources of [plan] using the cloud [providers], created
+// as needed, and removes the local files of [clusterName]. If any instance can't be destroyed,
+// the shared resources and the cluster are kept so that the command can be retried.
+func executeDestroyPlan(clusterName string, plan destroyPlan, providers map[string]cloud.Provider) error {
+	nodeErrors := map[string]error{}
+	for _, nodeConfig := range plan.nodes {
+		if nodeConfig.CloudService == constants.ExistingHostsService {
+			if err := stopExistingHost(clusterName, nodeConfig.NodeID); err != nil {
+				nodeErrors[nodeConfig.NodeID] = err
+				continue
+			}
+		} else {
+			provider, err := getAuthorizedCloudProvider(providers, getNodeCloudService(nodeConfig))
+			if err != nil {
+				return err
+			}
+			ux.Logger.PrintToUser("Terminating node instance %s in cluster %s...", nodeConfig.NodeID, clusterName)
+			if err := provider.TerminateInstance(nodeConfig); err != nil {
+				if isExpiredCredentialError(err) {
+					ux.Logger.PrintToUser("")
+					printExpiredCredentialsOutput(awsProfile)
+				}
+				nodeErrors[nodeConfig.NodeID] = err
+				continue
+			}
+		}
+		if err := removeDeletedNodeDirectory(nodeConfig.NodeID); err != nil {
+			return err
+		}
+		if err := removeNodeFromCluster(clusterName, nodeConfig.NodeID); err != nil {
+			return err
+		}
+	}
+	if len(nodeErrors) > 0 {
+		ux.Logger.PrintToUser("Failed nodes: ")
+		for node, nodeErr := range nodeErrors {
+			ux.Logger.PrintToUser("Failed to destroy node %s due to %s", node, nodeErr)
+		}
+		return fmt.Errorf("failed to destroy node(s) %s", maps.Keys(nodeErrors))
+	}
+	resourceErrors := map[string]error{}
+	for _, securityGroup := range plan.securityGroups {
+		provider, err := getAuthorizedCloudProvider(providers, securityGroup.cloudService)
+		if err != nil {
+			return err
+		}
+		ux.Logger.PrintToUser("Deleting security group %s...", securityGroup)
+		if err := provider.DeleteSecurityGroup(securityGroup.location, securityGroup.name); err != nil {
+			resourceErrors[securityGroup.String()] = err
+		}
+	}
+	for _, keyPair := range plan.keyPairs {
+		provider, err := getAuthorizedCloudProvider(providers, keyPair.cloudService)
+		if err != nil {
+			return err
+		}
+		ux.Logger.PrintToUser("Deleting key pair %s...", keyPair)
+		if err := provider.DeleteSSHKey(keyPair.location, keyPair.name); err != nil {
+			resourceErrors[keyPair.String()] = err
+		}
+	}
+	if err := removeClustersConfigFiles(clusterName); err != nil {
+		return err
+	}
+	if err := removeKeyPairsFromClustersConfig(plan.keyPairs); err != nil {
+		return err
+	}
+	if len(resourceErrors) > 0 {
+		ux.Logger.PrintToUser("All nodes of cluster %s are destroyed, but some cloud resources could not be deleted: ", clusterName)
+		for resource, err := range resourceErrors {
+			ux.Logger.PrintToUser("Failed to delete %s due to %s", resource, err)
+		}
+		ux.Logger.PrintToUser("Delete the above resource(s) on the cloud console")
+		return fmt.Errorf("failed to delete cloud resource(s) %s", maps.Keys(resourceErrors))
+	}
+	return nil
+}
+
+// removeNodeFromCluster removes [nodeID] from the nodes, or as monitoring instance, of [clusterName]
+func removeNodeFromCluster(clusterName, nodeID string) error {
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return err
+	}
+	clusterConfig, ok := clustersConfig.Clusters[clusterName]
+	if !ok {
+		return nil
+	}
+	if i := slices.Index(clusterConfig.Nodes, nodeID); i != -1 {
+		clusterConfig.Nodes = slices.Delete(clusterConfig.Nodes, i, i+1)
+	}
+	if clusterConfig.MonitoringInstance == nodeID {
+		clusterConfig.MonitoringInstance = ""
+	}
+	clustersConfig.Clusters[clusterName] = clusterConfig
+	return app.WriteClustersConfigFile(&clustersConfig)
+}
+
+// removeKeyPairsFromClustersConfig forgets the cert paths of the deleted [keyPairs]
+func removeKeyPairsFromClustersConfig(keyPairs []cloudResource) error {
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return err
+	}
+	for _, keyPair := range keyPairs {
+		delete(clustersConfig.KeyPair, keyPair.name)
+	}
+	return app.WriteClustersConfigFile(&clustersConfig)
+}
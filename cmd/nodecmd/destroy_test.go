@@ -0,0 +1,127 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud/fake"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// createFakeClusterNodes creates [count] instances of [clusterName] in [location] of [provider],
+// using the security group and key pair named after the location, and stores their node configs
+func createFakeClusterNodes(t *testing.T, provider *fake.Provider, clusterName, location string, count int) []string {
+	require := require.New(t)
+	sgName := location + "-sg"
+	keyPair := location + "-kp"
+	sgID, err := provider.SetupSecurityGroup(location, sgName, testUserIP)
+	require.NoError(err)
+	exists, err := provider.CheckSSHKeyExists(location, keyPair)
+	require.NoError(err)
+	if !exists {
+		require.NoError(provider.ImportSSHKey(location, keyPair, "ssh-ed25519 AAAA"))
+	}
+	staticIPs, err := provider.AllocateStaticIPs(location, clusterName, count)
+	require.NoError(err)
+	instanceIDs, err := provider.CreateInstances(cloud.InstanceSpec{
+		Location:      location,
+		Count:         count,
+		SSHKeyName:    keyPair,
+		SecurityGroup: sgID,
+		StaticIPs:     staticIPs,
+	})
+	require.NoError(err)
+	clustersConfig := models.ClustersConfig{}
+	if app.ClustersConfigExists() {
+		clustersConfig, err = app.LoadClustersConfig()
+		require.NoError(err)
+	}
+	if clustersConfig.Clusters == nil {
+		clustersConfig.Clusters = map[string]models.ClusterConfig{}
+	}
+	if clustersConfig.KeyPair == nil {
+		clustersConfig.KeyPair = map[string]string{}
+	}
+	clustersConfig.KeyPair[keyPair] = keyPair + ".pem"
+	clusterConfig := clustersConfig.Clusters[clusterName]
+	clusterConfig.Nodes = append(clusterConfig.Nodes, instanceIDs...)
+	clustersConfig.Clusters[clusterName] = clusterConfig
+	require.NoError(app.WriteClustersConfigFile(&clustersConfig))
+	for i, instanceID := range instanceIDs {
+		require.NoError(app.CreateNodeCloudConfigFile(instanceID, &models.NodeConfig{
+			NodeID:        instanceID,
+			Region:        location,
+			KeyPair:       keyPair,
+			SecurityGroup: sgName,
+			ElasticIP:     staticIPs[i].Address,
+			CloudService:  fake.CloudService,
+			UseStaticIP:   true,
+		}))
+	}
+	return instanceIDs
+}
+
+func TestDestroyCluster(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	provider := fake.NewProvider()
+	nodes := createFakeClusterNodes(t, provider, "c1", "loc-a", 2)
+	nodes = append(nodes, createFakeClusterNodes(t, provider, "c1", "loc-b", 1)...)
+	otherNodes := createFakeClusterNodes(t, provider, "c2", "loc-a", 1)
+
+	plan, err := getDestroyPlan("c1")
+	require.NoError(err)
+	require.Len(plan.nodes, 3)
+	require.Equal([]cloudResource{{fake.CloudService, "loc-b", "loc-b-sg"}}, plan.securityGroups)
+	require.Equal([]cloudResource{{fake.CloudService, "loc-b", "loc-b-kp"}}, plan.keyPairs)
+	require.Len(plan.kept, 2)
+
+	providers := map[string]cloud.Provider{fake.CloudService: provider}
+	require.NoError(executeDestroyPlan("c1", plan, providers))
+	for _, node := range nodes {
+		require.Equal(fake.InstanceTerminated, provider.Instances[node].State)
+		require.NoDirExists(app.GetNodeInstanceDirPath(node))
+	}
+	require.Len(provider.StaticIPs, 1)
+	require.Contains(provider.SecurityGroups, "loc-a/loc-a-sg")
+	require.NotContains(provider.SecurityGroups, "loc-b/loc-b-sg")
+	require.Contains(provider.SSHKeys, "loc-a/loc-a-kp")
+	require.NotContains(provider.SSHKeys, "loc-b/loc-b-kp")
+
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(err)
+	require.NotContains(clustersConfig.Clusters, "c1")
+	require.Equal(otherNodes, clustersConfig.Clusters["c2"].Nodes)
+	require.Equal(map[string]string{"loc-a-kp": "loc-a-kp.pem"}, clustersConfig.KeyPair)
+}
+
+func TestDestroyClusterInstanceFailure(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	provider := fake.NewProvider()
+	nodes := createFakeClusterNodes(t, provider, "c1", "loc-a", 1)
+
+	plan, err := getDestroyPlan("c1")
+	require.NoError(err)
+	errTerminate := errors.New("instance is protected")
+	provider.Fail["TerminateInstance"] = errTerminate
+	providers := map[string]cloud.Provider{fake.CloudService: provider}
+	require.ErrorContains(executeDestroyPlan("c1", plan, providers), nodes[0])
+
+	// the shared resources and the cluster are kept to retry
+	require.Contains(provider.SecurityGroups, "loc-a/loc-a-sg")
+	require.Contains(provider.SSHKeys, "loc-a/loc-a-kp")
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(err)
+	require.Equal(nodes, clustersConfig.Clusters["c1"].Nodes)
+
+	delete(provider.Fail, "TerminateInstance")
+	require.NoError(executeDestroyPlan("c1", plan, providers))
+	require.Empty(provider.SecurityGroups)
+	require.Empty(provider.SSHKeys)
+}
@@ -3,13 +3,10 @@
 package nodecmd
 
 import (
-	"context"
 	"fmt"
 
-	awsAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/aws"
-	gcpAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/gcp"
-
 	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
@@ -32,51 +29,29 @@ func getNodesWithDynamicIP(clusterNodes []string) ([]models.NodeConfig, error) {
 
 func getPublicIPsForNodesWithDynamicIP(nodesWithDynamicIP []models.NodeConfig) (map[string]string, error) {
 	publicIPMap := make(map[string]string)
-	var (
-		err        error
-		lastRegion string
-		ec2Svc     *awsAPI.AwsCloud
-		gcpCloud   *gcpAPI.GcpCloud
-	)
+	providers := map[string]cloud.Provider{}
 	ux.Logger.PrintToUser("Getting Public IP(s) for node(s) with dynamic IP ...")
 	for _, node := range nodesWithDynamicIP {
-		if lastRegion == "" || node.Region != lastRegion {
-			if node.CloudService == "" || node.CloudService == constants.AWSCloudService {
-				ec2Svc, err = awsAPI.NewAwsCloud(awsProfile, node.Region)
-				if err != nil {
-					return nil, err
-				}
-			}
-			lastRegion = node.Region
-		}
-		var publicIP map[string]string
-		if node.CloudService == constants.GCPCloudService {
-			if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.GCPCloudService) != nil) {
+		cloudService := getNodeCloudService(node)
+		provider, ok := providers[cloudService]
+		if !ok {
+			if cloudService == constants.GCPCloudService && !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.GCPCloudService) != nil) {
 				return nil, fmt.Errorf("cloud access is required")
 			}
-			if gcpCloud == nil {
-				gcpClient, projectName, _, err := getGCPCloudCredentials()
-				if err != nil {
-					return nil, err
-				}
-				gcpCloud, err = gcpAPI.NewGcpCloud(gcpClient, projectName, context.Background())
-				if err != nil {
-					return nil, err
-				}
-			}
-			publicIP, err = gcpCloud.GetInstancePublicIPs(node.Region, []string{node.NodeID})
+			var err error
+			provider, err = getCloudProvider(cloudService)
 			if err != nil {
 				return nil, err
 			}
-		} else {
-			publicIP, err = ec2Svc.GetInstancePublicIPs([]string{node.NodeID})
-			if err != nil {
-				if isExpiredCredentialError(err) {
-					ux.Logger.PrintToUser("")
-					printExpiredCredentialsOutput(awsProfile)
-				}
-				return nil, err
+			providers[cloudService] = provider
+		}
+		publicIP, err := provider.GetInstancePublicIPs(node.Region, []string{node.NodeID})
+		if err != nil {
+			if isExpiredCredentialError(err) {
+				ux.Logger.PrintToUser("")
+				printExpiredCredentialsOutput(awsProfile)
 			}
+			return nil, err
 		}
 		publicIPMap[node.NodeID] = publicIP[node.NodeID]
 	}
@@ -0,0 +1,53 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+)
+
+const hostRetryInterval = 5 * time.Second
+
+var (
+	hostsConcurrency int
+	hostTimeout      time.Duration
+	hostRetries      int
+	continueOnError  bool
+)
+
+// getExecutorOptions returns the options given by the node command flags
+func getExecutorOptions() executor.Options {
+	return executor.Options{
+		Concurrency:     hostsConcurrency,
+		Timeout:         hostTimeout,
+		Retries:         hostRetries,
+		RetryInterval:   hostRetryInterval,
+		ContinueOnError: continueOnError,
+	}
+}
+
+// runOnHosts runs [op] on [hosts] with the options given by the node command flags
+func runOnHosts(hosts []*models.Host, op executor.Operation) *models.NodeResults {
+	return executor.Run(hosts, getExecutorOptions(), op)
+}
+
+// runOnHostsOnce runs [op] on [hosts] with the options given by the node command flags,
+// but without timeout nor retries. It is used for operations that change the node binaries
+// or configs, which must neither be cut off, leaving the node stopped, nor run again over
+// a partially applied attempt
+func runOnHostsOnce(hosts []*models.Host, op executor.Operation) *models.NodeResults {
+	opts := getExecutorOptions()
+	opts.Timeout = 0
+	opts.Retries = 0
+	return executor.Run(hosts, opts, op)
+}
+
+// runOnHostsSequentially runs [op] on [hosts] one at a time, in order, continuing
+// after failures. It is used for operations that issue transactions from the same
+// wallet or prompt the user, so timeouts and retries are not applied
+func runOnHostsSequentially(hosts []*models.Host, op executor.Operation) *models.NodeResults {
+	return executor.Run(hosts, executor.Options{Concurrency: 1, ContinueOnError: true}, op)
+}
@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"sync"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
@@ -21,30 +20,18 @@ import (
 
 func checkHostsAreHealthy(hosts []*models.Host) ([]string, error) {
 	ux.Logger.PrintToUser("Checking if node(s) are healthy ...")
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if resp, err := ssh.RunSSHCheckHealthy(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			} else {
-				if isHealthy, err := parseHealthyOutput(resp); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, isHealthy, err)
-				}
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return nil, fmt.Errorf("failed to get health status for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		resp, err := ssh.RunSSHCheckHealthy(host)
+		if err != nil {
+			return nil, err
+		}
+		return parseHealthyOutput(resp)
+	})
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to get health status for node(s) %s", results.GetErrorHostMap())
 	}
-	return utils.Filter(wgResults.GetNodeList(), func(nodeID string) bool {
-		return !wgResults.GetResultMap()[nodeID].(bool)
+	return utils.Filter(results.GetNodeList(), func(nodeID string) bool {
+		return !results.GetResultMap()[nodeID].(bool)
 	}), nil
 }
 
@@ -65,30 +52,18 @@ func parseHealthyOutput(byteValue []byte) (bool, error) {
 
 func checkHostsAreBootstrapped(hosts []*models.Host) ([]string, error) {
 	ux.Logger.PrintToUser("Checking if node(s) are bootstrapped to Primary Network ...")
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if resp, err := ssh.RunSSHCheckBootstrapped(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			} else {
-				if isBootstrapped, err := parseBootstrappedOutput(resp); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, isBootstrapped, err)
-				}
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return nil, fmt.Errorf("failed to get odysseygo bootstrapp status for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		resp, err := ssh.RunSSHCheckBootstrapped(host)
+		if err != nil {
+			return nil, err
+		}
+		return parseBootstrappedOutput(resp)
+	})
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to get odysseygo bootstrapp status for node(s) %s", results.GetErrorHostMap())
 	}
-	return utils.Filter(wgResults.GetNodeList(), func(nodeID string) bool {
-		return !wgResults.GetResultMap()[nodeID].(bool)
+	return utils.Filter(results.GetNodeList(), func(nodeID string) bool {
+		return !results.GetResultMap()[nodeID].(bool)
 	}), nil
 }
 
@@ -117,30 +92,18 @@ func checkOdysseyGoVersionCompatible(hosts []*models.Host, subnetName string) ([
 	if err != nil {
 		return nil, err
 	}
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if resp, err := ssh.RunSSHCheckOdysseyGoVersion(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			} else {
-				if odysseyGoVersion, err := parseOdysseyGoOutput(resp); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, odysseyGoVersion, err)
-				}
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return nil, fmt.Errorf("failed to get odysseygo version for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		resp, err := ssh.RunSSHCheckOdysseyGoVersion(host)
+		if err != nil {
+			return nil, err
+		}
+		return parseOdysseyGoOutput(resp)
+	})
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to get odysseygo version for node(s) %s", results.GetErrorHostMap())
 	}
 	incompatibleNodes := []string{}
-	for nodeID, odysseyGoVersion := range wgResults.GetResultMap() {
+	for nodeID, odysseyGoVersion := range results.GetResultMap() {
 		if !slices.Contains(compatibleVersions, fmt.Sprintf("%v", odysseyGoVersion)) {
 			incompatibleNodes = append(incompatibleNodes, nodeID)
 		}
@@ -0,0 +1,214 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// hostKeysLock serializes the updates of pinned host keys done by concurrent connections
+var hostKeysLock sync.Mutex
+
+// getClusterHosts returns the hosts in the ansible inventory of [clusterName],
+// set up to verify the SSH host keys pinned in its cluster config
+func getClusterHosts(clusterName string) ([]*models.Host, error) {
+	return getClusterHostsFromInventory(clusterName, app.GetAnsibleInventoryDirPath(clusterName))
+}
+
+// getClusterHostsFromInventory returns the hosts in the ansible inventory at [inventoryDirPath],
+// set up to verify the SSH host keys pinned in the cluster config of [clusterName]
+func getClusterHostsFromInventory(clusterName string, inventoryDirPath string) ([]*models.Host, error) {
+	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(inventoryDirPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := setHostKeys(clusterName, hosts); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// getClusterHostMap returns the hosts of [clusterName] indexed by ansible ID,
+// set up to verify the SSH host keys pinned in its cluster config
+func getClusterHostMap(clusterName string) (map[string]*models.Host, error) {
+	hosts, err := getClusterHosts(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	hostMap := map[string]*models.Host{}
+	for _, host := range hosts {
+		hostMap[host.NodeID] = host
+	}
+	return hostMap, nil
+}
+
+// setHostKeys sets on [hosts] the SSH host keys pinned in the cluster config of [clusterName],
+// and makes the first connection to a host without a pinned key pin the key it presents.
+// It fails if [clusterName] has no cluster config, as the keys could be neither checked nor pinned
+func setHostKeys(clusterName string, hosts []*models.Host) error {
+	clustersConfig := models.ClustersConfig{}
+	if app.ClustersConfigExists() {
+		var err error
+		clustersConfig, err = app.LoadClustersConfig()
+		if err != nil {
+			return err
+		}
+	}
+	clusterConfig, ok := clustersConfig.Clusters[clusterName]
+	if !ok {
+		return fmt.Errorf("cluster %s not found in the clusters config: can't verify the SSH host keys of its nodes", clusterName)
+	}
+	for _, host := range hosts {
+		hostID := host.NodeID
+		host.HostKey = clusterConfig.HostKeys[hostID]
+		host.PinHostKey = func(hostKey string) error {
+			return pinHostKey(clusterName, hostID, hostKey)
+		}
+	}
+	return nil
+}
+
+// pinHostKey saves [hostKey] as the pinned SSH host key of host [hostID] in the cluster
+// config of [clusterName]. An empty [hostKey] removes the pinned key.
+func pinHostKey(clusterName string, hostID string, hostKey string) error {
+	hostKeysLock.Lock()
+	defer hostKeysLock.Unlock()
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return err
+	}
+	clusterConfig, ok := clustersConfig.Clusters[clusterName]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterName)
+	}
+	if clusterConfig.HostKeys == nil {
+		clusterConfig.HostKeys = map[string]string{}
+	}
+	if hostKey == "" {
+		delete(clusterConfig.HostKeys, hostID)
+	} else {
+		clusterConfig.HostKeys[hostID] = hostKey
+	}
+	clustersConfig.Clusters[clusterName] = clusterConfig
+	if err := app.WriteClustersConfigFile(&clustersConfig); err != nil {
+		return err
+	}
+	// keep the known_hosts file used by the inventory in sync
+	_, err = writeKnownHostsFile(clusterName)
+	return err
+}
+
+// writeKnownHostsFile writes an OpenSSH known_hosts file with the SSH host keys pinned
+// for the hosts of [clusterName], including its separate monitoring host if any, so that
+// they are also verified by the ssh command and by ansible, and returns its path
+func writeKnownHostsFile(clusterName string) (string, error) {
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return "", err
+	}
+	hostKeys := clustersConfig.Clusters[clusterName].HostKeys
+	inventoryDirPath := app.GetAnsibleInventoryDirPath(clusterName)
+	lines := []string{}
+	for _, dirPath := range []string{inventoryDirPath, filepath.Join(inventoryDirPath, constants.MonitoringDir)} {
+		if !utils.FileExists(filepath.Join(dirPath, constants.AnsibleHostInventoryFileName)) {
+			continue
+		}
+		hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(dirPath)
+		if err != nil {
+			return "", err
+		}
+		for _, host := range hosts {
+			hostKey := hostKeys[host.NodeID]
+			if hostKey == "" {
+				continue
+			}
+			key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+			if err != nil {
+				return "", fmt.Errorf("invalid host key pinned for host %s: %w", host.NodeID, err)
+			}
+			address := host.IP
+			if host.SSHPort != 0 {
+				address = net.JoinHostPort(host.IP, strconv.Itoa(host.SSHPort))
+			}
+			lines = append(lines, knownhosts.Line([]string{address}, key))
+		}
+	}
+	knownHostsPath := app.GetSSHKnownHostsPath(clusterName)
+	if err := os.MkdirAll(inventoryDirPath, constants.DefaultPerms755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(knownHostsPath, []byte(strings.Join(lines, "\n")+"\n"), constants.WriteReadReadPerms); err != nil {
+		return "", err
+	}
+	return knownHostsPath, nil
+}
+
+// rotateHostKeyPrompt asks to confirm the rotation of a pinned host key
+const rotateHostKeyPrompt = "Pin the new host key"
+
+// rotateHostKeys replaces the SSH host keys pinned for [hosts] of [clusterName]
+// with the ones they present now. Each new key is pinned only if it has the expected
+// fingerprint given by the user, or if the user confirms it.
+func rotateHostKeys(clusterName string, hosts []*models.Host) error {
+	if expectedFingerprint != "" && len(hosts) != 1 {
+		return fmt.Errorf("--expected-fingerprint requires a single node")
+	}
+	for _, host := range hosts {
+		oldHostKey := host.HostKey
+		newHostKey, err := host.ScanHostKey()
+		if err != nil {
+			return err
+		}
+		newFingerprint := models.HostKeyFingerprint(newHostKey)
+		if oldHostKey == newHostKey {
+			ux.Logger.PrintToUser("[%s] host key %s is unchanged", host.GetCloudID(), newFingerprint)
+			continue
+		}
+		oldFingerprint := "none"
+		if oldHostKey != "" {
+			oldFingerprint = models.HostKeyFingerprint(oldHostKey)
+		}
+		ux.Logger.PrintToUser("[%s] pinned host key:    %s", host.GetCloudID(), oldFingerprint)
+		ux.Logger.PrintToUser("[%s] presented host key: %s", host.GetCloudID(), newFingerprint)
+		if expectedFingerprint != "" {
+			if newFingerprint != expectedFingerprint {
+				return fmt.Errorf("host %s presents host key %s instead of the expected %s", host.IP, newFingerprint, expectedFingerprint)
+			}
+		} else {
+			yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("%s %s for host %s?", rotateHostKeyPrompt, newFingerprint, host.IP))
+			if err != nil {
+				return err
+			}
+			if !yes {
+				return fmt.Errorf("host key rotation of host %s aborted by user", host.IP)
+			}
+		}
+		if err := pinHostKey(clusterName, host.NodeID, newHostKey); err != nil {
+			return err
+		}
+		// the host must keep presenting the confirmed key
+		host.HostKey = newHostKey
+		if err := host.Connect(); err != nil {
+			return err
+		}
+		if err := host.Disconnect(); err != nil {
+			return err
+		}
+		ux.Logger.PrintToUser("[%s] pinned new host key %s", host.GetCloudID(), newFingerprint)
+	}
+	return nil
+}
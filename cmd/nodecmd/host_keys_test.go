@@ -0,0 +1,118 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// pinStaleHostKey pins a random host key for the first host of the test cluster, as if
+// the host key was changed since, and returns the host with its real host key
+func pinStaleHostKey(t *testing.T) (*models.Host, string) {
+	require := require.New(t)
+	hosts, err := getClusterHosts(testClusterName)
+	require.NoError(err)
+	host := hosts[0]
+	hostKey, err := host.ScanHostKey()
+	require.NoError(err)
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	staleKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(err)
+	require.NoError(pinHostKey(testClusterName, host.NodeID, models.MarshalHostKey(staleKey)))
+	hosts, err = getClusterHosts(testClusterName)
+	require.NoError(err)
+	require.ErrorIs(hosts[0].Connect(), models.ErrHostKeyMismatch)
+	return hosts[0], hostKey
+}
+
+func getPinnedHostKey(t *testing.T, hostID string) string {
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(t, err)
+	return clustersConfig.Clusters[testClusterName].HostKeys[hostID]
+}
+
+func TestRotateHostKeysConfirmation(t *testing.T) {
+	require := require.New(t)
+	setupClusterTest(t, 1)
+	mockPrompt := &mocks.Prompter{}
+	app.Prompt = mockPrompt
+	host, hostKey := pinStaleHostKey(t)
+	staleKey := host.HostKey
+
+	mockPrompt.On("CaptureYesNo", mock.Anything).Return(false, nil).Once()
+	require.ErrorContains(rotateHostKeys(testClusterName, []*models.Host{host}), "aborted by user")
+	require.Equal(staleKey, getPinnedHostKey(t, host.NodeID))
+
+	mockPrompt.On("CaptureYesNo", mock.Anything).Return(true, nil).Once()
+	require.NoError(rotateHostKeys(testClusterName, []*models.Host{host}))
+	require.Equal(hostKey, getPinnedHostKey(t, host.NodeID))
+	mockPrompt.AssertExpectations(t)
+}
+
+func TestRotateHostKeysExpectedFingerprint(t *testing.T) {
+	require := require.New(t)
+	setupClusterTest(t, 1)
+	host, hostKey := pinStaleHostKey(t)
+	staleKey := host.HostKey
+	defer func() { expectedFingerprint = "" }()
+
+	expectedFingerprint = models.HostKeyFingerprint(staleKey)
+	require.ErrorContains(rotateHostKeys(testClusterName, []*models.Host{host}), "instead of the expected "+expectedFingerprint)
+	require.Equal(staleKey, getPinnedHostKey(t, host.NodeID))
+
+	// no confirmation is asked with the right fingerprint
+	expectedFingerprint = models.HostKeyFingerprint(hostKey)
+	require.NoError(rotateHostKeys(testClusterName, []*models.Host{host}))
+	require.Equal(hostKey, getPinnedHostKey(t, host.NodeID))
+}
+
+func TestPinHostKeyWritesKnownHosts(t *testing.T) {
+	require := require.New(t)
+	setupClusterTest(t, 2)
+
+	hosts, err := getClusterHosts(testClusterName)
+	require.NoError(err)
+	defer disconnectHosts(hosts)
+	require.Empty(waitForHosts(hosts).GetErrorHostMap())
+
+	knownHostsPath := app.GetSSHKnownHostsPath(testClusterName)
+	checkHostKey, err := knownhosts.New(knownHostsPath)
+	require.NoError(err)
+	for _, host := range hosts {
+		// the inventory makes ansible verify the pinned keys
+		require.Equal("-o StrictHostKeyChecking=yes -o UserKnownHostsFile="+knownHostsPath, host.SSHCommonArgs)
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(getPinnedHostKey(t, host.NodeID)))
+		require.NoError(err)
+		address := &net.TCPAddr{IP: net.ParseIP(host.IP), Port: host.SSHPort}
+		require.NoError(checkHostKey(address.String(), address, hostKey))
+	}
+}
+
+func TestGetClusterHostsUnknownCluster(t *testing.T) {
+	require := require.New(t)
+	setupClusterTest(t, 1)
+	clustersConfig, err := app.LoadClustersConfig()
+	require.NoError(err)
+	delete(clustersConfig.Clusters, testClusterName)
+	require.NoError(app.WriteClustersConfigFile(&clustersConfig))
+
+	// the hosts would otherwise trust any host key without pinning it
+	_, err = getClusterHosts(testClusterName)
+	require.ErrorContains(err, "cluster cluster1 not found")
+
+	require.NoError(os.Remove(app.GetClustersConfigPath()))
+	_, err = getClusterHosts(testClusterName)
+	require.ErrorContains(err, "cluster cluster1 not found")
+}
@@ -4,12 +4,14 @@ package nodecmd
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 )
 
 func newListCmd() *cobra.Command {
@@ -27,6 +29,19 @@ The node list command lists all clusters together with their nodes.`,
 	return cmd
 }
 
+// clusterListItem is the --output json|yaml schema of node list
+type clusterListItem struct {
+	Name    string         `json:"name" yaml:"name"`
+	Network string         `json:"network" yaml:"network"`
+	Nodes   []nodeListItem `json:"nodes" yaml:"nodes"`
+}
+
+type nodeListItem struct {
+	CloudID string `json:"cloudID" yaml:"cloudID"`
+	NodeID  string `json:"nodeID" yaml:"nodeID"`
+	IP      string `json:"ip" yaml:"ip"`
+}
+
 func list(_ *cobra.Command, _ []string) error {
 	var err error
 	clustersConfig := models.ClustersConfig{}
@@ -36,11 +51,11 @@ func list(_ *cobra.Command, _ []string) error {
 			return err
 		}
 	}
-	if len(clustersConfig.Clusters) == 0 {
-		ux.Logger.PrintToUser("There are no clusters defined.")
-	}
-	for clusterName, clusterConf := range clustersConfig.Clusters {
-		ux.Logger.PrintToUser("Cluster %q (%s)", clusterName, clusterConf.Network.Name())
+	clusterNames := maps.Keys(clustersConfig.Clusters)
+	sort.Strings(clusterNames)
+	clusters := []clusterListItem{}
+	for _, clusterName := range clusterNames {
+		clusterConf := clustersConfig.Clusters[clusterName]
 		if err := checkCluster(clusterName); err != nil {
 			return err
 		}
@@ -48,10 +63,15 @@ func list(_ *cobra.Command, _ []string) error {
 		if err != nil {
 			return err
 		}
-		ansibleHosts, err := ansible.GetHostMapfromAnsibleInventory(app.GetAnsibleInventoryDirPath(clusterName))
+		ansibleHosts, err := getClusterHostMap(clusterName)
 		if err != nil {
 			return err
 		}
+		cluster := clusterListItem{
+			Name:    clusterName,
+			Network: clusterConf.Network.Name(),
+			Nodes:   []nodeListItem{},
+		}
 		for _, ansibleHostID := range ansibleHostIDs {
 			_, cloudHostID, err := models.HostAnsibleIDToCloudID(ansibleHostID)
 			if err != nil {
@@ -61,8 +81,23 @@ func list(_ *cobra.Command, _ []string) error {
 			if err != nil {
 				return err
 			}
-			ux.Logger.PrintToUser(fmt.Sprintf("  Node %s (%s) %s", cloudHostID, nodeID.String(), ansibleHosts[ansibleHostID].IP))
+			cluster.Nodes = append(cluster.Nodes, nodeListItem{
+				CloudID: cloudHostID,
+				NodeID:  nodeID.String(),
+				IP:      ansibleHosts[ansibleHostID].IP,
+			})
 		}
+		clusters = append(clusters, cluster)
 	}
-	return nil
+	return ux.Render(clusters, func() {
+		if len(clusters) == 0 {
+			ux.Logger.PrintToUser("There are no clusters defined.")
+		}
+		for _, cluster := range clusters {
+			ux.Logger.PrintToUser("Cluster %q (%s)", cluster.Name, cluster.Network)
+			for _, node := range cluster.Nodes {
+				ux.Logger.PrintToUser(fmt.Sprintf("  Node %s (%s) %s", node.CloudID, node.NodeID, node.IP))
+			}
+		}
+	})
 }
@@ -21,7 +21,14 @@ validators on Odyssey Network.
 
 To get started, use the node create command wizard to walk through the
 configuration to make your node a primary validator on Odyssey public network. You can use the
-rest of the commands to maintain your node and make your node a Subnet Validator.`,
+rest of the commands to maintain your node and make your node a Subnet Validator.
+
+Commands operating on the nodes of a cluster run on all of them in parallel. Use
+--max-parallel to limit how many nodes are operated on at the same time, --host-timeout
+and --retries to bound and retry the operation on each node, and --continue-on-error to
+keep going with the remaining nodes after a node fails. A summary of the result on each
+node is shown at the end. Commands that change the node binaries or configs (upgrade,
+rollback, updateSubnet) are neither timed out nor retried.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := cmd.Help()
 			if err != nil {
@@ -30,14 +37,22 @@ rest of the commands to maintain your node and make your node a Subnet Validator
 		},
 	}
 	app = injectedApp
+	cmd.PersistentFlags().IntVar(&hostsConcurrency, "max-parallel", 0, "maximum number of nodes to operate on at the same time (0 for all)")
+	cmd.PersistentFlags().DurationVar(&hostTimeout, "host-timeout", 0, "maximum duration of the operation on each node (ex: 10m)")
+	cmd.PersistentFlags().IntVar(&hostRetries, "retries", 0, "number of times to retry a failed operation on a node")
+	cmd.PersistentFlags().BoolVar(&continueOnError, "continue-on-error", false, "keep operating on the remaining nodes after a node fails")
 	// node create
 	cmd.AddCommand(newCreateCmd())
+	// node add-host
+	cmd.AddCommand(newAddHostCmd())
 	// node validate
 	cmd.AddCommand(NewValidateCmd())
 	// node sync cluster --subnet subnetName
 	cmd.AddCommand(newSyncCmd())
 	// node stop
 	cmd.AddCommand(newStopCmd())
+	// node destroy
+	cmd.AddCommand(newDestroyCmd())
 	// node status cluster
 	cmd.AddCommand(newStatusCmd())
 	// node list
@@ -48,6 +63,8 @@ rest of the commands to maintain your node and make your node a Subnet Validator
 	cmd.AddCommand(newDevnetCmd())
 	// node upgrade
 	cmd.AddCommand(newUpgradeCmd())
+	// node rollback
+	cmd.AddCommand(newRollbackCmd())
 	// node ssh
 	cmd.AddCommand(newSSHCmd())
 	// node refresh-ips
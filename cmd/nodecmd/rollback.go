@@ -0,0 +1,252 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var rollbackToVersion string
+
+func newRollbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback [clusterName]",
+		Short: "(ALPHA Warning) Roll back odysseygo or VM version for all nodes in a cluster",
+		Long: `(ALPHA Warning) This command is currently in experimental mode.
+
+The node rollback command restores the odysseygo and Subnet EVM binaries that were
+replaced by the last node upgrade of each node in the cluster, and restarts the nodes.
+
+node upgrade keeps the replaced binaries on the nodes and records their versions in
+the node config. If you provide the --to flag, the nodes are rolled back to the last
+upgrade that replaced that odysseygo or Subnet EVM version, undoing all the later ones.
+
+As with node upgrade, nodes are rolled back in rolling batches of --batch-size nodes.
+After each batch, the command waits for the nodes to be healthy, bootstrapped and synced
+to the Subnets they run, before continuing with the next batch. If a batch fails to get
+back, the rollback is aborted and the remaining nodes are left untouched.
+
+Nodes with no recorded upgrade to roll back, as those left out of a partial upgrade, are
+skipped. The command fails only if no node of the cluster can be rolled back.`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE:         rollback,
+	}
+	cmd.Flags().StringVar(&rollbackToVersion, "to", "", "roll back to this odysseygo or Subnet EVM version (ex: v1.10.10)")
+	cmd.Flags().IntVar(&upgradeBatchSize, "batch-size", 1, "number of nodes to roll back at a time")
+	return cmd
+}
+
+func rollback(_ *cobra.Command, args []string) error {
+	clusterName := args[0]
+	if err := validateBatchSize(upgradeBatchSize); err != nil {
+		return err
+	}
+	if err := checkCluster(clusterName); err != nil {
+		return err
+	}
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return err
+	}
+	hosts, err := getClusterHosts(clusterName)
+	if err != nil {
+		return err
+	}
+	defer disconnectHosts(hosts)
+	rollbackIndices := map[*models.Host]int{}
+	nodeConfigs := map[*models.Host]models.NodeConfig{}
+	skippedNodes := map[string]error{}
+	toRollbackHosts := []*models.Host{}
+	for _, host := range hosts {
+		nodeConfig, err := app.LoadClusterNodeConfig(host.GetCloudID())
+		if err != nil {
+			return err
+		}
+		index, err := getRollbackIndex(nodeConfig.VersionHistory, rollbackToVersion)
+		if err != nil {
+			// nodes left out of a partial upgrade have nothing to roll back
+			skippedNodes[host.NodeID] = err
+			continue
+		}
+		rollbackIndices[host] = index
+		nodeConfigs[host] = nodeConfig
+		toRollbackHosts = append(toRollbackHosts, host)
+	}
+	if len(toRollbackHosts) == 0 {
+		return fmt.Errorf("can't roll back any node of cluster %s: %s", clusterName, skippedNodes)
+	}
+	batches := getHostBatches(toRollbackHosts, upgradeBatchSize)
+	printRollbackPlan(batches, rollbackIndices, nodeConfigs, skippedNodes)
+
+	blockchainIDs, err := getBlockchainIDsByVMID(clustersConfig.Clusters[clusterName].Network)
+	if err != nil {
+		return err
+	}
+	hostsBlockchainIDs := map[string][]ids.ID{}
+	for host, nodeConfig := range nodeConfigs {
+		for _, versions := range nodeConfig.VersionHistory {
+			for vmID := range versions.VMVersions {
+				blockchainID, ok := blockchainIDs[vmID]
+				if ok && !slices.Contains(hostsBlockchainIDs[host.NodeID], blockchainID) {
+					hostsBlockchainIDs[host.NodeID] = append(hostsBlockchainIDs[host.NodeID], blockchainID)
+				}
+			}
+		}
+	}
+	rolledBackNodes := []string{}
+	failedNodes := map[string]error{}
+	for batchIndex, batch := range batches {
+		ux.Logger.PrintToUser("Rolling back batch %d of %d ...", batchIndex+1, len(batches))
+		results := runOnHostsOnce(batch, func(host *models.Host) (interface{}, error) {
+			return nil, rollbackHost(host, nodeConfigs[host], rollbackIndices[host])
+		})
+		executor.PrintSummary(results)
+		if results.HasErrors() && !continueOnError {
+			return abortRollback(rolledBackNodes, batches[batchIndex:], fmt.Errorf("failed to roll back node(s) %s", results.GetErrorHostMap()))
+		}
+		maps.Copy(failedNodes, results.GetErrorHostMap())
+		rolledBackBatch := utils.Filter(batch, func(host *models.Host) bool {
+			return !results.HasNodeIDWithError(host.NodeID)
+		})
+		if len(rolledBackBatch) == 0 {
+			continue
+		}
+		if err := waitForUpgradedHosts(rolledBackBatch, hostsBlockchainIDs, upgradeCheckTimeout, upgradeCheckPoolTime); err != nil {
+			return abortRollback(rolledBackNodes, batches[batchIndex:], err)
+		}
+		for _, host := range rolledBackBatch {
+			rolledBackNodes = append(rolledBackNodes, host.NodeID)
+		}
+	}
+	if len(failedNodes) > 0 {
+		return fmt.Errorf("failed to roll back node(s) %s", failedNodes)
+	}
+	ux.Logger.PrintToUser("All nodes of cluster %s successfully rolled back!", clusterName)
+	return nil
+}
+
+// abortRollback reports which nodes were rolled back and which were not before returning [err]
+func abortRollback(rolledBackNodes []string, remainingBatches [][]*models.Host, err error) error {
+	return abortBatches("rollback", "Rolled back nodes", "Not rolled back nodes", rolledBackNodes, remainingBatches, err)
+}
+
+// getRollbackIndex returns the index of the [history] entry to roll back to: the last one
+// if [toVersion] is empty, or else the last one that replaced odysseygo or a VM at [toVersion]
+func getRollbackIndex(history []models.NodeVersions, toVersion string) (int, error) {
+	if len(history) == 0 {
+		return 0, fmt.Errorf("no upgrades recorded for the node")
+	}
+	if toVersion == "" {
+		return len(history) - 1, nil
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].OdysseyGoVersion == toVersion || slices.Contains(maps.Values(history[i].VMVersions), toVersion) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no upgrade from version %s recorded for the node", toVersion)
+}
+
+// getRollbackVersions merges the [history] entries from [index] on, so that each binary is
+// restored to the oldest version replaced since then
+func getRollbackVersions(history []models.NodeVersions, index int) models.NodeVersions {
+	versions := models.NodeVersions{VMVersions: map[string]string{}}
+	for i := len(history) - 1; i >= index; i-- {
+		if history[i].OdysseyGoVersion != "" {
+			versions.OdysseyGoVersion = history[i].OdysseyGoVersion
+		}
+		for vmID, vmVersion := range history[i].VMVersions {
+			versions.VMVersions[vmID] = vmVersion
+		}
+	}
+	return versions
+}
+
+// printRollbackPlan shows the versions each node of [batches] is rolled back to, and the
+// [skippedNodes] that have no recorded upgrade to roll back
+func printRollbackPlan(
+	batches [][]*models.Host,
+	rollbackIndices map[*models.Host]int,
+	nodeConfigs map[*models.Host]models.NodeConfig,
+	skippedNodes map[string]error,
+) {
+	ux.Logger.PrintToUser("Rollback plan:")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Batch", "Node", "OdysseyGo", "Subnet EVM"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for batchIndex, batch := range batches {
+		for _, host := range batch {
+			versions := getRollbackVersions(nodeConfigs[host].VersionHistory, rollbackIndices[host])
+			odysseyGoVersion := "-"
+			if versions.OdysseyGoVersion != "" {
+				odysseyGoVersion = versions.OdysseyGoVersion
+			}
+			vmIDs := maps.Keys(versions.VMVersions)
+			sort.Strings(vmIDs)
+			vmVersions := []string{}
+			for _, vmID := range vmIDs {
+				vmVersions = append(vmVersions, fmt.Sprintf("%s (%s)", versions.VMVersions[vmID], vmID))
+			}
+			if len(vmVersions) == 0 {
+				vmVersions = append(vmVersions, "-")
+			}
+			table.Append([]string{strconv.Itoa(batchIndex + 1), host.NodeID, odysseyGoVersion, strings.Join(vmVersions, "\n")})
+		}
+	}
+	table.Render()
+	skippedNodeIDs := maps.Keys(skippedNodes)
+	sort.Strings(skippedNodeIDs)
+	for _, nodeID := range skippedNodeIDs {
+		ux.Logger.PrintToUser("Skipping node %s: %s", nodeID, skippedNodes[nodeID])
+	}
+}
+
+// rollbackHost restores the binaries kept for the [nodeConfig] version history entries from [index] on,
+// restarts [host], and removes those entries from the history
+func rollbackHost(host *models.Host, nodeConfig models.NodeConfig, index int) error {
+	versions := getRollbackVersions(nodeConfig.VersionHistory, index)
+	ux.Logger.PrintToUser("Rolling back node %s ...", host.NodeID)
+	if err := ssh.RunSSHStopNode(host); err != nil {
+		return err
+	}
+	if versions.OdysseyGoVersion != "" {
+		backupBinaryPath := getBackupBinaryPath(constants.OdysseyGoRepoName, versions.OdysseyGoVersion)
+		if err := ssh.RunSSHRestoreBinary(host, backupBinaryPath, constants.CloudNodeOdysseyGoBinaryPath); err != nil {
+			return err
+		}
+	}
+	for vmID, vmVersion := range versions.VMVersions {
+		subnetEVMBinaryPath := fmt.Sprintf(constants.CloudNodeSubnetEvmBinaryPath, vmID)
+		if err := ssh.RunSSHRestoreBinary(host, getBackupBinaryPath(vmID, vmVersion), subnetEVMBinaryPath); err != nil {
+			return err
+		}
+	}
+	if err := ssh.RunSSHStartNode(host); err != nil {
+		return err
+	}
+	nodeConfig.VersionHistory = nodeConfig.VersionHistory[:index]
+	if err := app.CreateNodeCloudConfigFile(host.GetCloudID(), &nodeConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Successfully rolled back node %s!", host.NodeID)
+	ux.Logger.PrintToUser("======================================")
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRollbackVersions(t *testing.T) {
+	require := require.New(t)
+	history := []models.NodeVersions{
+		{OdysseyGoVersion: "v1.10.8", VMVersions: map[string]string{"vmA": "v0.5.5"}},
+		{OdysseyGoVersion: "v1.10.9", VMVersions: map[string]string{}},
+		{VMVersions: map[string]string{"vmA": "v0.5.6", "vmB": "v0.5.6"}},
+	}
+
+	index, err := getRollbackIndex(history, "")
+	require.NoError(err)
+	require.Equal(2, index)
+	require.Equal(models.NodeVersions{
+		VMVersions: map[string]string{"vmA": "v0.5.6", "vmB": "v0.5.6"},
+	}, getRollbackVersions(history, index))
+
+	index, err = getRollbackIndex(history, "v1.10.9")
+	require.NoError(err)
+	require.Equal(1, index)
+	require.Equal(models.NodeVersions{
+		OdysseyGoVersion: "v1.10.9",
+		VMVersions:       map[string]string{"vmA": "v0.5.6", "vmB": "v0.5.6"},
+	}, getRollbackVersions(history, index))
+
+	// each binary goes back to the oldest version replaced since the selected upgrade
+	index, err = getRollbackIndex(history, "v0.5.5")
+	require.NoError(err)
+	require.Equal(0, index)
+	require.Equal(models.NodeVersions{
+		OdysseyGoVersion: "v1.10.8",
+		VMVersions:       map[string]string{"vmA": "v0.5.5", "vmB": "v0.5.6"},
+	}, getRollbackVersions(history, index))
+
+	_, err = getRollbackIndex(history, "v1.9.0")
+	require.ErrorContains(err, "no upgrade from version v1.9.0")
+	_, err = getRollbackIndex(nil, "")
+	require.ErrorContains(err, "no upgrades recorded")
+}
@@ -7,16 +7,20 @@ import (
 	"os"
 	"os/exec"
 	"strings"
-	"sync"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/spf13/cobra"
 )
 
-var isParallel bool
+var (
+	isParallel          bool
+	rotateHostKey       bool
+	expectedFingerprint string
+)
 
 func newSSHCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,12 +32,21 @@ The node ssh command execute a given command [cmd] using ssh on all nodes in the
 If no command is given, just prints the ssh command to be used to connect to each node in the cluster.
 For provided NodeID or InstanceID or IP, the command [cmd] will be executed on that node.
 If no [cmd] is provided for the node, it will open ssh shell there.
+
+The SSH host key of each node is pinned on the first connection, and verified on every
+later one. If a node host key was legitimately changed, --rotate-host-key pins the one
+currently presented by the given node(s), after showing the pinned and presented key
+fingerprints and asking for confirmation. To rotate the key of a single node without
+confirmation, give the fingerprint of its new key with --expected-fingerprint.
 `,
 		SilenceUsage: true,
 		Args:         cobra.MinimumNArgs(0),
 		RunE:         sshNode,
 	}
 	cmd.Flags().BoolVar(&isParallel, "parallel", false, "run ssh command on all nodes in parallel")
+	cmd.Flags().BoolVar(&rotateHostKey, "rotate-host-key", false, "replace the pinned SSH host key of the node(s) with the one they present now")
+	cmd.Flags().StringVar(&expectedFingerprint, "expected-fingerprint", "", "with --rotate-host-key, pin the new host key of the node only if it has this SHA256 fingerprint")
+	prompts.RegisterFlagHint(cmd, rotateHostKeyPrompt, "--expected-fingerprint")
 	return cmd
 }
 
@@ -50,6 +63,12 @@ func sshNode(_ *cobra.Command, args []string) error {
 		ux.Logger.PrintToUser("There are no clusters defined.")
 		return nil
 	}
+	if rotateHostKey && len(args) != 1 {
+		return fmt.Errorf("--rotate-host-key requires a single cluster name, node ID, instance ID or IP, and no command")
+	}
+	if expectedFingerprint != "" && !rotateHostKey {
+		return fmt.Errorf("--expected-fingerprint can only be used with --rotate-host-key")
+	}
 	if len(args) == 0 {
 		// provide ssh connection string for all clusters
 		for clusterName, clusterConfig := range clustersConfig.Clusters {
@@ -61,19 +80,22 @@ func sshNode(_ *cobra.Command, args []string) error {
 		cmd := strings.Join(args[1:], " ")
 		if err := checkCluster(clusterNameOrNodeID); err == nil {
 			// clusterName detected
-			if len(args[1:]) == 0 {
+			if len(args[1:]) == 0 && !rotateHostKey {
 				return printClusterConnectionString(clusterNameOrNodeID, clustersConfig.Clusters[clusterNameOrNodeID].Network.Name())
 			} else {
-				clusterHosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterNameOrNodeID))
+				clusterHosts, err := getClusterHosts(clusterNameOrNodeID)
 				if err != nil {
 					return err
 				}
-				return sshHosts(clusterHosts, cmd)
+				if rotateHostKey {
+					return rotateHostKeys(clusterNameOrNodeID, clusterHosts)
+				}
+				return sshHosts(clusterNameOrNodeID, clusterHosts, cmd)
 			}
 		} else {
 			// try to detect nodeID
 			for clusterName := range clustersConfig.Clusters {
-				clusterHosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+				clusterHosts, err := getClusterHosts(clusterName)
 				if err != nil {
 					return err
 				}
@@ -88,7 +110,10 @@ func sshNode(_ *cobra.Command, args []string) error {
 				case len(selectedHost) > 2:
 					return fmt.Errorf("more then 1 node found for %s", clusterNameOrNodeID)
 				default:
-					return sshHosts(selectedHost, cmd)
+					if rotateHostKey {
+						return rotateHostKeys(clusterName, selectedHost)
+					}
+					return sshHosts(clusterName, selectedHost, cmd)
 				}
 			}
 		}
@@ -96,39 +121,31 @@ func sshNode(_ *cobra.Command, args []string) error {
 	}
 }
 
-func sshHosts(hosts []*models.Host, cmd string) error {
+func sshHosts(clusterName string, hosts []*models.Host, cmd string) error {
+	knownHostsPath, err := writeKnownHostsFile(clusterName)
+	if err != nil {
+		return err
+	}
 	if cmd != "" {
 		// execute cmd
-		wg := sync.WaitGroup{}
-		nowExecutingMutex := sync.Mutex{}
-		wgResults := models.NodeResults{}
-		for _, host := range hosts {
-			wg.Add(1)
-			go func(nodeResults *models.NodeResults, host *models.Host) {
-				if !isParallel {
-					nowExecutingMutex.Lock()
-					defer nowExecutingMutex.Unlock()
-				}
-				defer wg.Done()
-				splitCmdLine := strings.Split(utils.GetSSHConnectionString(host.IP, host.SSHPrivateKeyPath), " ")
-				splitCmdLine = append(splitCmdLine, cmd)
-				cmd := exec.Command(splitCmdLine[0], splitCmdLine[1:]...) //nolint: gosec
-				cmd.Env = os.Environ()
-				outBuf, errBuf := utils.SetupRealtimeCLIOutput(cmd, false, false)
-				if !isParallel {
-					_, _ = utils.SetupRealtimeCLIOutput(cmd, true, true)
-				}
-				if _, err := outBuf.ReadFrom(errBuf); err != nil {
-					nodeResults.AddResult(host.NodeID, outBuf, err)
-				}
-				if err := cmd.Run(); err != nil {
-					nodeResults.AddResult(host.NodeID, outBuf, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, outBuf, nil)
-				}
-			}(&wgResults, host)
+		opts := getExecutorOptions()
+		if !isParallel {
+			opts.Concurrency = 1
 		}
-		wg.Wait()
+		wgResults := executor.Run(hosts, opts, func(host *models.Host) (interface{}, error) {
+			splitCmdLine := strings.Split(utils.GetSSHConnectionString(host.IP, host.SSHPrivateKeyPath, knownHostsPath), " ")
+			splitCmdLine = append(splitCmdLine, cmd)
+			cmd := exec.Command(splitCmdLine[0], splitCmdLine[1:]...) //nolint: gosec
+			cmd.Env = os.Environ()
+			outBuf, errBuf := utils.SetupRealtimeCLIOutput(cmd, false, false)
+			if !isParallel {
+				_, _ = utils.SetupRealtimeCLIOutput(cmd, true, true)
+			}
+			if _, err := outBuf.ReadFrom(errBuf); err != nil {
+				return outBuf, err
+			}
+			return outBuf, cmd.Run()
+		})
 		if wgResults.HasErrors() {
 			return fmt.Errorf("failed to ssh node(s) %s", wgResults.GetErrorHostMap())
 		}
@@ -146,7 +163,7 @@ func sshHosts(hosts []*models.Host, cmd string) error {
 			return fmt.Errorf("no nodes found")
 		default:
 			selectedHost := hosts[0]
-			splitCmdLine := strings.Split(utils.GetSSHConnectionString(selectedHost.IP, selectedHost.SSHPrivateKeyPath), " ")
+			splitCmdLine := strings.Split(utils.GetSSHConnectionString(selectedHost.IP, selectedHost.SSHPrivateKeyPath, knownHostsPath), " ")
 			cmd := exec.Command(splitCmdLine[0], splitCmdLine[1:]...) //nolint: gosec
 			cmd.Env = os.Environ()
 			cmd.Stdin = os.Stdin
@@ -164,12 +181,16 @@ func sshHosts(hosts []*models.Host, cmd string) error {
 
 func printClusterConnectionString(clusterName string, networkName string) error {
 	ux.Logger.PrintToUser("Cluster %q (%s)", clusterName, networkName)
-	clusterHosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	clusterHosts, err := getClusterHosts(clusterName)
+	if err != nil {
+		return err
+	}
+	knownHostsPath, err := writeKnownHostsFile(clusterName)
 	if err != nil {
 		return err
 	}
 	for _, host := range clusterHosts {
-		ux.Logger.PrintToUser(utils.GetSSHConnectionString(host.IP, host.SSHPrivateKeyPath))
+		ux.Logger.PrintToUser(utils.GetSSHConnectionString(host.IP, host.SSHPrivateKeyPath, knownHostsPath))
 	}
 	ux.Logger.PrintToUser("")
 	return nil
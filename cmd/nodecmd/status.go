@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"sync"
 
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
@@ -73,7 +72,7 @@ func statusNode(_ *cobra.Command, args []string) error {
 		}
 	}
 
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -91,30 +90,18 @@ func statusNode(_ *cobra.Command, args []string) error {
 
 	ux.Logger.PrintToUser("Getting odysseygo version of node(s)")
 
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if resp, err := ssh.RunSSHCheckOdysseyGoVersion(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			} else {
-				if odysseyGoVersion, err := parseOdysseyGoOutput(resp); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, odysseyGoVersion, err)
-				}
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return fmt.Errorf("failed to get odysseygo version for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		resp, err := ssh.RunSSHCheckOdysseyGoVersion(host)
+		if err != nil {
+			return nil, err
+		}
+		return parseOdysseyGoOutput(resp)
+	})
+	if results.HasErrors() {
+		return fmt.Errorf("failed to get odysseygo version for node(s) %s", results.GetErrorHostMap())
 	}
 	odysseygoVersionForNode := map[string]string{}
-	for nodeID, odysseygoVersion := range wgResults.GetResultMap() {
+	for nodeID, odysseygoVersion := range results.GetResultMap() {
 		odysseygoVersionForNode[nodeID] = fmt.Sprintf("%v", odysseygoVersion)
 	}
 
@@ -146,30 +133,17 @@ func statusNode(_ *cobra.Command, args []string) error {
 		if len(hostsToCheckSyncStatus) != 0 {
 			ux.Logger.PrintToUser("Getting subnet sync status of node(s)")
 			hostsToCheck := utils.Filter(hosts, func(h *models.Host) bool { return slices.Contains(hostsToCheckSyncStatus, h.NodeID) })
-			wg := sync.WaitGroup{}
-			wgResults := models.NodeResults{}
-			for _, host := range hostsToCheck {
-				wg.Add(1)
-				go func(nodeResults *models.NodeResults, host *models.Host) {
-					defer wg.Done()
-					if syncstatus, err := ssh.RunSSHSubnetSyncStatus(host, blockchainID.String()); err != nil {
-						nodeResults.AddResult(host.NodeID, nil, err)
-						return
-					} else {
-						if subnetSyncStatus, err := parseSubnetSyncOutput(syncstatus); err != nil {
-							nodeResults.AddResult(host.NodeID, nil, err)
-							return
-						} else {
-							nodeResults.AddResult(host.NodeID, subnetSyncStatus, err)
-						}
-					}
-				}(&wgResults, host)
-			}
-			wg.Wait()
-			if wgResults.HasErrors() {
-				return fmt.Errorf("failed to check sync status for node(s) %s", wgResults.GetErrorHostMap())
+			results := runOnHosts(hostsToCheck, func(host *models.Host) (interface{}, error) {
+				resp, err := ssh.RunSSHSubnetSyncStatus(host, blockchainID.String())
+				if err != nil {
+					return nil, err
+				}
+				return parseSubnetSyncOutput(resp)
+			})
+			if results.HasErrors() {
+				return fmt.Errorf("failed to check sync status for node(s) %s", results.GetErrorHostMap())
 			}
-			for nodeID, subnetSyncStatus := range wgResults.GetResultMap() {
+			for nodeID, subnetSyncStatus := range results.GetResultMap() {
 				switch subnetSyncStatus {
 				case status.Syncing.String():
 					subnetSyncedNodes = append(subnetSyncedNodes, nodeID)
@@ -185,11 +159,11 @@ func statusNode(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	ansibleHosts, err := ansible.GetHostMapfromAnsibleInventory(app.GetAnsibleInventoryDirPath(clusterName))
+	ansibleHosts, err := getClusterHostMap(clusterName)
 	if err != nil {
 		return err
 	}
-	printOutput(
+	return printOutput(
 		clustersConfig,
 		hostIDs,
 		ansibleHostIDs,
@@ -204,7 +178,28 @@ func statusNode(_ *cobra.Command, args []string) error {
 		clusterName,
 		subnetName,
 	)
-	return nil
+}
+
+// clusterStatus is the --output json|yaml schema of node status.
+// Subnet is omitted if --subnet is not set.
+type clusterStatus struct {
+	Cluster string       `json:"cluster" yaml:"cluster"`
+	Subnet  string       `json:"subnet,omitempty" yaml:"subnet,omitempty"`
+	Nodes   []nodeStatus `json:"nodes" yaml:"nodes"`
+}
+
+// nodeStatus describes the status of a cluster node.
+// SubnetStatus is one of SYNCED, VALIDATING or NOT_BOOTSTRAPPED, and is
+// omitted if --subnet is not set.
+type nodeStatus struct {
+	CloudID          string `json:"cloudID" yaml:"cloudID"`
+	NodeID           string `json:"nodeID" yaml:"nodeID"`
+	IP               string `json:"ip" yaml:"ip"`
+	Network          string `json:"network" yaml:"network"`
+	OdysseyGoVersion string `json:"odysseyGoVersion" yaml:"odysseyGoVersion"`
+	Bootstrapped     bool   `json:"bootstrapped" yaml:"bootstrapped"`
+	Healthy          bool   `json:"healthy" yaml:"healthy"`
+	SubnetStatus     string `json:"subnetStatus,omitempty" yaml:"subnetStatus,omitempty"`
 }
 
 func printOutput(
@@ -221,7 +216,46 @@ func printOutput(
 	subnetValidatingHosts []string,
 	clusterName string,
 	subnetName string,
+) error {
+	result := clusterStatus{
+		Cluster: clusterName,
+		Subnet:  subnetName,
+		Nodes:   []nodeStatus{},
+	}
+	for i, ansibleHostID := range ansibleHostIDs {
+		node := nodeStatus{
+			CloudID:          hostIDs[i],
+			NodeID:           nodeIDs[i],
+			IP:               ansibleHosts[ansibleHostID].IP,
+			Network:          clustersConfig.Clusters[clusterName].Network.Name(),
+			OdysseyGoVersion: odygoVersions[ansibleHostID],
+			Bootstrapped:     !slices.Contains(notBootstrappedHosts, ansibleHostID),
+			Healthy:          !slices.Contains(notHealthyHosts, ansibleHostID),
+		}
+		if subnetName != "" {
+			node.SubnetStatus = "NOT_BOOTSTRAPPED"
+			if slices.Contains(subnetSyncedHosts, ansibleHostID) {
+				node.SubnetStatus = "SYNCED"
+			}
+			if slices.Contains(subnetValidatingHosts, ansibleHostID) {
+				node.SubnetStatus = "VALIDATING"
+			}
+		}
+		result.Nodes = append(result.Nodes, node)
+	}
+	return ux.Render(result, func() {
+		printStatusTable(result, notBootstrappedHosts, notSyncedHosts, subnetSyncedHosts)
+	})
+}
+
+func printStatusTable(
+	result clusterStatus,
+	notBootstrappedHosts []string,
+	notSyncedHosts []string,
+	subnetSyncedHosts []string,
 ) {
+	clusterName := result.Cluster
+	subnetName := result.Subnet
 	if subnetName == "" && len(notBootstrappedHosts) == 0 {
 		ux.Logger.PrintToUser("All nodes in cluster %s are bootstrapped to Primary Network!", clusterName)
 	}
@@ -245,31 +279,28 @@ func printOutput(
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader(header)
 	table.SetRowLine(true)
-	for i, ansibleHostID := range ansibleHostIDs {
+	for _, node := range result.Nodes {
 		bootstrappedStatus := logging.Green.Wrap("BOOTSTRAPPED")
-		if slices.Contains(notBootstrappedHosts, ansibleHostID) {
+		if !node.Bootstrapped {
 			bootstrappedStatus = logging.Red.Wrap("NOT_BOOTSTRAPPED")
 		}
 		healthyStatus := logging.Green.Wrap("OK")
-		if slices.Contains(notHealthyHosts, ansibleHostID) {
+		if !node.Healthy {
 			healthyStatus = logging.Red.Wrap("UNHEALTHY")
 		}
 		row := []string{
-			hostIDs[i],
-			nodeIDs[i],
-			ansibleHosts[ansibleHostID].IP,
-			clustersConfig.Clusters[clusterName].Network.Name(),
-			odygoVersions[ansibleHostID],
+			node.CloudID,
+			node.NodeID,
+			node.IP,
+			node.Network,
+			node.OdysseyGoVersion,
 			bootstrappedStatus,
 			healthyStatus,
 		}
 		if subnetName != "" {
-			syncedStatus := logging.Red.Wrap("NOT_BOOTSTRAPPED")
-			if slices.Contains(subnetSyncedHosts, ansibleHostID) {
-				syncedStatus = logging.Green.Wrap("SYNCED")
-			}
-			if slices.Contains(subnetValidatingHosts, ansibleHostID) {
-				syncedStatus = logging.Green.Wrap("VALIDATING")
+			syncedStatus := logging.Red.Wrap(node.SubnetStatus)
+			if node.SubnetStatus != "NOT_BOOTSTRAPPED" {
+				syncedStatus = logging.Green.Wrap(node.SubnetStatus)
 			}
 			row = append(row, syncedStatus)
 		}
@@ -8,15 +8,12 @@ import (
 	"os"
 	"strings"
 
-	gcpAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/gcp"
 	"golang.org/x/exp/maps"
-	"golang.org/x/net/context"
 
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
-
-	awsAPI "github.com/DioneProtocol/odyssey-cli/pkg/cloud/aws"
-
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 
 	"github.com/spf13/cobra"
@@ -98,6 +95,12 @@ func stopNodes(_ *cobra.Command, args []string) error {
 	if err := getDeleteConfigConfirmation(); err != nil {
 		return err
 	}
+	return stopCluster(clusterName, map[string]cloud.Provider{})
+}
+
+// stopCluster stops the nodes of [clusterName] using the cloud [providers], created as
+// needed, and removes the local files of the cluster once all of them are stopped
+func stopCluster(clusterName string, providers map[string]cloud.Provider) error {
 	clusterNodes, err := getClusterNodes(clusterName)
 	if err != nil {
 		return err
@@ -111,9 +114,6 @@ func stopNodes(_ *cobra.Command, args []string) error {
 		nodesToStop = append(nodesToStop, monitoringNode)
 	}
 	nodeErrors := map[string]error{}
-	lastRegion := ""
-	var ec2Svc *awsAPI.AwsCloud
-	var gcpCloud *gcpAPI.GcpCloud
 	for _, node := range nodesToStop {
 		nodeConfig, err := app.LoadClusterNodeConfig(node)
 		if err != nil {
@@ -121,46 +121,24 @@ func stopNodes(_ *cobra.Command, args []string) error {
 			ux.Logger.PrintToUser("Failed to stop node %s due to %s", node, err.Error())
 			continue
 		}
-		if nodeConfig.CloudService == "" || nodeConfig.CloudService == constants.AWSCloudService {
-			if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.AWSCloudService) != nil) {
-				return fmt.Errorf("cloud access is required")
+		if nodeConfig.CloudService == constants.ExistingHostsService {
+			if err := stopExistingHost(clusterName, node); err != nil {
+				nodeErrors[node] = err
+				continue
 			}
-			// need to check if it's empty because we didn't set cloud service when only using AWS
-			if nodeConfig.Region != lastRegion {
-				ec2Svc, err = awsAPI.NewAwsCloud(awsProfile, nodeConfig.Region)
-				if err != nil {
-					return err
-				}
-				lastRegion = nodeConfig.Region
+		} else {
+			provider, err := getAuthorizedCloudProvider(providers, getNodeCloudService(nodeConfig))
+			if err != nil {
+				return err
 			}
-			if err = ec2Svc.StopAWSNode(nodeConfig, clusterName); err != nil {
+			ux.Logger.PrintToUser("Stopping node instance %s in cluster %s...", nodeConfig.NodeID, clusterName)
+			if err = provider.StopInstance(nodeConfig); err != nil {
 				if isExpiredCredentialError(err) {
 					ux.Logger.PrintToUser("")
 					printExpiredCredentialsOutput(awsProfile)
 					return nil
 				}
-				if !errors.Is(err, awsAPI.ErrNodeNotFoundToBeRunning) {
-					nodeErrors[node] = err
-					continue
-				}
-				ux.Logger.PrintToUser("node %s is already stopped", nodeConfig.NodeID)
-			}
-		} else {
-			if !(authorizeAccess || authorizedAccessFromSettings()) && (requestCloudAuth(constants.GCPCloudService) != nil) {
-				return fmt.Errorf("cloud access is required")
-			}
-			if gcpCloud == nil {
-				gcpClient, projectName, _, err := getGCPCloudCredentials()
-				if err != nil {
-					return err
-				}
-				gcpCloud, err = gcpAPI.NewGcpCloud(gcpClient, projectName, context.Background())
-				if err != nil {
-					return err
-				}
-			}
-			if err = gcpCloud.StopGCPNode(nodeConfig, clusterName); err != nil {
-				if !errors.Is(err, gcpAPI.ErrNodeNotFoundToBeRunning) {
+				if !errors.Is(err, cloud.ErrNodeNotFoundToBeRunning) {
 					nodeErrors[node] = err
 					continue
 				}
@@ -189,6 +167,22 @@ func stopNodes(_ *cobra.Command, args []string) error {
 	return removeClustersConfigFiles(clusterName)
 }
 
+// stopExistingHost stops the odysseygo service of the existing host [hostID] of [clusterName],
+// which is kept running as it is not managed by the CLI
+func stopExistingHost(clusterName string, hostID string) error {
+	hosts, err := getClusterHosts(clusterName)
+	if err != nil {
+		return err
+	}
+	for _, host := range hosts {
+		if host.GetCloudID() == hostID {
+			defer disconnectHosts([]*models.Host{host})
+			return ssh.RunSSHStopNode(host)
+		}
+	}
+	return fmt.Errorf("host %s not found in cluster %s", hostID, clusterName)
+}
+
 func getClusterMonitoringNode(clusterName string) (string, error) {
 	clustersConfig := models.ClustersConfig{}
 	if app.ClustersConfigExists() {
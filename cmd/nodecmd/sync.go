@@ -5,15 +5,13 @@ package nodecmd
 import (
 	"fmt"
 	"path/filepath"
-	"sync"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
-
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/spf13/cobra"
@@ -46,7 +44,7 @@ func syncSubnet(_ *cobra.Command, args []string) error {
 	if _, err := subnetcmd.ValidateSubnetNameAndGetChains([]string{subnetName}); err != nil {
 		return err
 	}
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -126,27 +124,16 @@ func trackSubnet(
 	if err := subnetcmd.CallExportSubnet(subnetName, subnetPath); err != nil {
 		return nil, err
 	}
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			subnetExportPath := filepath.Join("/tmp", filepath.Base(subnetPath))
-			if err := ssh.RunSSHExportSubnet(host, subnetPath, subnetExportPath); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if err := ssh.RunSSHTrackSubnet(host, subnetName, subnetExportPath, networkFlag); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		fmt.Println(wgResults.GetErrorHostMap())
-		return nil, fmt.Errorf("failed to track subnet for node(s) %s", wgResults.GetErrorHostMap())
-	}
-	return wgResults.GetErrorHosts(), nil
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		subnetExportPath := filepath.Join("/tmp", filepath.Base(subnetPath))
+		if err := ssh.RunSSHExportSubnet(host, subnetPath, subnetExportPath); err != nil {
+			return nil, err
+		}
+		return nil, ssh.RunSSHTrackSubnet(host, subnetName, subnetExportPath, networkFlag)
+	})
+	executor.PrintSummary(results)
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to track subnet for node(s) %s", results.GetErrorHostMap())
+	}
+	return results.GetErrorHosts(), nil
 }
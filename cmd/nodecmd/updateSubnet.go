@@ -5,14 +5,12 @@ package nodecmd
 import (
 	"fmt"
 	"path/filepath"
-	"sync"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
-
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/spf13/cobra"
@@ -43,7 +41,7 @@ func updateSubnet(_ *cobra.Command, args []string) error {
 	if _, err := subnetcmd.ValidateSubnetNameAndGetChains([]string{subnetName}); err != nil {
 		return err
 	}
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -100,26 +98,16 @@ func doUpdateSubnet(
 	if err := subnetcmd.CallExportSubnet(subnetName, subnetPath); err != nil {
 		return nil, err
 	}
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			subnetExportPath := filepath.Join("/tmp", filepath.Base(subnetPath))
-			if err := ssh.RunSSHExportSubnet(host, subnetPath, subnetExportPath); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-			if err := ssh.RunSSHUpdateSubnet(host, subnetName, subnetExportPath); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return nil, fmt.Errorf("failed to update subnet for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHostsOnce(hosts, func(host *models.Host) (interface{}, error) {
+		subnetExportPath := filepath.Join("/tmp", filepath.Base(subnetPath))
+		if err := ssh.RunSSHExportSubnet(host, subnetPath, subnetExportPath); err != nil {
+			return nil, err
+		}
+		return nil, ssh.RunSSHUpdateSubnet(host, subnetName, subnetExportPath)
+	})
+	executor.PrintSummary(results)
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to update subnet for node(s) %s", results.GetErrorHostMap())
 	}
-	return wgResults.GetErrorHosts(), nil
+	return results.GetErrorHosts(), nil
 }
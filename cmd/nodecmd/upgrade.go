@@ -4,27 +4,53 @@ package nodecmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"sync"
+	"time"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
+const (
+	upgradeCheckPoolTime = 10 * time.Second
+	upgradeCheckTimeout  = 5 * time.Minute
+)
+
+// binaryVersionRegex matches the version printed by odysseygo and Subnet EVM binaries
+// with --version, as in "odysseygo/1.10.10 [database=v1.4.5, ...]"
+var binaryVersionRegex = regexp.MustCompile(`v?\d+\.\d+\.\d+`)
+
+var (
+	upgradeBatchSize        int
+	upgradeOdysseyGoVersion string
+	upgradeSubnetEVMVersion string
+	upgradeDryRun           bool
+)
+
 type nodeUpgradeInfo struct {
-	OdysseyGoVersion      string   // odyssey go version to update to on cloud server
-	SubnetEVMVersion      string   // subnet EVM version to update to on cloud server
-	SubnetEVMIDsToUpgrade []string // list of ID of Subnet EVM to be upgraded to subnet EVM version to update to
+	OdysseyGoVersion        string            // odyssey go version to update to on cloud server
+	SubnetEVMVersion        string            // subnet EVM version to update to on cloud server
+	SubnetEVMIDsToUpgrade   []string          // list of ID of Subnet EVM to be upgraded to subnet EVM version to update to
+	CurrentOdysseyGoVersion string            // odyssey go version currently running on cloud server
+	CurrentVMVersions       map[string]string // maps ID of the custom VMs currently running on cloud server to their version
 }
 
 func newUpgradeCmd() *cobra.Command {
@@ -36,106 +62,405 @@ func newUpgradeCmd() *cobra.Command {
 The node update command suite provides a collection of commands for nodes to update
 their odysseygo or VM version.
 
+Nodes are upgraded in rolling batches of --batch-size nodes. After each batch, the command
+waits for the upgraded nodes to be healthy, bootstrapped and synced to the Subnets they run,
+before continuing with the next batch. If a batch fails to get back, the upgrade is aborted
+and the remaining nodes are left untouched. With --continue-on-error, the nodes that fail
+to be upgraded are reported at the end, and the upgrade continues with the other nodes.
+
+By default, nodes are upgraded to the latest Subnet EVM release and to the latest odysseygo
+release compatible with it. Use --odysseygo-version and --subnet-evm-version to pin them,
+and --dry-run to show the upgrade plan without applying it.
+
 You can check the status after upgrade by calling odyssey node status`,
 		SilenceUsage: true,
 		Args:         cobra.ExactArgs(1),
 		RunE:         upgrade,
 	}
+	cmd.Flags().IntVar(&upgradeBatchSize, "batch-size", 1, "number of nodes to upgrade at a time")
+	cmd.Flags().StringVar(&upgradeOdysseyGoVersion, "odysseygo-version", "", "upgrade to this odysseygo version (ex: v1.10.11)")
+	cmd.Flags().StringVar(&upgradeSubnetEVMVersion, "subnet-evm-version", "", "upgrade to this Subnet EVM version (ex: v0.5.7)")
+	cmd.Flags().BoolVar(&upgradeDryRun, "dry-run", false, "show the upgrade plan without applying it")
 
 	return cmd
 }
 
 func upgrade(_ *cobra.Command, args []string) error {
 	clusterName := args[0]
+	if err := validateBatchSize(upgradeBatchSize); err != nil {
+		return err
+	}
 	if err := checkCluster(clusterName); err != nil {
 		return err
 	}
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	clustersConfig, err := app.LoadClustersConfig()
+	if err != nil {
+		return err
+	}
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
 	defer disconnectHosts(hosts)
-	toUpgradeNodesMap, err := getNodesUpgradeInfo(hosts)
+	toUpgradeNodesMap, err := getNodesUpgradeInfo(hosts, upgradeOdysseyGoVersion, upgradeSubnetEVMVersion)
 	if err != nil {
 		return err
 	}
-	for host, upgradeInfo := range toUpgradeNodesMap {
-		if upgradeInfo.OdysseyGoVersion != "" {
-			if err := upgradeOdysseyGo(host, upgradeInfo.OdysseyGoVersion); err != nil {
-				return err
+	batches := getUpgradeBatches(toUpgradeNodesMap, upgradeBatchSize)
+	if len(batches) == 0 {
+		ux.Logger.PrintToUser("All nodes of cluster %s are already up to date", clusterName)
+		return nil
+	}
+	printUpgradePlan(batches, toUpgradeNodesMap)
+	if upgradeDryRun {
+		return nil
+	}
+	blockchainIDs, err := getBlockchainIDsByVMID(clustersConfig.Clusters[clusterName].Network)
+	if err != nil {
+		return err
+	}
+	upgradedNodes := []string{}
+	failedNodes := map[string]error{}
+	for batchIndex, batch := range batches {
+		ux.Logger.PrintToUser("Upgrading batch %d of %d ...", batchIndex+1, len(batches))
+		results := runOnHostsOnce(batch, func(host *models.Host) (interface{}, error) {
+			return nil, upgradeHost(host, toUpgradeNodesMap[host])
+		})
+		executor.PrintSummary(results)
+		if results.HasErrors() && !continueOnError {
+			return abortUpgrade(upgradedNodes, batches[batchIndex:], fmt.Errorf("failed to upgrade node(s) %s", results.GetErrorHostMap()))
+		}
+		maps.Copy(failedNodes, results.GetErrorHostMap())
+		upgradedBatch := utils.Filter(batch, func(host *models.Host) bool {
+			return !results.HasNodeIDWithError(host.NodeID)
+		})
+		if len(upgradedBatch) == 0 {
+			continue
+		}
+		hostsBlockchainIDs := map[string][]ids.ID{}
+		for _, host := range upgradedBatch {
+			for vmID := range toUpgradeNodesMap[host].CurrentVMVersions {
+				if blockchainID, ok := blockchainIDs[vmID]; ok {
+					hostsBlockchainIDs[host.NodeID] = append(hostsBlockchainIDs[host.NodeID], blockchainID)
+				}
 			}
 		}
-		if upgradeInfo.SubnetEVMVersion != "" {
-			subnetEVMVersionToUpgradeToWoPrefix := strings.TrimPrefix(upgradeInfo.SubnetEVMVersion, "v")
-			subnetEVMArchive := fmt.Sprintf(constants.SubnetEVMArchive, subnetEVMVersionToUpgradeToWoPrefix)
-			subnetEVMReleaseURL := fmt.Sprintf(constants.SubnetEVMReleaseURL, upgradeInfo.SubnetEVMVersion, subnetEVMArchive)
-			if err := getNewSubnetEVMRelease(host, subnetEVMReleaseURL, subnetEVMArchive, upgradeInfo.SubnetEVMVersion); err != nil {
+		if err := waitForUpgradedHosts(upgradedBatch, hostsBlockchainIDs, upgradeCheckTimeout, upgradeCheckPoolTime); err != nil {
+			return abortUpgrade(upgradedNodes, batches[batchIndex:], err)
+		}
+		for _, host := range upgradedBatch {
+			upgradedNodes = append(upgradedNodes, host.NodeID)
+		}
+	}
+	if len(failedNodes) > 0 {
+		return fmt.Errorf("failed to upgrade node(s) %s", failedNodes)
+	}
+	ux.Logger.PrintToUser("All nodes of cluster %s successfully upgraded!", clusterName)
+	return nil
+}
+
+// upgradeHost applies [upgradeInfo] to [host]. The replaced binaries are kept on the host,
+// and their versions are added to the version history of the node config
+func upgradeHost(host *models.Host, upgradeInfo nodeUpgradeInfo) (err error) {
+	replacedVersions := models.NodeVersions{
+		VMVersions: map[string]string{},
+		UpgradedAt: time.Now().UTC(),
+	}
+	defer func() {
+		if replacedVersions.OdysseyGoVersion == "" && len(replacedVersions.VMVersions) == 0 {
+			return
+		}
+		if historyErr := addVersionHistory(host, replacedVersions); historyErr != nil && err == nil {
+			err = historyErr
+		}
+	}()
+	if upgradeInfo.OdysseyGoVersion != "" {
+		if err := upgradeOdysseyGo(host, upgradeInfo.OdysseyGoVersion, upgradeInfo.CurrentOdysseyGoVersion); err != nil {
+			return err
+		}
+		replacedVersions.OdysseyGoVersion = upgradeInfo.CurrentOdysseyGoVersion
+	}
+	if upgradeInfo.SubnetEVMVersion != "" {
+		subnetEVMVersionToUpgradeToWoPrefix := strings.TrimPrefix(upgradeInfo.SubnetEVMVersion, "v")
+		subnetEVMArchive := fmt.Sprintf(constants.SubnetEVMArchive, subnetEVMVersionToUpgradeToWoPrefix)
+		subnetEVMReleaseURL := fmt.Sprintf(constants.SubnetEVMReleaseURL, upgradeInfo.SubnetEVMVersion, subnetEVMArchive)
+		if err := getNewSubnetEVMRelease(host, subnetEVMReleaseURL, subnetEVMArchive, upgradeInfo.SubnetEVMVersion); err != nil {
+			return err
+		}
+		if err := ssh.RunSSHStopNode(host); err != nil {
+			return err
+		}
+		for _, vmID := range upgradeInfo.SubnetEVMIDsToUpgrade {
+			subnetEVMBinaryPath := fmt.Sprintf(constants.CloudNodeSubnetEvmBinaryPath, vmID)
+			currentVersion := upgradeInfo.CurrentVMVersions[vmID]
+			if err := backupBinary(host, subnetEVMBinaryPath, getBackupBinaryPath(vmID, currentVersion), currentVersion); err != nil {
 				return err
 			}
-			if err := ssh.RunSSHStopNode(host); err != nil {
+			if err := upgradeSubnetEVM(host, subnetEVMBinaryPath, upgradeInfo.SubnetEVMVersion); err != nil {
 				return err
 			}
-			for _, vmID := range upgradeInfo.SubnetEVMIDsToUpgrade {
-				subnetEVMBinaryPath := fmt.Sprintf(constants.CloudNodeSubnetEvmBinaryPath, vmID)
-				if err := upgradeSubnetEVM(host, subnetEVMBinaryPath, upgradeInfo.SubnetEVMVersion); err != nil {
-					return err
-				}
+			replacedVersions.VMVersions[vmID] = currentVersion
+		}
+		if err := ssh.RunSSHStartNode(host); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateBatchSize(batchSize int) error {
+	if batchSize < 1 {
+		return fmt.Errorf("invalid batch size %d: it must be at least 1", batchSize)
+	}
+	return nil
+}
+
+// getUpgradeBatches splits the nodes of [nodesToUpgrade] that have something to upgrade
+// into batches of [batchSize] nodes, ordered by node ID
+func getUpgradeBatches(nodesToUpgrade map[*models.Host]nodeUpgradeInfo, batchSize int) [][]*models.Host {
+	hosts := []*models.Host{}
+	for host, upgradeInfo := range nodesToUpgrade {
+		if upgradeInfo.OdysseyGoVersion != "" || upgradeInfo.SubnetEVMVersion != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return getHostBatches(hosts, batchSize)
+}
+
+// getHostBatches splits [hosts] into batches of [batchSize] hosts, ordered by node ID
+func getHostBatches(hosts []*models.Host, batchSize int) [][]*models.Host {
+	hosts = append([]*models.Host{}, hosts...)
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].NodeID < hosts[j].NodeID
+	})
+	batches := [][]*models.Host{}
+	for start := 0; start < len(hosts); start += batchSize {
+		end := start + batchSize
+		if end > len(hosts) {
+			end = len(hosts)
+		}
+		batches = append(batches, hosts[start:end])
+	}
+	return batches
+}
+
+func printUpgradePlan(batches [][]*models.Host, nodesToUpgrade map[*models.Host]nodeUpgradeInfo) {
+	ux.Logger.PrintToUser("Upgrade plan:")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Batch", "Node", "OdysseyGo", "Subnet EVM"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for batchIndex, batch := range batches {
+		for _, host := range batch {
+			upgradeInfo := nodesToUpgrade[host]
+			odysseyGoUpgrade := "-"
+			if upgradeInfo.OdysseyGoVersion != "" {
+				odysseyGoUpgrade = fmt.Sprintf("%s -> %s", upgradeInfo.CurrentOdysseyGoVersion, upgradeInfo.OdysseyGoVersion)
 			}
-			if err := ssh.RunSSHStartNode(host); err != nil {
-				return err
+			subnetEVMUpgrade := "-"
+			if upgradeInfo.SubnetEVMVersion != "" {
+				subnetEVMUpgrade = fmt.Sprintf("%s (%s)", upgradeInfo.SubnetEVMVersion, strings.Join(upgradeInfo.SubnetEVMIDsToUpgrade, ", "))
 			}
+			table.Append([]string{strconv.Itoa(batchIndex + 1), host.NodeID, odysseyGoUpgrade, subnetEVMUpgrade})
 		}
 	}
-	return nil
+	table.Render()
+}
+
+// abortUpgrade reports which nodes were upgraded and which were not before returning [err]
+func abortUpgrade(upgradedNodes []string, remainingBatches [][]*models.Host, err error) error {
+	return abortBatches("upgrade", "Upgraded nodes", "Not upgraded nodes", upgradedNodes, remainingBatches, err)
+}
+
+// abortBatches reports, for an [operation] applied in batches, the [doneNodes] of the
+// completed batches, the nodes of the failed batch, that is the first of [remainingBatches],
+// and the nodes left untouched, before returning [err]
+func abortBatches(
+	operation string,
+	doneNodesLabel string,
+	untouchedNodesLabel string,
+	doneNodes []string,
+	remainingBatches [][]*models.Host,
+	err error,
+) error {
+	ux.Logger.PrintToUser("Aborting %s", operation)
+	if len(doneNodes) > 0 {
+		ux.Logger.PrintToUser("%s: %s", doneNodesLabel, strings.Join(doneNodes, ", "))
+	}
+	ux.Logger.PrintToUser("Nodes of the failed batch:")
+	for _, host := range remainingBatches[0] {
+		ux.Logger.PrintToUser("  %s", host.NodeID)
+	}
+	untouchedNodes := []string{}
+	for _, batch := range remainingBatches[1:] {
+		for _, host := range batch {
+			untouchedNodes = append(untouchedNodes, host.NodeID)
+		}
+	}
+	if len(untouchedNodes) > 0 {
+		ux.Logger.PrintToUser("%s: %s", untouchedNodesLabel, strings.Join(untouchedNodes, ", "))
+	}
+	return err
+}
+
+// waitForUpgradedHosts polls [hosts] until all of them are healthy, bootstrapped to the
+// Primary Network, and syncing or validating the blockchains given by [blockchainIDs]
+func waitForUpgradedHosts(
+	hosts []*models.Host,
+	blockchainIDs map[string][]ids.ID,
+	timeout time.Duration,
+	poolTime time.Duration,
+) error {
+	startTime := time.Now()
+	for {
+		notReadyNodes := getNotReadyHosts(hosts, blockchainIDs)
+		if len(notReadyNodes) == 0 {
+			ux.Logger.PrintToUser("Nodes ready after %d seconds", uint32(time.Since(startTime).Seconds()))
+			return nil
+		}
+		if time.Since(startTime) > timeout {
+			ux.Logger.PrintToUser("Nodes not ready")
+			for _, failedNode := range notReadyNodes {
+				ux.Logger.PrintToUser("  " + failedNode)
+			}
+			return fmt.Errorf("node(s) not ready after %d seconds", uint32(timeout.Seconds()))
+		}
+		time.Sleep(poolTime)
+	}
+}
+
+// getNotReadyHosts returns the IDs of the [hosts] that are not healthy, bootstrapped, or
+// running the blockchains given by [blockchainIDs]. Nodes still starting up
+// are reported as not ready instead of failing.
+func getNotReadyHosts(hosts []*models.Host, blockchainIDs map[string][]ids.ID) []string {
+	// all nodes are checked on every poll, which already retries them
+	opts := getExecutorOptions()
+	opts.Retries = 0
+	opts.ContinueOnError = true
+	results := executor.Run(hosts, opts, func(host *models.Host) (interface{}, error) {
+		return isHostReady(host, blockchainIDs[host.NodeID])
+	})
+	notReadyNodes := []string{}
+	for _, result := range results.GetResults() {
+		if result.Err != nil {
+			app.Log.Debug(fmt.Sprintf("node %s not ready: %s", result.NodeID, result.Err))
+		}
+		if ready, _ := result.Value.(bool); !ready {
+			notReadyNodes = append(notReadyNodes, result.NodeID)
+		}
+	}
+	sort.Strings(notReadyNodes)
+	return notReadyNodes
+}
+
+func isHostReady(host *models.Host, blockchainIDs []ids.ID) (bool, error) {
+	resp, err := ssh.RunSSHCheckHealthy(host)
+	if err != nil {
+		return false, err
+	}
+	if isHealthy, err := parseHealthyOutput(resp); err != nil || !isHealthy {
+		return false, err
+	}
+	resp, err = ssh.RunSSHCheckBootstrapped(host)
+	if err != nil {
+		return false, err
+	}
+	if isBootstrapped, err := parseBootstrappedOutput(resp); err != nil || !isBootstrapped {
+		return false, err
+	}
+	for _, blockchainID := range blockchainIDs {
+		resp, err := ssh.RunSSHSubnetSyncStatus(host, blockchainID.String())
+		if err != nil {
+			return false, err
+		}
+		subnetSyncStatus, err := parseSubnetSyncOutput(resp)
+		if err != nil {
+			return false, err
+		}
+		if subnetSyncStatus != status.Syncing.String() && subnetSyncStatus != status.Validating.String() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getBlockchainIDsByVMID maps the VM IDs of the subnets deployed to [network] to their blockchain IDs
+func getBlockchainIDsByVMID(network models.Network) (map[string]ids.ID, error) {
+	subnetDirs, err := os.ReadDir(app.GetSubnetDir())
+	if err != nil {
+		return nil, err
+	}
+	blockchainIDs := map[string]ids.ID{}
+	for _, subnetDir := range subnetDirs {
+		if !subnetDir.IsDir() || !app.SidecarExists(subnetDir.Name()) {
+			continue
+		}
+		sc, err := app.LoadSidecar(subnetDir.Name())
+		if err != nil {
+			return nil, err
+		}
+		blockchainID := sc.Networks[network.Name()].BlockchainID
+		if blockchainID == ids.Empty {
+			continue
+		}
+		vmID, err := sc.GetVMID()
+		if err != nil {
+			return nil, err
+		}
+		blockchainIDs[vmID] = blockchainID
+	}
+	return blockchainIDs, nil
 }
 
 // getNodesUpgradeInfo gets the node versions of all given nodes and checks which
 // nodes needs to have Odyssey Go & SubnetEVM upgraded. It first checks the subnet EVM version -
 // it will install the newest subnet EVM version and install the latest odyssey Go that is still compatible with the Subnet EVM version
 // if the node is not tracking any subnet, it will just install latestOdygoVersion
-func getNodesUpgradeInfo(hosts []*models.Host) (map[*models.Host]nodeUpgradeInfo, error) {
-	latestOdygoVersion, err := app.Downloader.GetLatestReleaseVersion(binutils.GetGithubLatestReleaseURL(
-		constants.DioneProtocolOrg,
-		constants.OdysseyGoRepoName,
-	))
-	if err != nil {
-		return nil, err
+//
+// if [odygoVersion] or [subnetEVMVersion] are given, they are used instead of the latest ones
+func getNodesUpgradeInfo(hosts []*models.Host, odygoVersion string, subnetEVMVersion string) (map[*models.Host]nodeUpgradeInfo, error) {
+	var err error
+	latestOdygoVersion := odygoVersion
+	if latestOdygoVersion == "" {
+		latestOdygoVersion, err = app.Downloader.GetLatestReleaseVersion(binutils.GetGithubLatestReleaseURL(
+			constants.DioneProtocolOrg,
+			constants.OdysseyGoRepoName,
+		))
+		if err != nil {
+			return nil, err
+		}
 	}
-	latestSubnetEVMVersion, err := app.Downloader.GetLatestReleaseVersion(binutils.GetGithubLatestReleaseURL(
-		constants.DioneProtocolOrg,
-		constants.SubnetEVMRepoName,
-	))
-	if err != nil {
-		return nil, err
+	latestSubnetEVMVersion := subnetEVMVersion
+	if latestSubnetEVMVersion == "" {
+		latestSubnetEVMVersion, err = app.Downloader.GetLatestReleaseVersion(binutils.GetGithubLatestReleaseURL(
+			constants.DioneProtocolOrg,
+			constants.SubnetEVMRepoName,
+		))
+		if err != nil {
+			return nil, err
+		}
 	}
 	rpcVersion, err := vm.GetRPCProtocolVersion(app, models.SubnetEvm, latestSubnetEVMVersion)
 	if err != nil {
 		return nil, err
 	}
+	// a pinned odysseygo version must run the Subnet EVM version of the nodes that have one
+	var pinnedOdygoVersionErr error
+	if odygoVersion != "" {
+		pinnedOdygoVersionErr = checkOdygoVersionForRPC(odygoVersion, rpcVersion, latestSubnetEVMVersion)
+	}
 	nodeErrors := map[string]error{}
 	nodesToUpgrade := make(map[*models.Host]nodeUpgradeInfo)
 
-	wg := sync.WaitGroup{}
-	wgResults := models.NodeResults{}
-	for _, host := range hosts {
-		wg.Add(1)
-		go func(nodeResults *models.NodeResults, host *models.Host) {
-			defer wg.Done()
-			if resp, err := ssh.RunSSHCheckOdysseyGoVersion(host); err != nil {
-				nodeResults.AddResult(host.NodeID, nil, err)
-				return
-			} else {
-				if vmVersions, err := parseNodeVersionOutput(resp); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-				} else {
-					nodeResults.AddResult(host.NodeID, vmVersions, err)
-				}
-			}
-		}(&wgResults, host)
-	}
-	wg.Wait()
-	if wgResults.HasErrors() {
-		return nil, fmt.Errorf("failed to get odysseygo version for node(s) %s", wgResults.GetErrorHostMap())
+	results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+		resp, err := ssh.RunSSHCheckOdysseyGoVersion(host)
+		if err != nil {
+			return nil, err
+		}
+		return parseNodeVersionOutput(resp)
+	})
+	if results.HasErrors() {
+		return nil, fmt.Errorf("failed to get odysseygo version for node(s) %s", results.GetErrorHostMap())
 	}
 
 	nodeIDToHost := map[string]*models.Host{}
@@ -143,7 +468,7 @@ func getNodesUpgradeInfo(hosts []*models.Host) (map[*models.Host]nodeUpgradeInfo
 		nodeIDToHost[host.NodeID] = host
 	}
 
-	for hostID, vmVersionsInterface := range wgResults.GetResultMap() {
+	for hostID, vmVersionsInterface := range results.GetResultMap() {
 		vmVersions, err := utils.ConvertInterfaceToMap(vmVersionsInterface)
 		if err != nil {
 			return nil, err
@@ -151,18 +476,26 @@ func getNodesUpgradeInfo(hosts []*models.Host) (map[*models.Host]nodeUpgradeInfo
 		currentOdysseyGoVersion := vmVersions[constants.PlatformKeyName]
 		odysseyGoVersionToUpdateTo := latestOdygoVersion
 		nodeUpgradeInfo := nodeUpgradeInfo{}
+		nodeUpgradeInfo.CurrentOdysseyGoVersion = fmt.Sprintf("%v", currentOdysseyGoVersion)
 		nodeUpgradeInfo.SubnetEVMIDsToUpgrade = []string{}
+		nodeUpgradeInfo.CurrentVMVersions = map[string]string{}
 		for vmName, vmVersion := range vmVersions {
 			// when calling info.getNodeVersion, this is what we get
 			// "vmVersions":{"alpha":"v1.10.12","evm":"v0.12.5","n8Anw9kErmgk7KHviddYtecCmziLZTphDwfL1V2DfnFjWZXbE":"v0.5.6","omega":"v1.10.12"}},
 			// we need to get the VM ID of the subnets that the node is currently validating, in the example above it is n8Anw9kErmgk7KHviddYtecCmziLZTphDwfL1V2DfnFjWZXbE
 			if !checkIfKeyIsStandardVMName(vmName) {
+				nodeUpgradeInfo.CurrentVMVersions[vmName] = fmt.Sprintf("%v", vmVersion)
 				if vmVersion != latestSubnetEVMVersion {
 					// update subnet EVM version
-					ux.Logger.PrintToUser("Upgrading Subnet EVM version for node %s from version %s to version %s", hostID, vmVersion, latestSubnetEVMVersion)
 					nodeUpgradeInfo.SubnetEVMVersion = latestSubnetEVMVersion
 					nodeUpgradeInfo.SubnetEVMIDsToUpgrade = append(nodeUpgradeInfo.SubnetEVMIDsToUpgrade, vmName)
 				}
+				if odygoVersion != "" {
+					if pinnedOdygoVersionErr != nil {
+						nodeErrors[hostID] = pinnedOdygoVersionErr
+					}
+					continue
+				}
 				// find the highest version of odyssey go that is still compatible with current highest rpc
 				odysseyGoVersionToUpdateTo, err = GetLatestOdygoVersionForRPC(rpcVersion)
 				if err != nil {
@@ -175,7 +508,6 @@ func getNodesUpgradeInfo(hosts []*models.Host) (map[*models.Host]nodeUpgradeInfo
 			continue
 		}
 		if currentOdysseyGoVersion != odysseyGoVersionToUpdateTo {
-			ux.Logger.PrintToUser("Upgrading Odyssey Go version for node %s from version %s to version %s", hostID, currentOdysseyGoVersion, odysseyGoVersionToUpdateTo)
 			nodeUpgradeInfo.OdysseyGoVersion = odysseyGoVersionToUpdateTo
 		}
 		nodesToUpgrade[nodeIDToHost[hostID]] = nodeUpgradeInfo
@@ -190,17 +522,98 @@ func getNodesUpgradeInfo(hosts []*models.Host) (map[*models.Host]nodeUpgradeInfo
 	return nodesToUpgrade, nil
 }
 
+// checkOdygoVersionForRPC checks that [odygoVersion] supports the [rpcVersion] protocol of
+// Subnet EVM [subnetEVMVersion]
+func checkOdygoVersionForRPC(odygoVersion string, rpcVersion int, subnetEVMVersion string) error {
+	compatibleVersions, err := vm.GetOdysseyGoVersionsForRPC(app, rpcVersion, constants.OdysseyGoCompatibilityURL)
+	if err != nil && !errors.Is(err, vm.ErrNoOdygoVersion) {
+		return err
+	}
+	if !slices.Contains(compatibleVersions, odygoVersion) {
+		return fmt.Errorf(
+			"odysseygo %s is not compatible with Subnet EVM %s (RPC protocol version %d), compatible odysseygo versions: %v",
+			odygoVersion,
+			subnetEVMVersion,
+			rpcVersion,
+			compatibleVersions,
+		)
+	}
+	return nil
+}
+
 // checks if vmName is "alpha", "evm" or "omega"
 func checkIfKeyIsStandardVMName(vmName string) bool {
 	standardVMNames := []string{constants.PlatformKeyName, constants.EVMKeyName, constants.AVMKeyName}
 	return slices.Contains(standardVMNames, vmName)
 }
 
+// addVersionHistory records the [replacedVersions] by an upgrade of [host] in its node config
+func addVersionHistory(host *models.Host, replacedVersions models.NodeVersions) error {
+	nodeConfig, err := app.LoadClusterNodeConfig(host.GetCloudID())
+	if err != nil {
+		return err
+	}
+	nodeConfig.VersionHistory = append(nodeConfig.VersionHistory, replacedVersions)
+	return app.CreateNodeCloudConfigFile(host.GetCloudID(), &nodeConfig)
+}
+
+// getBackupBinaryPath returns where the binary of [name] at [version] is kept on the cloud server
+func getBackupBinaryPath(name string, version string) string {
+	return fmt.Sprintf("%s%s-%s", constants.CloudNodeBinaryBackupPath, name, version)
+}
+
+// getHostBinaryVersion returns the version printed by the binary at [binaryPath] of [host],
+// or an empty string if there is no binary there
+func getHostBinaryVersion(host *models.Host, binaryPath string) (string, error) {
+	output, err := ssh.RunSSHGetBinaryVersion(host, binaryPath)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(output)) == "" {
+		return "", nil
+	}
+	version := binaryVersionRegex.FindString(string(output))
+	if version == "" {
+		return "", fmt.Errorf("failed to get the version of %s from its output %q", binaryPath, output)
+	}
+	return "v" + strings.TrimPrefix(version, "v"), nil
+}
+
+// backupBinary keeps the binary at [binaryPath] of [host] at [backupBinaryPath], as the binary
+// at [version]. The version of the binary is checked before, and an existing backup is never
+// overwritten: it is kept if it is at [version] too, and is an error otherwise
+func backupBinary(host *models.Host, binaryPath string, backupBinaryPath string, version string) error {
+	binaryVersion, err := getHostBinaryVersion(host, binaryPath)
+	if err != nil {
+		return err
+	}
+	if binaryVersion != version {
+		return fmt.Errorf("binary %s of node %s is at version %q instead of %s", binaryPath, host.NodeID, binaryVersion, version)
+	}
+	backupVersion, err := getHostBinaryVersion(host, backupBinaryPath)
+	if err != nil {
+		return err
+	}
+	switch backupVersion {
+	case "":
+		return ssh.RunSSHBackupBinary(host, binaryPath, backupBinaryPath)
+	case version:
+		return nil
+	default:
+		return fmt.Errorf("backup %s of node %s is at version %s instead of %s, refusing to overwrite it", backupBinaryPath, host.NodeID, backupVersion, version)
+	}
+}
+
 func upgradeOdysseyGo(
 	host *models.Host,
 	odyGoVersionToUpdateTo string,
+	currentOdyGoVersion string,
 ) error {
 	ux.Logger.PrintToUser("Upgrading Odyssey Go version of node %s to version %s ...", host.NodeID, odyGoVersionToUpdateTo)
+	backupBinaryPath := getBackupBinaryPath(constants.OdysseyGoRepoName, currentOdyGoVersion)
+	if err := backupBinary(host, constants.CloudNodeOdysseyGoBinaryPath, backupBinaryPath, currentOdyGoVersion); err != nil {
+		return err
+	}
 	if err := ssh.RunSSHUpgradeOdysseygo(host, odyGoVersionToUpdateTo); err != nil {
 		return err
 	}
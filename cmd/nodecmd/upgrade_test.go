@@ -0,0 +1,29 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package nodecmd
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUpgradeBatches(t *testing.T) {
+	require := require.New(t)
+	hostA := &models.Host{NodeID: "aws_node_a"}
+	hostB := &models.Host{NodeID: "aws_node_b"}
+	hostC := &models.Host{NodeID: "aws_node_c"}
+	upToDate := &models.Host{NodeID: "aws_node_d"}
+	nodesToUpgrade := map[*models.Host]nodeUpgradeInfo{
+		hostC:    {OdysseyGoVersion: "v1.10.11"},
+		hostA:    {SubnetEVMVersion: "v0.5.7", SubnetEVMIDsToUpgrade: []string{"vmID"}},
+		upToDate: {},
+		hostB:    {OdysseyGoVersion: "v1.10.11"},
+	}
+
+	require.Equal([][]*models.Host{{hostA, hostB}, {hostC}}, getUpgradeBatches(nodesToUpgrade, 2))
+	require.Equal([][]*models.Host{{hostA}, {hostB}, {hostC}}, getUpgradeBatches(nodesToUpgrade, 1))
+	require.Equal([][]*models.Host{{hostA, hostB, hostC}}, getUpgradeBatches(nodesToUpgrade, 5))
+	require.Empty(getUpgradeBatches(map[*models.Host]nodeUpgradeInfo{upToDate: {}}, 1))
+}
@@ -15,13 +15,13 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/utils/units"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
 
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 
 	subnetcmd "github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
@@ -312,7 +312,7 @@ func validatePrimaryNetwork(_ *cobra.Command, args []string) error {
 	}
 	network := clustersConfig.Clusters[clusterName].Network
 
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -349,42 +349,39 @@ func validatePrimaryNetwork(_ *cobra.Command, args []string) error {
 	}
 	ux.Logger.PrintToUser("Note that we have staggered the end time of validation period to increase by 24 hours for each node added if multiple nodes are added as Primary Network validators simultaneously")
 	nodeIDMap, failedNodesMap := getNodeIDs(hosts)
-	nodeErrors := map[string]error{}
+	hostIndices := map[string]int{}
 	for i, host := range hosts {
+		hostIndices[host.NodeID] = i
+	}
+	results := runOnHostsSequentially(hosts, func(host *models.Host) (interface{}, error) {
 		nodeIDStr, b := nodeIDMap[host.NodeID]
 		if !b {
 			err, b := failedNodesMap[host.NodeID]
 			if !b {
-				return fmt.Errorf("expected to found an error for non mapped node")
+				err = fmt.Errorf("expected to found an error for non mapped node")
 			}
 			ux.Logger.PrintToUser("Failed to add node %s as Primary Network validator due to %s", host.NodeID, err)
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		nodeID, err := ids.NodeIDFromString(nodeIDStr)
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as Primary Network validator due to %s", host.NodeID, err)
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		_, clusterNodeID, err := models.HostAnsibleIDToCloudID(host.NodeID)
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as Primary Network due to %s", host.NodeID, err.Error())
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
-		_, err = addNodeAsPrimaryNetworkValidator(network, kc, nodeID, i, clusterNodeID)
+		_, err = addNodeAsPrimaryNetworkValidator(network, kc, nodeID, hostIndices[host.NodeID], clusterNodeID)
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as Primary Network validator due to %s", host.NodeID, err)
-			nodeErrors[host.NodeID] = err
-		}
-	}
-	if len(nodeErrors) > 0 {
-		ux.Logger.PrintToUser("Failed nodes: ")
-		for node, nodeErr := range nodeErrors {
-			ux.Logger.PrintToUser("node %s failed due to %v", node, nodeErr)
 		}
-		return fmt.Errorf("node(s) %s failed to validate the Primary Network", maps.Keys(nodeErrors))
+		return nil, err
+	})
+	executor.PrintSummary(results)
+	if results.HasErrors() {
+		return fmt.Errorf("node(s) %s failed to validate the Primary Network", maps.Keys(results.GetErrorHostMap()))
 	} else {
 		ux.Logger.PrintToUser(fmt.Sprintf("All nodes in cluster %s are successfully added as Primary Network validators!", clusterName))
 	}
@@ -10,11 +10,11 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 
 	subnetcmd "github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/executor"
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
@@ -156,7 +156,7 @@ func validateSubnet(_ *cobra.Command, args []string) error {
 	}
 	network := clustersConfig.Clusters[clusterName].Network
 
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -223,68 +223,62 @@ func validateSubnet(_ *cobra.Command, args []string) error {
 	if blockchainID == ids.Empty {
 		return ErrNoBlockchainID
 	}
-	nodeErrors := map[string]error{}
 	ux.Logger.PrintToUser("Note that we have staggered the end time of validation period to increase by 24 hours for each node added if multiple nodes are added as Primary Network validators simultaneously")
+	hostIndices := map[string]int{}
 	for i, host := range hosts {
+		hostIndices[host.NodeID] = i
+	}
+	results := runOnHostsSequentially(hosts, func(host *models.Host) (interface{}, error) {
 		nodeIDStr, b := nodeIDMap[host.NodeID]
 		if !b {
 			err, b := failedNodesMap[host.NodeID]
 			if !b {
-				return fmt.Errorf("expected to found an error for non mapped node")
+				err = fmt.Errorf("expected to found an error for non mapped node")
 			}
 			ux.Logger.PrintToUser("Failed to add node %s as subnet validator due to %s", host.NodeID, err)
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		nodeID, err := ids.NodeIDFromString(nodeIDStr)
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as subnet validator due to %s", host.NodeID, err)
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		// we have to check if node is synced to subnet before adding the node as a validator
 		subnetSyncStatus, err := getNodeSubnetSyncStatus(host, blockchainID.String())
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to get subnet sync status for node %s", host.NodeID)
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		if subnetSyncStatus != status.Syncing.String() {
 			if subnetSyncStatus == status.Validating.String() {
 				ux.Logger.PrintToUser("Failed to add node %s as subnet validator as node is already a subnet validator", host.NodeID)
-				nodeErrors[host.NodeID] = errors.New("node is already a subnet validator")
+				return nil, errors.New("node is already a subnet validator")
 			} else {
 				ux.Logger.PrintToUser("Failed to add node %s as subnet validator as node is not synced to subnet yet", host.NodeID)
-				nodeErrors[host.NodeID] = errors.New("node is not synced to subnet yet, please try again later")
+				return nil, errors.New("node is not synced to subnet yet, please try again later")
 			}
-			continue
 		}
 		clusterNodeID := host.GetCloudID()
-		addedNodeAsPrimaryNetworkValidator, err := addNodeAsPrimaryNetworkValidator(network, kc, nodeID, i, clusterNodeID)
+		addedNodeAsPrimaryNetworkValidator, err := addNodeAsPrimaryNetworkValidator(network, kc, nodeID, hostIndices[host.NodeID], clusterNodeID)
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as subnet validator due to %s", host.NodeID, err.Error())
-			nodeErrors[host.NodeID] = err
-			continue
+			return nil, err
 		}
 		if addedNodeAsPrimaryNetworkValidator {
 			if err := waitForNodeToBePrimaryNetworkValidator(network, nodeID); err != nil {
 				ux.Logger.PrintToUser("Failed to add node %s as subnet validator due to %s", host.NodeID, err.Error())
-				nodeErrors[host.NodeID] = err
-				continue
+				return nil, err
 			}
 		}
-		err = addNodeAsSubnetValidator(network, kc, useLedger, nodeIDStr, subnetName, i, len(hosts))
+		err = addNodeAsSubnetValidator(network, kc, useLedger, nodeIDStr, subnetName, hostIndices[host.NodeID], len(hosts))
 		if err != nil {
 			ux.Logger.PrintToUser("Failed to add node %s as subnet validator due to %s", host.NodeID, err.Error())
-			nodeErrors[host.NodeID] = err
-		}
-	}
-	if len(nodeErrors) > 0 {
-		ux.Logger.PrintToUser("Failed nodes: ")
-		for node, err := range nodeErrors {
-			ux.Logger.PrintToUser("node %s failed due to %s", node, err)
 		}
-		return fmt.Errorf("node(s) %s failed to validate subnet %s", maps.Keys(nodeErrors), subnetName)
+		return nil, err
+	})
+	executor.PrintSummary(results)
+	if results.HasErrors() {
+		return fmt.Errorf("node(s) %s failed to validate subnet %s", maps.Keys(results.GetErrorHostMap()), subnetName)
 	} else {
 		ux.Logger.PrintToUser("All nodes in cluster %s are successfully added as Subnet validators!", clusterName)
 	}
@@ -5,15 +5,15 @@ package nodecmd
 import (
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
-	"github.com/DioneProtocol/odyssey-cli/pkg/ansible"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ssh"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
@@ -49,6 +49,7 @@ var (
 	subnetConf          string
 	chainConf           string
 	validators          []string
+	wizAnswers          flags.AnswersFiles
 )
 
 func newWizCmd() *cobra.Command {
@@ -61,7 +62,7 @@ The node wiz command creates a devnet and deploys, sync and validate a subnet in
 `,
 		SilenceUsage: true,
 		Args:         cobra.RangeArgs(1, 2),
-		RunE:         wiz,
+		RunE:         flags.RunWithAnswers(&app.Prompt, &wizAnswers, wiz, createAnswerKeys, vm.AnswerKeys, subnetcmd.CreateAnswerKeys),
 	}
 	cmd.Flags().BoolVar(&useStaticIP, "use-static-ip", true, "attach static Public IP on cloud servers")
 	cmd.Flags().BoolVar(&useAWS, "aws", false, "create node/s in AWS cloud")
@@ -99,6 +100,7 @@ The node wiz command creates a devnet and deploys, sync and validate a subnet in
 	cmd.Flags().BoolVar(&sameMonitoringInstance, "same-monitoring-instance", false, "host monitoring for a cloud servers on the same instance")
 	cmd.Flags().BoolVar(&separateMonitoringInstance, "separate-monitoring-instance", false, "host monitoring for all cloud servers on a separate instance")
 	cmd.Flags().BoolVar(&skipMonitoring, "skip-monitoring", false, "don't set up monitoring in created nodes")
+	flags.AddAnswersFlags(cmd, &wizAnswers)
 	return cmd
 }
 
@@ -172,7 +174,7 @@ func wiz(cmd *cobra.Command, args []string) error {
 
 	// check all validators are found
 	if len(validators) != 0 {
-		hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+		hosts, err := getClusterHosts(clusterName)
 		if err != nil {
 			return err
 		}
@@ -243,7 +245,7 @@ func waitForHealthyCluster(
 ) error {
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("Waiting for node(s) in cluster %s to be healthy...", clusterName)
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -281,7 +283,7 @@ func waitForClusterSubnetStatus(
 ) error {
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("Waiting for node(s) in cluster %s to be %s subnet %s...", clusterName, strings.ToLower(targetStatus.String()), subnetName)
-	hosts, err := ansible.GetInventoryFromAnsibleInventoryFile(app.GetAnsibleInventoryDirPath(clusterName))
+	hosts, err := getClusterHosts(clusterName)
 	if err != nil {
 		return err
 	}
@@ -294,31 +296,18 @@ func waitForClusterSubnetStatus(
 	defer disconnectHosts(hosts)
 	startTime := time.Now()
 	for {
-		wg := sync.WaitGroup{}
-		wgResults := models.NodeResults{}
-		for _, host := range hosts {
-			wg.Add(1)
-			go func(nodeResults *models.NodeResults, host *models.Host) {
-				defer wg.Done()
-				if syncstatus, err := ssh.RunSSHSubnetSyncStatus(host, blockchainID.String()); err != nil {
-					nodeResults.AddResult(host.NodeID, nil, err)
-					return
-				} else {
-					if subnetSyncStatus, err := parseSubnetSyncOutput(syncstatus); err != nil {
-						nodeResults.AddResult(host.NodeID, nil, err)
-						return
-					} else {
-						nodeResults.AddResult(host.NodeID, subnetSyncStatus, err)
-					}
-				}
-			}(&wgResults, host)
-		}
-		wg.Wait()
-		if wgResults.HasErrors() {
-			return fmt.Errorf("failed to check sync status for node(s) %s", wgResults.GetErrorHostMap())
+		results := runOnHosts(hosts, func(host *models.Host) (interface{}, error) {
+			resp, err := ssh.RunSSHSubnetSyncStatus(host, blockchainID.String())
+			if err != nil {
+				return nil, err
+			}
+			return parseSubnetSyncOutput(resp)
+		})
+		if results.HasErrors() {
+			return fmt.Errorf("failed to check sync status for node(s) %s", results.GetErrorHostMap())
 		}
 		failedNodes := []string{}
-		for host, subnetSyncStatus := range wgResults.GetResultMap() {
+		for host, subnetSyncStatus := range results.GetResultMap() {
 			if subnetSyncStatus != targetStatus.String() {
 				failedNodes = append(failedNodes, host)
 			}
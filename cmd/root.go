@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/perms"
 	"github.com/spf13/cobra"
@@ -44,6 +46,9 @@ var (
 	Version   = ""
 	cfgFile   string
 	skipCheck bool
+	output    string
+
+	nonInteractive bool
 )
 
 func NewRootCmd() *cobra.Command {
@@ -67,6 +72,13 @@ in with odyssey subnet create myNewSubnet.`,
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.odyssey-cli/config.json)")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "ERROR", "log level for the application")
 	rootCmd.PersistentFlags().BoolVar(&skipCheck, constants.SkipUpdateFlag, false, "skip check for new versions")
+	rootCmd.PersistentFlags().StringVar(&output, constants.OutputFlag, string(ux.OutputTable), "output format for listing and status commands (table, json or yaml)")
+	rootCmd.PersistentFlags().BoolVar(
+		&nonInteractive,
+		constants.NonInteractiveFlag,
+		false,
+		fmt.Sprintf("fail instead of prompting for missing values (can also be set with %s)", constants.NonInteractiveEnvVarName),
+	)
 
 	// add sub commands
 	rootCmd.AddCommand(subnetcmd.NewCmd(app))
@@ -92,6 +104,11 @@ in with odyssey subnet create myNewSubnet.`,
 }
 
 func createApp(cmd *cobra.Command, _ []string) error {
+	outputFormat, err := ux.ParseOutputFormat(output)
+	if err != nil {
+		return err
+	}
+	ux.SetOutputFormat(outputFormat)
 	baseDir, err := setupEnv()
 	if err != nil {
 		return err
@@ -101,14 +118,28 @@ func createApp(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 	cf := config.New()
-	app.Setup(baseDir, log, cf, prompts.NewPrompter(), application.NewDownloader())
+	prompter, err := getPrompter(cmd)
+	if err != nil {
+		return err
+	}
+	app.Setup(baseDir, log, cf, prompter, application.NewDownloader())
 
 	initConfig()
 
 	if err := migrations.RunMigrations(app); err != nil {
 		return err
 	}
-	if os.Getenv("RUN_E2E") == "" && !app.Conf.ConfigFileExists() && !utils.FileExists(utils.UserHomePath(constants.OldMetricsConfigFileName)) && metrics.CheckCommandIsNotCompletion(cmd) {
+	if _, err := app.LoadCustomNetworksConfig(); err != nil {
+		return err
+	}
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	if err != nil {
+		return err
+	}
+	if err := vm.SetCustomPrecompiles(customPrecompilesConfig.Precompiles); err != nil {
+		return err
+	}
+	if os.Getenv("RUN_E2E") == "" && !nonInteractive && !app.Conf.ConfigFileExists() && !utils.FileExists(utils.UserHomePath(constants.OldMetricsConfigFileName)) && metrics.CheckCommandIsNotCompletion(cmd) {
 		err = metrics.HandleUserMetricsPreference(app)
 		if err != nil {
 			return err
@@ -121,6 +152,24 @@ func createApp(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// getPrompter returns a prompter that fails on every prompt if non-interactive
+// mode was requested either by flag or by env var
+func getPrompter(cmd *cobra.Command) (prompts.Prompter, error) {
+	if !nonInteractive {
+		if envValue := os.Getenv(constants.NonInteractiveEnvVarName); envValue != "" {
+			var err error
+			nonInteractive, err = strconv.ParseBool(envValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q for %s: %w", envValue, constants.NonInteractiveEnvVarName, err)
+			}
+		}
+	}
+	if nonInteractive {
+		return prompts.NewNonInteractivePrompter(cmd.CommandPath(), prompts.GetFlagHints(cmd)), nil
+	}
+	return prompts.NewPrompter(), nil
+}
+
 // checkForUpdates evaluates first if the user is maybe wanting to skip the update check
 // if there's no skip, it runs the update check
 func checkForUpdates(cmd *cobra.Command, app *application.Odyssey) error {
@@ -280,8 +329,13 @@ func setupLogging(baseDir string) (logging.Logger, error) {
 		factory.Close()
 		return nil, fmt.Errorf("failed setting up logging, exiting: %w", err)
 	}
-	// create the user facing logger as a global var
-	ux.NewUserLog(log, os.Stdout)
+	// create the user facing logger as a global var.
+	// with structured output, stdout is reserved for the json/yaml document
+	userWriter := os.Stdout
+	if ux.IsStructuredOutput() {
+		userWriter = os.Stderr
+	}
+	ux.NewUserLog(log, userWriter)
 	return log, nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
+	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/spf13/cobra"
 )
@@ -56,6 +57,7 @@ these prompts by providing the values with flags.`,
 	cmd.Flags().DurationVar(&duration, "staking-period", 0, "how long delegator should delegate for after start time")
 	cmd.Flags().BoolVarP(&useLedger, "ledger", "g", false, "use ledger instead of key (always true on mainnet, defaults to false on testnet)")
 	cmd.Flags().StringSliceVar(&ledgerAddresses, "ledger-addrs", []string{}, "use the given ledger addresses")
+	cmd.Flags().StringVar(&outputTxPath, "output-tx-path", "", "file path of the add permissionless delegator tx, to be signed and committed later instead of issued")
 
 	return cmd
 }
@@ -77,6 +79,7 @@ func addPermissionlessDelegator(_ *cobra.Command, args []string) error {
 		deployTestnet,
 		deployMainnet,
 		"",
+		"",
 		true,
 		[]models.NetworkKind{models.Local, models.Testnet, models.Mainnet},
 	)
@@ -148,6 +151,13 @@ func addPermissionlessDelegator(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if outputTxPath != "" {
+		tx, err := deployer.CreateAddPermissionlessDelegatorTx(subnetID, assetID, nodeID, stakedTokenAmount, uint64(start.Unix()), uint64(endTime.Unix()), recipientAddr)
+		if err != nil {
+			return err
+		}
+		return txutils.SaveNotFullySignedInputsTx(app, "Add Permissionless Delegator", tx, outputTxPath, false)
+	}
 	txID, err := deployer.AddPermissionlessDelegator(subnetID, assetID, nodeID, stakedTokenAmount, uint64(start.Unix()), uint64(endTime.Unix()), recipientAddr)
 	if err != nil {
 		return err
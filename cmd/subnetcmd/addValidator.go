@@ -73,6 +73,7 @@ Testnet or Mainnet.`,
 	cmd.Flags().BoolVar(&deployDevnet, "devnet", false, "add subnet validator on `devnet`")
 	cmd.Flags().BoolVar(&deployTestnet, "testnet", false, "add subnet validator on `testnet`")
 	cmd.Flags().BoolVar(&deployMainnet, "mainnet", false, "add subnet validator on `mainnet`")
+	cmd.Flags().StringVar(&deployNetworkName, "network", "", "add subnet validator on the given network, registered with network add")
 	cmd.Flags().StringSliceVar(&subnetAuthKeys, "subnet-auth-keys", nil, "control keys that will be used to authenticate add validator tx")
 	cmd.Flags().StringVar(&outputTxPath, "output-tx-path", "", "file path of the add validator tx")
 	cmd.Flags().BoolVarP(&useEwoq, "ewoq", "e", false, "use ewoq key [testnet/devnet only]")
@@ -87,9 +88,10 @@ func addValidator(_ *cobra.Command, args []string) error {
 		deployDevnet,
 		deployTestnet,
 		deployMainnet,
+		deployNetworkName,
 		endpoint,
 		true,
-		[]models.NetworkKind{models.Local, models.Devnet, models.Testnet, models.Mainnet},
+		[]models.NetworkKind{models.Local, models.Devnet, models.Testnet, models.Mainnet, models.Custom},
 	)
 	if err != nil {
 		return err
@@ -253,7 +255,7 @@ func PromptDuration(start time.Time, network models.Network) (time.Duration, err
 		txt := "How long should this validator be validating? Enter a duration, e.g. 8760h. Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\""
 		var d time.Duration
 		var err error
-		if network.Kind == models.Testnet {
+		if network.Kind == models.Testnet || network.Kind == models.Custom {
 			d, err = app.Prompt.CaptureTestnetDuration(txt)
 		} else {
 			d, err = app.Prompt.CaptureMainnetDuration(txt)
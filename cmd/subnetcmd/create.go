@@ -10,12 +10,15 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/metrics"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/subnet-evm/commontype"
 	"github.com/spf13/cobra"
 	"golang.org/x/mod/semver"
 )
@@ -23,6 +26,8 @@ import (
 const (
 	forceFlag = "force"
 	latest    = "latest"
+
+	chooseVMPrompt = "Choose your VM"
 )
 
 var (
@@ -35,8 +40,18 @@ var (
 	evmChainID          uint64
 	evmToken            string
 	evmDefaults         bool
+	evmAirdropFile      string
+	evmFeeConfigFile    string
+	evmFeePreset        string
 	useLatestEvmVersion bool
 	useRepo             bool
+	createAnswers       flags.AnswersFiles
+
+	// CreateAnswerKeys are the stable answers file keys of the subnet create prompts
+	// not done by the VM wizards
+	CreateAnswerKeys = prompts.AnswerKeys{
+		chooseVMPrompt: "subnet.vm",
+	}
 
 	errIllegalNameCharacter = errors.New(
 		"illegal name character: only letters, no special characters allowed")
@@ -55,12 +70,22 @@ The tool supports deploying Subnet-EVM, and custom VMs. You
 can create a custom, user-generated genesis with a custom VM by providing
 the path to your genesis and VM binaries with the --genesis and --vm flags.
 
+To airdrop tokens to many addresses in a Subnet-EVM genesis, pass a CSV or JSON file
+with --airdrop-file. CSV files have a header row with address and balance columns, and
+optional code and storage columns to pre-deploy contracts. JSON files are arrays of
+objects with the same fields. Balances are given in token units.
+
+To skip the Subnet-EVM fee prompts, pass a JSON file with all the fields of the fee
+config with --fee-config, or the name of a fee preset with --fee-preset. The low, medium
+and high presets are the throughput options of the wizard, and other presets are saved
+with subnet fee-preset save. The estimated transaction costs of the fee config are shown.
+
 By default, running the command with a subnetName that already exists
 causes the command to fail. If you’d like to overwrite an existing
 configuration, pass the -f flag.`,
 		SilenceUsage:      true,
 		Args:              cobra.ExactArgs(1),
-		RunE:              createSubnetConfig,
+		RunE:              flags.RunWithAnswers(&app.Prompt, &createAnswers, createSubnetConfig, vm.AnswerKeys, CreateAnswerKeys),
 		PersistentPostRun: handlePostRun,
 	}
 	cmd.Flags().StringVar(&genesisFile, "genesis", "", "file path of genesis to use")
@@ -69,6 +94,9 @@ configuration, pass the -f flag.`,
 	cmd.Flags().Uint64Var(&evmChainID, "evm-chain-id", 0, "chain ID to use with Subnet-EVM")
 	cmd.Flags().StringVar(&evmToken, "evm-token", "", "token name to use with Subnet-EVM")
 	cmd.Flags().BoolVar(&evmDefaults, "evm-defaults", false, "use default settings for fees/airdrop/precompiles with Subnet-EVM")
+	cmd.Flags().StringVar(&evmAirdropFile, "airdrop-file", "", "file path of the Subnet-EVM genesis airdrop, in CSV or JSON (balances in token units)")
+	cmd.Flags().StringVar(&evmFeeConfigFile, "fee-config", "", "file path of the Subnet-EVM fee config, in JSON")
+	cmd.Flags().StringVar(&evmFeePreset, "fee-preset", "", "use the Subnet-EVM fee config of this preset (low, medium, high or saved)")
 	cmd.Flags().BoolVar(&useCustom, "custom", false, "use a custom VM template")
 	cmd.Flags().BoolVar(&useLatestEvmVersion, latest, false, "use latest Subnet-EVM version, takes precedence over --vm-version")
 	cmd.Flags().BoolVarP(&forceCreate, forceFlag, "f", false, "overwrite the existing configuration if one exists")
@@ -78,6 +106,7 @@ configuration, pass the -f flag.`,
 	cmd.Flags().StringVar(&customVMBranch, "custom-vm-branch", "", "custom vm branch")
 	cmd.Flags().StringVar(&customVMBuildScript, "custom-vm-build-script", "", "custom vm build-script")
 	cmd.Flags().BoolVar(&useRepo, "from-github-repo", false, "generate custom VM binary from github repository")
+	flags.AddAnswersFlags(cmd, &createAnswers)
 	return cmd
 }
 
@@ -135,6 +164,29 @@ func getVMFromFlag() models.VMType {
 	return ""
 }
 
+// getFeeConfigFromFlags returns the fee config given with --fee-config or --fee-preset, if any
+func getFeeConfigFromFlags() (*commontype.FeeConfig, error) {
+	var (
+		feeConfig commontype.FeeConfig
+		err       error
+	)
+	switch {
+	case evmFeeConfigFile != "" && evmFeePreset != "":
+		return nil, errors.New("--fee-config and --fee-preset can't be used together")
+	case evmFeeConfigFile != "":
+		feeConfig, err = vm.LoadFeeConfigFile(evmFeeConfigFile)
+	case evmFeePreset != "":
+		feeConfig, err = vm.GetFeePreset(app, evmFeePreset)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vm.PrintFeePreview(vm.PreviewFeeConfig(feeConfig))
+	return &feeConfig, nil
+}
+
 // override postrun function from root.go, so that we don't double send metrics for the same command
 func handlePostRun(_ *cobra.Command, _ []string) {}
 
@@ -156,7 +208,7 @@ func createSubnetConfig(cmd *cobra.Command, args []string) error {
 
 	if subnetType == "" {
 		subnetTypeStr, err := app.Prompt.CaptureList(
-			"Choose your VM",
+			chooseVMPrompt,
 			[]string{models.SubnetEvm, models.CustomVM},
 		)
 		if err != nil {
@@ -181,11 +233,21 @@ func createSubnetConfig(cmd *cobra.Command, args []string) error {
 
 	switch subnetType {
 	case models.SubnetEvm:
-		genesisBytes, sc, err = vm.CreateEvmSubnetConfig(app, subnetName, genesisFile, evmVersion, evmChainID, evmToken, evmDefaults)
+		feeConfig, err := getFeeConfigFromFlags()
+		if err != nil {
+			return err
+		}
+		genesisBytes, sc, err = vm.CreateEvmSubnetConfig(app, subnetName, genesisFile, evmVersion, evmChainID, evmToken, evmDefaults, evmAirdropFile, feeConfig)
 		if err != nil {
 			return err
 		}
 	case models.CustomVM:
+		if evmAirdropFile != "" {
+			return errors.New("--airdrop-file is only supported with Subnet-EVM")
+		}
+		if evmFeeConfigFile != "" || evmFeePreset != "" {
+			return errors.New("--fee-config and --fee-preset are only supported with Subnet-EVM")
+		}
 		genesisBytes, sc, err = vm.CreateCustomSubnetConfig(
 			app,
 			subnetName,
@@ -13,7 +13,6 @@ import (
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
-	"github.com/DioneProtocol/odyssey-cli/pkg/key"
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
 	"github.com/DioneProtocol/odyssey-cli/pkg/localnetworkinterface"
 	"github.com/DioneProtocol/odyssey-cli/pkg/metrics"
@@ -38,6 +37,7 @@ var (
 	deployDevnet             bool
 	deployTestnet            bool
 	deployMainnet            bool
+	deployNetworkName        string
 	endpoint                 string
 	sameControlKey           bool
 	keyName                  string
@@ -87,6 +87,7 @@ so you can take your locally tested Subnet and deploy it on Testnet or Mainnet.`
 	cmd.Flags().BoolVar(&deployDevnet, "devnet", false, "deploy to a devnet network")
 	cmd.Flags().BoolVarP(&deployTestnet, "testnet", "t", false, "deploy to testnet")
 	cmd.Flags().BoolVarP(&deployMainnet, "mainnet", "m", false, "deploy to mainnet")
+	cmd.Flags().StringVar(&deployNetworkName, "network", "", "deploy to the given network, registered with network add")
 	cmd.Flags().StringVar(&userProvidedOdygoVersion, "odysseygo-version", "latest", "use this version of odysseygo (ex: v1.17.12)")
 	cmd.Flags().StringVarP(&keyName, "key", "k", "", "select the key to use [testnet/devnet deploy only]")
 	cmd.Flags().BoolVarP(&sameControlKey, "same-control-key", "s", false, "use the fee-paying key as control key")
@@ -309,9 +310,10 @@ func deploySubnet(cmd *cobra.Command, args []string) error {
 		deployDevnet,
 		deployTestnet,
 		deployMainnet,
+		deployNetworkName,
 		endpoint,
 		true,
-		[]models.NetworkKind{models.Local, models.Devnet, models.Testnet, models.Mainnet},
+		[]models.NetworkKind{models.Local, models.Devnet, models.Testnet, models.Mainnet, models.Custom},
 	)
 	if err != nil {
 		return err
@@ -639,7 +641,7 @@ func useAllKeys(network models.Network) ([]string, error) {
 	}
 
 	for _, kp := range keyPaths {
-		k, err := key.LoadSoft(network.ID, kp)
+		k, err := app.LoadKey(network.ID, kp)
 		if err != nil {
 			return nil, err
 		}
@@ -789,7 +791,7 @@ func PrintReadyToSignMsg(
 	ux.Logger.PrintToUser("Tx is fully signed, and ready to be committed")
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("Commit command:")
-	ux.Logger.PrintToUser("  odyssey transaction commit %s --input-tx-filepath %s", chain, outputTxPath)
+	ux.Logger.PrintToUser("  odyssey transaction commit %s", transactionCmdArgs(chain, outputTxPath))
 }
 
 func PrintRemainingToSignMsg(
@@ -807,10 +809,19 @@ func PrintRemainingToSignMsg(
 		"and run the signing command, or send %q to another user for signing.", outputTxPath)
 	ux.Logger.PrintToUser("")
 	ux.Logger.PrintToUser("Signing command:")
-	ux.Logger.PrintToUser("  odyssey transaction sign %s --input-tx-filepath %s", chain, outputTxPath)
+	ux.Logger.PrintToUser("  odyssey transaction sign %s", transactionCmdArgs(chain, outputTxPath))
 	ux.Logger.PrintToUser("")
 }
 
+// transactionCmdArgs returns the args of the transaction sign and commit commands for the
+// tx at [txPath] of [chain]. Txs without subnet auth have no chain.
+func transactionCmdArgs(chain string, txPath string) string {
+	if chain == "" {
+		return fmt.Sprintf("--input-tx-filepath %s", txPath)
+	}
+	return fmt.Sprintf("%s --input-tx-filepath %s", chain, txPath)
+}
+
 func PrintDeployResults(chain string, subnetID ids.ID, blockchainID ids.ID) error {
 	vmID, err := onrutils.VMID(chain)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"sort"
 	"strconv"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
@@ -254,6 +255,133 @@ func appendToAddressTable(
 	}
 }
 
+// subnetDescription is the --output json|yaml schema of subnet describe.
+// Genesis related fields are only present for Subnet-EVM subnets. Big numbers,
+// such as fee parameters and airdrop amounts (in wei), are given as decimal strings.
+type subnetDescription struct {
+	Name           string                        `json:"name" yaml:"name"`
+	VM             string                        `json:"vm" yaml:"vm"`
+	VMVersion      string                        `json:"vmVersion" yaml:"vmVersion"`
+	VMID           string                        `json:"vmID" yaml:"vmID"`
+	ChainID        string                        `json:"chainID,omitempty" yaml:"chainID,omitempty"`
+	MainnetChainID uint                          `json:"mainnetChainID" yaml:"mainnetChainID"`
+	TokenName      string                        `json:"tokenName" yaml:"tokenName"`
+	Networks       map[string]subnetDeployInfo   `json:"networks" yaml:"networks"`
+	FeeConfig      *subnetFeeConfigDescription   `json:"feeConfig,omitempty" yaml:"feeConfig,omitempty"`
+	Airdrops       []subnetAirdropDescription    `json:"airdrops,omitempty" yaml:"airdrops,omitempty"`
+	Precompiles    []subnetPrecompileDescription `json:"precompiles,omitempty" yaml:"precompiles,omitempty"`
+}
+
+type subnetFeeConfigDescription struct {
+	GasLimit                 string `json:"gasLimit" yaml:"gasLimit"`
+	MinBaseFee               string `json:"minBaseFee" yaml:"minBaseFee"`
+	TargetGas                string `json:"targetGas" yaml:"targetGas"`
+	BaseFeeChangeDenominator string `json:"baseFeeChangeDenominator" yaml:"baseFeeChangeDenominator"`
+	MinBlockGasCost          string `json:"minBlockGasCost" yaml:"minBlockGasCost"`
+	MaxBlockGasCost          string `json:"maxBlockGasCost" yaml:"maxBlockGasCost"`
+	TargetBlockRate          uint64 `json:"targetBlockRate" yaml:"targetBlockRate"`
+	BlockGasCostStep         string `json:"blockGasCostStep" yaml:"blockGasCostStep"`
+}
+
+type subnetAirdropDescription struct {
+	Address string `json:"address" yaml:"address"`
+	Amount  string `json:"amount" yaml:"amount"`
+}
+
+type subnetPrecompileDescription struct {
+	Name             string   `json:"name" yaml:"name"`
+	AdminAddresses   []string `json:"adminAddresses" yaml:"adminAddresses"`
+	EnabledAddresses []string `json:"enabledAddresses" yaml:"enabledAddresses"`
+}
+
+func getSubnetDescription(sc models.Sidecar) subnetDescription {
+	desc := subnetDescription{
+		Name:           sc.Subnet,
+		VM:             string(sc.VM),
+		VMVersion:      sc.VMVersion,
+		VMID:           sc.ImportedVMID,
+		MainnetChainID: sc.SubnetEVMMainnetChainID,
+		TokenName:      app.GetTokenName(sc.Subnet),
+		Networks:       map[string]subnetDeployInfo{},
+	}
+	if desc.VMID == "" {
+		desc.VMID = constants.NotAvailableLabel
+		if vmID, err := utils.VMID(sc.Name); err == nil {
+			desc.VMID = vmID.String()
+		}
+	}
+	for net, data := range sc.Networks {
+		info := subnetDeployInfo{}
+		if data.SubnetID != ids.Empty {
+			info.SubnetID = data.SubnetID.String()
+		}
+		if data.BlockchainID != ids.Empty {
+			info.BlockchainID = data.BlockchainID.String()
+		}
+		desc.Networks[net] = info
+	}
+	return desc
+}
+
+func getSubnetEvmDescription(genesis core.Genesis, sc models.Sidecar) subnetDescription {
+	desc := getSubnetDescription(sc)
+	desc.ChainID = genesis.Config.ChainID.String()
+
+	feeConfig := genesis.Config.FeeConfig
+	desc.FeeConfig = &subnetFeeConfigDescription{
+		GasLimit:                 feeConfig.GasLimit.String(),
+		MinBaseFee:               feeConfig.MinBaseFee.String(),
+		TargetGas:                feeConfig.TargetGas.String(),
+		BaseFeeChangeDenominator: feeConfig.BaseFeeChangeDenominator.String(),
+		MinBlockGasCost:          feeConfig.MinBlockGasCost.String(),
+		MaxBlockGasCost:          feeConfig.MaxBlockGasCost.String(),
+		TargetBlockRate:          feeConfig.TargetBlockRate,
+		BlockGasCostStep:         feeConfig.BlockGasCostStep.String(),
+	}
+
+	for address, account := range genesis.Alloc {
+		desc.Airdrops = append(desc.Airdrops, subnetAirdropDescription{
+			Address: address.Hex(),
+			Amount:  account.Balance.String(),
+		})
+	}
+	sort.Slice(desc.Airdrops, func(i, j int) bool { return desc.Airdrops[i].Address < desc.Airdrops[j].Address })
+
+	appendPrecompile := func(name string, adminAddresses []common.Address, enabledAddresses []common.Address) {
+		desc.Precompiles = append(desc.Precompiles, subnetPrecompileDescription{
+			Name:             name,
+			AdminAddresses:   hexAddresses(adminAddresses),
+			EnabledAddresses: hexAddresses(enabledAddresses),
+		})
+	}
+	if cfg, ok := genesis.Config.GenesisPrecompiles[nativeminter.ConfigKey].(*nativeminter.Config); ok {
+		appendPrecompile("Native Minter", cfg.AdminAddresses, cfg.EnabledAddresses)
+	}
+	if cfg, ok := genesis.Config.GenesisPrecompiles[deployerallowlist.ConfigKey].(*deployerallowlist.Config); ok {
+		appendPrecompile("Contract Allow List", cfg.AdminAddresses, cfg.EnabledAddresses)
+	}
+	if cfg, ok := genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey].(*txallowlist.Config); ok {
+		appendPrecompile("Tx Allow List", cfg.AdminAddresses, cfg.EnabledAddresses)
+	}
+	if cfg, ok := genesis.Config.GenesisPrecompiles[feemanager.ConfigKey].(*feemanager.Config); ok {
+		appendPrecompile("Fee Config Allow List", cfg.AdminAddresses, cfg.EnabledAddresses)
+	}
+	if cfg, ok := genesis.Config.GenesisPrecompiles[rewardmanager.ConfigKey].(*rewardmanager.Config); ok {
+		appendPrecompile("Reward Manager Allow List", cfg.AdminAddresses, cfg.EnabledAddresses)
+	}
+	return desc
+}
+
+func hexAddresses(addresses []common.Address) []string {
+	hexAddrs := []string{}
+	for _, address := range addresses {
+		if address != (common.Address{}) {
+			hexAddrs = append(hexAddrs, address.Hex())
+		}
+	}
+	return hexAddrs
+}
+
 func describeSubnetEvmGenesis(sc models.Sidecar) error {
 	// Load genesis
 	genesis, err := app.LoadEvmGenesis(sc.Subnet)
@@ -261,13 +389,14 @@ func describeSubnetEvmGenesis(sc models.Sidecar) error {
 		return err
 	}
 
-	printDetails(genesis, sc)
-	// Write gas table
-	printGasTable(genesis)
-	// fmt.Printf("\n\n")
-	printAirdropTable(genesis)
-	printPrecompileTable(genesis)
-	return nil
+	return ux.Render(getSubnetEvmDescription(genesis, sc), func() {
+		printDetails(genesis, sc)
+		// Write gas table
+		printGasTable(genesis)
+		// fmt.Printf("\n\n")
+		printAirdropTable(genesis)
+		printPrecompileTable(genesis)
+	})
 }
 
 func readGenesis(_ *cobra.Command, args []string) error {
@@ -292,6 +421,9 @@ func readGenesis(_ *cobra.Command, args []string) error {
 		return describeSubnetEvmGenesis(sc)
 	}
 	app.Log.Warn("Unknown genesis format", zap.Any("vm-type", sc.VM))
+	if ux.IsStructuredOutput() {
+		return ux.Render(getSubnetDescription(sc), nil)
+	}
 	ux.Logger.PrintToUser("Printing genesis")
 	return printGenesis(sc, subnetName)
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	es "github.com/DioneProtocol/odyssey-cli/pkg/elasticsubnet"
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
@@ -35,6 +36,14 @@ const (
 	testnetDeployment    = "Testnet"
 	mainnetDeployment    = "Mainnet (coming soon)"
 	subnetIsElasticError = "subnet is already elastic"
+
+	elasticNetworkPrompt             = "Which network should transform into an elastic Subnet?"
+	elasticTokenNamePrompt           = "Token name"
+	elasticTokenSymbolPrompt         = "Token symbol"
+	elasticTokenDenominationPrompt   = "Token Denomination"
+	elasticConfirmPrompt             = "WARNING: Transforming a Permissioned Subnet into an Elastic Subnet is an irreversible operation. Continue?"
+	elasticTransformValidatorsPrompt = "Do you want to transform existing validators to permissionless validators with equal weight? " +
+		"Press <No> if you want to customize the structure of your permissionless validators"
 )
 
 var (
@@ -45,6 +54,16 @@ var (
 	overrideWarning     bool
 	transformValidators bool
 	denominationFlag    int
+	elasticAnswers      flags.AnswersFiles
+
+	elasticAnswerKeys = prompts.AnswerKeys{
+		elasticNetworkPrompt:             "elastic.network",
+		elasticTokenNamePrompt:           "elastic.tokenName",
+		elasticTokenSymbolPrompt:         "elastic.tokenSymbol",
+		elasticTokenDenominationPrompt:   "elastic.tokenDenomination",
+		elasticConfirmPrompt:             "elastic.confirm",
+		elasticTransformValidatorsPrompt: "elastic.transformValidators",
+	}
 )
 
 // odyssey subnet elastic
@@ -59,7 +78,7 @@ and that will be distributed as staking rewards, and provides a set of parameter
 mechanics will work.`,
 		SilenceUsage:      true,
 		Args:              cobra.ExactArgs(1),
-		RunE:              transformElasticSubnet,
+		RunE:              flags.RunWithAnswers(&app.Prompt, &elasticAnswers, transformElasticSubnet, es.AnswerKeys, elasticAnswerKeys),
 		PersistentPostRun: handlePostRun,
 	}
 	cmd.Flags().BoolVarP(&transformLocal, "local", "l", false, "transform a subnet on a local network")
@@ -78,6 +97,7 @@ mechanics will work.`,
 	cmd.Flags().StringVarP(&keyName, "key", "k", "", "select the key to use [testnet only]")
 	cmd.Flags().StringSliceVar(&subnetAuthKeys, "subnet-auth-keys", nil, "control keys that will be used to authenticate the transformSubnet tx")
 	cmd.Flags().StringVar(&outputTxPath, "output-tx-path", "", "file path of the transformSubnet tx")
+	flags.AddAnswersFlags(cmd, &elasticAnswers)
 	return cmd
 }
 
@@ -434,7 +454,7 @@ func transformElasticSubnetLocal(sc models.Sidecar, subnetName string, tokenName
 	}
 
 	if !overrideWarning {
-		yes, err := app.Prompt.CaptureNoYes("WARNING: Transforming a Permissioned Subnet into an Elastic Subnet is an irreversible operation. Continue?")
+		yes, err := app.Prompt.CaptureNoYes(elasticConfirmPrompt)
 		if err != nil {
 			return err
 		}
@@ -466,8 +486,7 @@ func transformElasticSubnetLocal(sc models.Sidecar, subnetName string, tokenName
 
 	if !transformValidators {
 		if !overrideWarning {
-			yes, err := app.Prompt.CaptureNoYes("Do you want to transform existing validators to permissionless validators with equal weight? " +
-				"Press <No> if you want to customize the structure of your permissionless validators")
+			yes, err := app.Prompt.CaptureNoYes(elasticTransformValidatorsPrompt)
 			if err != nil {
 				return err
 			}
@@ -535,13 +554,12 @@ func getNetworkOptions(sc models.Sidecar) []string {
 
 // select which network to transform to elastic subnet
 func selectNetworkToTransform(sc models.Sidecar) (string, error) {
-	networkPrompt := "Which network should transform into an elastic Subnet?"
 	networkOptions := getNetworkOptions(sc)
 	if len(networkOptions) == 0 {
 		return "", errors.New("no deployment target available, please first deploy created subnet")
 	}
 
-	selectedDeployment, err := app.Prompt.CaptureList(networkPrompt, networkOptions)
+	selectedDeployment, err := app.Prompt.CaptureList(elasticNetworkPrompt, networkOptions)
 	if err != nil {
 		return "", err
 	}
@@ -572,7 +590,7 @@ func PrintTransformResults(chain string, txID ids.ID, subnetID ids.ID, tokenName
 
 func getTokenName() (string, error) {
 	ux.Logger.PrintToUser("Select a name for your subnet's native token")
-	tokenName, err := app.Prompt.CaptureString("Token name")
+	tokenName, err := app.Prompt.CaptureString(elasticTokenNamePrompt)
 	if err != nil {
 		return "", err
 	}
@@ -581,7 +599,7 @@ func getTokenName() (string, error) {
 
 func getTokenSymbol() (string, error) {
 	ux.Logger.PrintToUser("Select a symbol for your subnet's native token")
-	tokenSymbol, err := app.Prompt.CaptureString("Token symbol")
+	tokenSymbol, err := app.Prompt.CaptureString(elasticTokenSymbolPrompt)
 	if err != nil {
 		return "", err
 	}
@@ -682,7 +700,7 @@ func getTokenDenomination() (int, error) {
 	ux.Logger.PrintToUser("Denomination determines how balances of this asset are displayed by user interfaces. " +
 		"If denomination is 0, 100 units of this asset are displayed as 100. If denomination is 1, 100 units of this asset are displayed as 10.0.")
 	tokenDenomination, err := app.Prompt.CapturePositiveInt(
-		"Token Denomination",
+		elasticTokenDenominationPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Min Denomination Value",
@@ -0,0 +1,85 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/subnet-evm/commontype"
+	"github.com/spf13/cobra"
+)
+
+var (
+	app *application.Odyssey
+
+	feeConfigFile string
+	subnetName    string
+)
+
+// odyssey subnet fee-preset
+func NewCmd(injectedApp *application.Odyssey) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-preset",
+		Short: "Save and preview Subnet-EVM fee configs",
+		Long: `The subnet fee-preset command suite saves Subnet-EVM fee configs as named presets,
+to be used by subnet create --fee-preset and offered by the create wizard, and
+estimates the transaction costs of fee configs before deploying them.
+
+The low, medium and high presets are built-in, and are the throughput options of
+the create wizard.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			if err != nil {
+				fmt.Println(err)
+			}
+		},
+	}
+	app = injectedApp
+	// subnet fee-preset save
+	cmd.AddCommand(newSaveCmd())
+	// subnet fee-preset list
+	cmd.AddCommand(newListCmd())
+	// subnet fee-preset remove
+	cmd.AddCommand(newRemoveCmd())
+	// subnet fee-preset preview
+	cmd.AddCommand(newPreviewCmd())
+	return cmd
+}
+
+func addFeeConfigSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&feeConfigFile, "fee-config", "", "use the fee config of this JSON file")
+	cmd.Flags().StringVar(&subnetName, "subnet", "", "use the fee config of the genesis of this Subnet")
+}
+
+// getFeeConfigFromFlags returns the fee config given with --fee-config or --subnet, if any
+func getFeeConfigFromFlags() (*commontype.FeeConfig, error) {
+	switch {
+	case feeConfigFile != "" && subnetName != "":
+		return nil, errors.New("--fee-config and --subnet can't be used together")
+	case feeConfigFile != "":
+		feeConfig, err := vm.LoadFeeConfigFile(feeConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		return &feeConfig, nil
+	case subnetName != "":
+		if !app.GenesisExists(subnetName) {
+			return nil, fmt.Errorf("subnet %s does not exist", subnetName)
+		}
+		genesis, err := app.LoadEvmGenesis(subnetName)
+		if err != nil {
+			return nil, err
+		}
+		if genesis.Config == nil {
+			return nil, fmt.Errorf("subnet %s has no Subnet-EVM fee config", subnetName)
+		}
+		if err := genesis.Config.FeeConfig.Verify(); err != nil {
+			return nil, fmt.Errorf("invalid fee config of subnet %s: %w", subnetName, err)
+		}
+		return &genesis.Config.FeeConfig, nil
+	}
+	return nil, nil
+}
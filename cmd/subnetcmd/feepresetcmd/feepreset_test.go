@@ -0,0 +1,80 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+const testFeeConfig = `{
+	"gasLimit": 15000000,
+	"targetBlockRate": 2,
+	"minBaseFee": 1000000000,
+	"targetGas": 30000000,
+	"baseFeeChangeDenominator": 48,
+	"minBlockGasCost": 0,
+	"maxBlockGasCost": 10000000,
+	"blockGasCostStep": 500000
+}`
+
+func setupFeePresetsTest(t *testing.T) {
+	app = testutils.SetupTestInTempDir(t)
+	ux.NewUserLog(logging.NoLog{}, os.Stdout)
+	feeConfigFile = filepath.Join(t.TempDir(), "fee.json")
+	require.NoError(t, os.WriteFile(feeConfigFile, []byte(testFeeConfig), 0o600))
+	subnetName = ""
+	forceSave = false
+}
+
+func TestSavePreset(t *testing.T) {
+	require := require.New(t)
+	setupFeePresetsTest(t)
+
+	require.NoError(savePreset(nil, []string{"gaming"}))
+	feeConfig, err := vm.GetFeePreset(app, "gaming")
+	require.NoError(err)
+	require.Equal(uint64(30_000_000), feeConfig.TargetGas.Uint64())
+
+	require.EqualError(savePreset(nil, []string{"gaming"}), "preset gaming already exists. Use --force to overwrite it")
+	forceSave = true
+	require.NoError(savePreset(nil, []string{"gaming"}))
+
+	require.EqualError(savePreset(nil, []string{vm.MediumFeePreset}), "preset name medium is reserved for a built-in preset")
+	require.EqualError(savePreset(nil, []string{"my preset"}), `invalid preset name "my preset": only letters, digits, '-' and '_' are allowed`)
+
+	subnetName = "gaming"
+	require.EqualError(savePreset(nil, []string{"gaming"}), "--fee-config and --subnet can't be used together")
+}
+
+func TestRemovePreset(t *testing.T) {
+	require := require.New(t)
+	setupFeePresetsTest(t)
+	require.NoError(savePreset(nil, []string{"gaming"}))
+
+	require.EqualError(removePreset(nil, []string{vm.LowFeePreset}), "built-in preset low can't be removed")
+	require.NoError(removePreset(nil, []string{"gaming"}))
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	require.NoError(err)
+	require.Empty(feePresetsConfig.Presets)
+	require.EqualError(removePreset(nil, []string{"gaming"}), "preset gaming not found")
+}
+
+func TestPreviewFeeConfig(t *testing.T) {
+	require := require.New(t)
+	setupFeePresetsTest(t)
+
+	require.NoError(previewFeeConfig(nil, nil))
+	require.EqualError(previewFeeConfig(nil, []string{vm.HighFeePreset}), "give either a preset name, --fee-config or --subnet")
+	feeConfigFile = ""
+	require.NoError(previewFeeConfig(nil, []string{vm.HighFeePreset}))
+	require.EqualError(previewFeeConfig(nil, nil), "give a preset name, --fee-config or --subnet")
+	require.EqualError(previewFeeConfig(nil, []string{"defi"}), "unknown fee preset defi: use one of low, medium, high")
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/subnet-evm/commontype"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type presetInfo struct {
+	Name                     string `json:"name" yaml:"name"`
+	BuiltIn                  bool   `json:"builtIn" yaml:"builtIn"`
+	GasLimit                 string `json:"gasLimit" yaml:"gasLimit"`
+	TargetBlockRate          uint64 `json:"targetBlockRate" yaml:"targetBlockRate"`
+	MinBaseFee               string `json:"minBaseFee" yaml:"minBaseFee"`
+	TargetGas                string `json:"targetGas" yaml:"targetGas"`
+	BaseFeeChangeDenominator string `json:"baseFeeChangeDenominator" yaml:"baseFeeChangeDenominator"`
+	MinBlockGasCost          string `json:"minBlockGasCost" yaml:"minBlockGasCost"`
+	MaxBlockGasCost          string `json:"maxBlockGasCost" yaml:"maxBlockGasCost"`
+	BlockGasCostStep         string `json:"blockGasCostStep" yaml:"blockGasCostStep"`
+}
+
+// odyssey subnet fee-preset list
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the Subnet-EVM fee presets",
+		Long: `The subnet fee-preset list command lists the built-in fee presets and the ones saved
+with subnet fee-preset save, with their fee config.`,
+		RunE:         listPresets,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+	}
+}
+
+func listPresets(*cobra.Command, []string) error {
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	if err != nil {
+		return err
+	}
+	presetInfos := []presetInfo{}
+	builtinPresets := vm.GetBuiltinFeePresets()
+	for _, name := range []string{vm.LowFeePreset, vm.MediumFeePreset, vm.HighFeePreset} {
+		presetInfos = append(presetInfos, newPresetInfo(name, true, builtinPresets[name]))
+	}
+	savedNames := make([]string, 0, len(feePresetsConfig.Presets))
+	for name := range feePresetsConfig.Presets {
+		savedNames = append(savedNames, name)
+	}
+	sort.Strings(savedNames)
+	for _, name := range savedNames {
+		presetInfos = append(presetInfos, newPresetInfo(name, false, feePresetsConfig.Presets[name]))
+	}
+	return ux.Render(presetInfos, func() {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Type", "Gas Limit", "Target Block Rate", "Min Base Fee", "Target Gas (per 10s)"})
+		table.SetRowLine(true)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, info := range presetInfos {
+			presetType := "saved"
+			if info.BuiltIn {
+				presetType = "built-in"
+			}
+			table.Append([]string{
+				info.Name,
+				presetType,
+				info.GasLimit,
+				strconv.FormatUint(info.TargetBlockRate, 10),
+				info.MinBaseFee,
+				info.TargetGas,
+			})
+		}
+		table.Render()
+	})
+}
+
+func newPresetInfo(name string, builtIn bool, feeConfig commontype.FeeConfig) presetInfo {
+	return presetInfo{
+		Name:                     name,
+		BuiltIn:                  builtIn,
+		GasLimit:                 feeConfig.GasLimit.String(),
+		TargetBlockRate:          feeConfig.TargetBlockRate,
+		MinBaseFee:               feeConfig.MinBaseFee.String(),
+		TargetGas:                feeConfig.TargetGas.String(),
+		BaseFeeChangeDenominator: feeConfig.BaseFeeChangeDenominator.String(),
+		MinBlockGasCost:          feeConfig.MinBlockGasCost.String(),
+		MaxBlockGasCost:          feeConfig.MaxBlockGasCost.String(),
+		BlockGasCostStep:         feeConfig.BlockGasCostStep.String(),
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+// odyssey subnet fee-preset preview
+func newPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview [presetName]",
+		Short: "Estimate the transaction costs of a Subnet-EVM fee config",
+		Long: `The subnet fee-preset preview command estimates the economics of the fee config of
+presetName, of the JSON file of --fee-config, or of the genesis of the Subnet of --subnet.
+
+It shows the cost of typical transactions at the min base fee, which is the base fee
+while the chain uses less gas than its target, and how many of them per second reach
+the target gas. It also shows how fast the base fee rises when the blocks are full.
+Contract gas use varies with the contract, so their costs are approximations.`,
+		RunE:         previewFeeConfig,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+	}
+	addFeeConfigSourceFlags(cmd)
+	return cmd
+}
+
+func previewFeeConfig(_ *cobra.Command, args []string) error {
+	feeConfig, err := getFeeConfigFromFlags()
+	if err != nil {
+		return err
+	}
+	switch {
+	case feeConfig != nil && len(args) > 0:
+		return errors.New("give either a preset name, --fee-config or --subnet")
+	case len(args) > 0:
+		presetFeeConfig, err := vm.GetFeePreset(app, args[0])
+		if err != nil {
+			return err
+		}
+		feeConfig = &presetFeeConfig
+	case feeConfig == nil:
+		return errors.New("give a preset name, --fee-config or --subnet")
+	}
+	preview := vm.PreviewFeeConfig(*feeConfig)
+	return ux.Render(preview, func() {
+		vm.PrintFeePreview(preview)
+	})
+}
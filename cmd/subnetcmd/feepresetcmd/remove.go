@@ -0,0 +1,44 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+// odyssey subnet fee-preset remove
+func newRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove [presetName]",
+		Short: "Remove a saved Subnet-EVM fee preset",
+		Long: `The subnet fee-preset remove command removes a fee preset saved with subnet fee-preset
+save. Subnets created with the preset keep their fee config.`,
+		RunE:         removePreset,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+}
+
+func removePreset(_ *cobra.Command, args []string) error {
+	presetName := args[0]
+	if _, ok := vm.GetBuiltinFeePresets()[presetName]; ok {
+		return fmt.Errorf("built-in preset %s can't be removed", presetName)
+	}
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := feePresetsConfig.Presets[presetName]; !ok {
+		return fmt.Errorf("preset %s not found", presetName)
+	}
+	delete(feePresetsConfig.Presets, presetName)
+	if err := app.WriteFeePresetsConfig(feePresetsConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Fee preset %s removed", presetName)
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package feepresetcmd
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/subnet-evm/params"
+	"github.com/spf13/cobra"
+)
+
+var (
+	forceSave bool
+
+	presetNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+)
+
+// odyssey subnet fee-preset save
+func newSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save [presetName]",
+		Short: "Save a Subnet-EVM fee config as a preset",
+		Long: `The subnet fee-preset save command saves a Subnet-EVM fee config under presetName.
+
+The fee config is read from the JSON file of --fee-config, which must have all the
+fields of the fee config, or taken from the genesis of the Subnet of --subnet. Without
+them, the fee config is set with the prompts of the create wizard.
+
+Existing presets are only overwritten with --force.`,
+		RunE:         savePreset,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	addFeeConfigSourceFlags(cmd)
+	cmd.Flags().BoolVarP(&forceSave, "force", "f", false, "overwrite the preset if it exists")
+	return cmd
+}
+
+func savePreset(_ *cobra.Command, args []string) error {
+	presetName := args[0]
+	if !presetNameRegexp.MatchString(presetName) {
+		return fmt.Errorf("invalid preset name %q: only letters, digits, '-' and '_' are allowed", presetName)
+	}
+	if _, ok := vm.GetBuiltinFeePresets()[presetName]; ok {
+		return fmt.Errorf("preset name %s is reserved for a built-in preset", presetName)
+	}
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := feePresetsConfig.Presets[presetName]; ok && !forceSave {
+		return fmt.Errorf("preset %s already exists. Use --force to overwrite it", presetName)
+	}
+	feeConfig, err := getFeeConfigFromFlags()
+	if err != nil {
+		return err
+	}
+	if feeConfig == nil {
+		chainConfig, direction, err := vm.GetFeeConfig(params.ChainConfig{}, app, false)
+		if err != nil {
+			return err
+		}
+		if direction != statemachine.Forward {
+			return errors.New("aborted by user")
+		}
+		feeConfig = &chainConfig.FeeConfig
+	}
+	if err := feeConfig.Verify(); err != nil {
+		return fmt.Errorf("invalid fee config: %w", err)
+	}
+	feePresetsConfig.Presets[presetName] = *feeConfig
+	if err := app.WriteFeePresetsConfig(feePresetsConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Fee preset %s saved. Use subnet create --fee-preset %s to use it", presetName, presetName)
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package genesiscmd
+
+import (
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// odyssey subnet genesis diff
+func newDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [subnetName|genesisFile] [subnetName|genesisFile]",
+		Short: "Compare two Subnet-EVM genesis",
+		Long: `The subnet genesis diff command prints the differences between two Subnet-EVM
+genesis, as the settings added, removed or changed from the first one to the second one.
+
+The genesis are compared by value: key order, number formats and address cases are not
+differences. Lists of addresses, as the allow list admins, are compared as sets. Balances
+are shown in wei, and contract code by its size and hash.`,
+		RunE:         diffGenesis,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+	}
+}
+
+func diffGenesis(_ *cobra.Command, args []string) error {
+	genesisA, err := loadGenesis(args[0])
+	if err != nil {
+		return err
+	}
+	genesisB, err := loadGenesis(args[1])
+	if err != nil {
+		return err
+	}
+	changes, err := vm.DiffGenesis(genesisA, genesisB)
+	if err != nil {
+		return err
+	}
+	return ux.Render(changes, func() {
+		if len(changes) == 0 {
+			ux.Logger.PrintToUser("No differences between %s and %s", args[0], args[1])
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Path", "Change", args[0], args[1]})
+		table.SetRowLine(true)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, change := range changes {
+			table.Append([]string{change.Path, change.Change, change.Old, change.New})
+		}
+		table.Render()
+	})
+}
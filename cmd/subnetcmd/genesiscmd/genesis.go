@@ -0,0 +1,60 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package genesiscmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/spf13/cobra"
+)
+
+var app *application.Odyssey
+
+// odyssey subnet genesis
+func NewCmd(injectedApp *application.Odyssey) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Check and compare Subnet-EVM genesis files",
+		Long: `The subnet genesis command suite provides tools to check Subnet-EVM genesis files
+before deploying them, and to compare them.
+
+Genesis files are given by the name of the Subnet they belong to, or by their path.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			if err != nil {
+				fmt.Println(err)
+			}
+		},
+	}
+	app = injectedApp
+	// subnet genesis lint
+	cmd.AddCommand(newLintCmd())
+	// subnet genesis diff
+	cmd.AddCommand(newDiffCmd())
+	return cmd
+}
+
+// loadGenesis loads the Subnet-EVM genesis of the subnet named [subnetNameOrPath],
+// or else the one at path [subnetNameOrPath]
+func loadGenesis(subnetNameOrPath string) (*core.Genesis, error) {
+	genesisPath := subnetNameOrPath
+	if app.GenesisExists(subnetNameOrPath) {
+		genesisPath = app.GetGenesisPath(subnetNameOrPath)
+	} else if !utils.FileExists(subnetNameOrPath) {
+		return nil, fmt.Errorf("no subnet or genesis file named %s", subnetNameOrPath)
+	}
+	genesisBytes, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, err
+	}
+	var genesis core.Genesis
+	if err := json.Unmarshal(genesisBytes, &genesis); err != nil {
+		return nil, fmt.Errorf("%s is not a Subnet-EVM genesis: %w", subnetNameOrPath, err)
+	}
+	return &genesis, nil
+}
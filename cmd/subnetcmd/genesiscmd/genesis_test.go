@@ -0,0 +1,51 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package genesiscmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesisPath = "../../../tests/e2e/assets/test_subnet_evm_genesis.json"
+
+func createTestSubnet(t *testing.T, subnetName string) {
+	require := require.New(t)
+	genesisBytes, err := os.ReadFile(testGenesisPath)
+	require.NoError(err)
+	require.NoError(app.WriteGenesisFile(subnetName, genesisBytes))
+	require.NoError(app.CreateSidecar(&models.Sidecar{Name: subnetName, VM: models.SubnetEvm, Subnet: subnetName}))
+}
+
+func TestLintGenesis(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+
+	// the test genesis only funds the default address
+	require.NoError(lintGenesis(nil, []string{testGenesisPath}))
+
+	createTestSubnet(t, "subnetA")
+	require.NoError(lintGenesis(nil, []string{"subnetA"}))
+
+	createTestSubnet(t, "subnetB")
+	require.EqualError(lintGenesis(nil, []string{"subnetB"}), "found 1 errors in subnetB")
+	knownChainIDs, err := getKnownChainIDs("subnetB")
+	require.NoError(err)
+	require.Equal("subnet subnetA", knownChainIDs[99999])
+
+	require.ErrorContains(lintGenesis(nil, []string{"unknown"}), "no subnet or genesis file named unknown")
+}
+
+func TestDiffGenesis(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	createTestSubnet(t, "subnetA")
+
+	require.NoError(diffGenesis(nil, []string{"subnetA", testGenesisPath}))
+	require.NoError(diffGenesis(nil, []string{"subnetA", "../../../tests/e2e/assets/test_subnet_evm_genesis_2.json"}))
+	require.ErrorContains(diffGenesis(nil, []string{"subnetA", "../../../tests/e2e/assets/test_key.pk"}), "is not a Subnet-EVM genesis")
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package genesiscmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// odyssey subnet genesis lint
+func newLintCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lint [subnetName|genesisFile]",
+		Short: "Check a Subnet-EVM genesis for mistakes",
+		Long: `The subnet genesis lint command checks a Subnet-EVM genesis for mistakes that make
+the chain unusable or unsafe:
+
+- chain IDs already used by public chains or by other Subnets of this machine
+- invalid or risky fee configs, as a target gas below the block gas limit, or a zero
+  min base fee
+- allocations without any balance, funding the public default address, or exceeding
+  the maximum supply
+- allow lists that lock every address out, as a transaction allow list whose
+  addresses have no balance, and precompile admins without funds
+
+The command fails if any error is found. Warnings are reported without failing.`,
+		RunE:         lintGenesis,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+}
+
+func lintGenesis(_ *cobra.Command, args []string) error {
+	genesis, err := loadGenesis(args[0])
+	if err != nil {
+		return err
+	}
+	knownChainIDs, err := getKnownChainIDs(args[0])
+	if err != nil {
+		return err
+	}
+	findings := vm.LintGenesis(genesis, knownChainIDs)
+	err = ux.Render(findings, func() {
+		if len(findings) == 0 {
+			ux.Logger.PrintToUser("No issues found in %s", args[0])
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Severity", "Rule", "Message"})
+		table.SetRowLine(true)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, finding := range findings {
+			table.Append([]string{finding.Severity, finding.Rule, finding.Message})
+		}
+		table.Render()
+	})
+	if err != nil {
+		return err
+	}
+	errorsCount := 0
+	for _, finding := range findings {
+		if finding.Severity == vm.LintError {
+			errorsCount++
+		}
+	}
+	if errorsCount > 0 {
+		return fmt.Errorf("found %d errors in %s", errorsCount, args[0])
+	}
+	return nil
+}
+
+// getKnownChainIDs returns the chain IDs of the public chains and of the Subnet-EVM
+// subnets of this machine, but [subnetName]
+func getKnownChainIDs(subnetName string) (map[uint64]string, error) {
+	knownChainIDs := map[uint64]string{}
+	for chainID, chainName := range vm.KnownChainIDs {
+		knownChainIDs[chainID] = chainName
+	}
+	sidecarNames, err := app.GetSidecarNames()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return knownChainIDs, nil
+		}
+		return nil, err
+	}
+	for _, sidecarName := range sidecarNames {
+		if sidecarName == subnetName {
+			continue
+		}
+		sc, err := app.LoadSidecar(sidecarName)
+		if err != nil || sc.VM != models.SubnetEvm {
+			continue
+		}
+		genesis, err := app.LoadEvmGenesis(sidecarName)
+		if err != nil || genesis.Config == nil || genesis.Config.ChainID == nil || !genesis.Config.ChainID.IsUint64() {
+			continue
+		}
+		knownChainIDs[genesis.Config.ChainID.Uint64()] = "subnet " + sidecarName
+	}
+	return knownChainIDs, nil
+}
@@ -8,15 +8,28 @@ import (
 
 	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 	"golang.org/x/exp/slices"
 )
 
+const networkPrompt = "Choose a network for the operation"
+
+// networkFlags are the flags used to select each network kind
+var networkFlags = map[models.NetworkKind]string{
+	models.Local:   "--local",
+	models.Devnet:  "--devnet",
+	models.Testnet: "--testnet",
+	models.Mainnet: "--mainnet",
+	models.Custom:  "--network",
+}
+
 func fillNetworkDetails(network *models.Network) error {
 	if network.Endpoint == "" {
-		endpoint, err := app.Prompt.CaptureString(fmt.Sprintf("%s Network Endpoint", network.Name()))
+		endpointPrompt := fmt.Sprintf("%s Network Endpoint", network.Name())
+		endpoint, err := app.Prompt.CaptureString(endpointPrompt)
 		if err != nil {
-			return err
+			return prompts.WithFlagHint(err, "--endpoint")
 		}
 		network.Endpoint = endpoint
 	}
@@ -28,6 +41,7 @@ func GetNetworkFromCmdLineFlags(
 	useDevnet bool,
 	useTestnet bool,
 	useMainnet bool,
+	networkName string,
 	endpoint string,
 	askForDevnetEndpoint bool,
 	supportedNetworkKinds []models.NetworkKind,
@@ -43,20 +57,25 @@ func GetNetworkFromCmdLineFlags(
 		network = models.TestnetNetwork
 	case useMainnet:
 		network = models.MainnetNetwork
+	case networkName != "":
+		var err error
+		network, err = models.GetNetworkByName(networkName)
+		if err != nil {
+			return models.UndefinedNetwork, err
+		}
 	}
 
 	if endpoint != "" {
 		network.Endpoint = endpoint
 	}
 
+	supportedNetworksFlags := utils.Map(supportedNetworkKinds, func(n models.NetworkKind) string { return networkFlags[n] })
+
 	// no flag was set, prompt user
 	if network.Kind == models.Undefined {
-		networkStr, err := app.Prompt.CaptureList(
-			"Choose a network for the operation",
-			utils.Map(supportedNetworkKinds, func(n models.NetworkKind) string { return n.String() }),
-		)
+		networkStr, err := app.Prompt.CaptureList(networkPrompt, models.GetNetworkNames(supportedNetworkKinds))
 		if err != nil {
-			return models.UndefinedNetwork, err
+			return models.UndefinedNetwork, prompts.WithFlagHint(err, supportedNetworksFlags...)
 		}
 		network = models.NetworkFromString(networkStr)
 		if askForDevnetEndpoint {
@@ -67,23 +86,14 @@ func GetNetworkFromCmdLineFlags(
 		return network, nil
 	}
 
-	// for err messages
-	networkFlags := map[models.NetworkKind]string{
-		models.Local:   "--local",
-		models.Devnet:  "--devnet",
-		models.Testnet: "--testnet",
-		models.Mainnet: "--mainnet",
-	}
-	supportedNetworksFlags := strings.Join(utils.Map(supportedNetworkKinds, func(n models.NetworkKind) string { return networkFlags[n] }), ", ")
-
 	// unsupported network
 	if !slices.Contains(supportedNetworkKinds, network.Kind) {
-		return models.UndefinedNetwork, fmt.Errorf("network flag %s is not supported. use one of %s", networkFlags[network.Kind], supportedNetworksFlags)
+		return models.UndefinedNetwork, fmt.Errorf("network flag %s is not supported. use one of %s", networkFlags[network.Kind], strings.Join(supportedNetworksFlags, ", "))
 	}
 
 	// not mutually exclusive flag selection
-	if !flags.EnsureMutuallyExclusive([]bool{useLocal, useDevnet, useTestnet, useMainnet}) {
-		return models.UndefinedNetwork, fmt.Errorf("network flags %s are mutually exclusive", supportedNetworksFlags)
+	if !flags.EnsureMutuallyExclusive([]bool{useLocal, useDevnet, useTestnet, useMainnet, networkName != ""}) {
+		return models.UndefinedNetwork, fmt.Errorf("network flags %s are mutually exclusive", strings.Join(supportedNetworksFlags, ", "))
 	}
 	if askForDevnetEndpoint {
 		if err := fillNetworkDetails(&network); err != nil {
@@ -0,0 +1,54 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package subnetcmd
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+var testCustomNetwork = models.CustomNetwork{
+	Name:      "private",
+	NetworkID: 4242,
+	Endpoint:  "http://10.0.0.1:9650",
+	HRP:       "private",
+}
+
+func TestGetNetworkFromCmdLineFlagsCustomNetwork(t *testing.T) {
+	require := require.New(t)
+	app = testutils.SetupTestInTempDir(t)
+	models.SetCustomNetworks(map[string]models.CustomNetwork{testCustomNetwork.Name: testCustomNetwork})
+	t.Cleanup(func() { models.SetCustomNetworks(nil) })
+	supportedNetworkKinds := []models.NetworkKind{models.Local, models.Testnet, models.Custom}
+
+	network, err := GetNetworkFromCmdLineFlags(false, false, false, false, "private", "", false, supportedNetworkKinds)
+	require.NoError(err)
+	require.Equal(testCustomNetwork.Network(), network)
+
+	network, err = GetNetworkFromCmdLineFlags(false, false, false, false, "private", "http://10.0.0.2:9650", false, supportedNetworkKinds)
+	require.NoError(err)
+	require.Equal("http://10.0.0.2:9650", network.Endpoint)
+
+	_, err = GetNetworkFromCmdLineFlags(false, false, false, false, "unknown", "", false, supportedNetworkKinds)
+	require.ErrorContains(err, "network unknown not found")
+
+	_, err = GetNetworkFromCmdLineFlags(false, false, true, false, "private", "", false, supportedNetworkKinds)
+	require.ErrorContains(err, "mutually exclusive")
+
+	_, err = GetNetworkFromCmdLineFlags(false, false, false, false, "private", "", false, []models.NetworkKind{models.Testnet})
+	require.ErrorContains(err, "network flag --network is not supported")
+
+	// registered networks are prompted for in place of the custom kind
+	mockPrompt := &mocks.Prompter{}
+	mockPrompt.On("CaptureList", networkPrompt, []string{models.Local.String(), models.Testnet.String(), "private"}).
+		Return("private", nil)
+	app.Prompt = mockPrompt
+	network, err = GetNetworkFromCmdLineFlags(false, false, false, false, "", "", false, supportedNetworkKinds)
+	require.NoError(err)
+	require.Equal(testCustomNetwork.Network(), network)
+	mockPrompt.AssertExpectations(t)
+}
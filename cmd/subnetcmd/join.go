@@ -50,7 +50,7 @@ var (
 	stakeAmount uint64
 
 	errNoBlockchainID                      = errors.New("failed to find the blockchain ID for this subnet, has it been deployed/created on this network?")
-	errMutuallyExclusiveNetworksWithDevnet = errors.New("--local, --devnet, --testnet and --mainnet are mutually exclusive")
+	errMutuallyExclusiveNetworksWithDevnet = errors.New("--local, --devnet, --testnet, --mainnet and --network are mutually exclusive")
 )
 
 // odyssey subnet join
@@ -71,7 +71,8 @@ After you update your validator's config, you need to restart your validator man
 you provide the --odysseygo-config flag, this command attempts to edit the config file
 at that path.
 
-This command currently only supports Subnets deployed on the Testnet and Mainnet.`,
+This command currently only supports Subnets deployed on the Testnet, Mainnet and on
+networks registered with network add.`,
 		RunE: joinCmd,
 		Args: cobra.ExactArgs(1),
 	}
@@ -82,6 +83,7 @@ This command currently only supports Subnets deployed on the Testnet and Mainnet
 	cmd.Flags().BoolVar(&deployLocal, "local", false, "join on `local` (for elastic subnet only)")
 	cmd.Flags().BoolVar(&deployDevnet, "devnet", false, "join on `devnet`")
 	cmd.Flags().BoolVar(&deployMainnet, "mainnet", false, "join on `mainnet`")
+	cmd.Flags().StringVar(&deployNetworkName, "network", "", "join on the given network, registered with network add")
 	cmd.Flags().BoolVar(&printManual, "print", false, "if true, print the manual config without prompting")
 	cmd.Flags().StringVar(&nodeIDStr, "nodeID", "", "set the NodeID of the validator to check")
 	cmd.Flags().BoolVar(&forceWrite, "force-write", false, "if true, skip to prompt to overwrite the config file")
@@ -112,7 +114,7 @@ func joinCmd(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	if !flags.EnsureMutuallyExclusive([]bool{deployMainnet, deployTestnet, deployLocal, deployDevnet}) {
+	if !flags.EnsureMutuallyExclusive([]bool{deployMainnet, deployTestnet, deployLocal, deployDevnet, deployNetworkName != ""}) {
 		return errMutuallyExclusiveNetworksWithDevnet
 	}
 
@@ -126,6 +128,11 @@ func joinCmd(_ *cobra.Command, args []string) error {
 		network = models.TestnetNetwork
 	case deployMainnet:
 		network = models.MainnetNetwork
+	case deployNetworkName != "":
+		network, err = models.GetNetworkByName(deployNetworkName)
+		if err != nil {
+			return err
+		}
 	}
 
 	if network.Kind == models.Undefined {
@@ -145,7 +152,7 @@ func joinCmd(_ *cobra.Command, args []string) error {
 		} else {
 			networkStr, err := app.Prompt.CaptureList(
 				"Choose a network to validate on (this command only supports public networks)",
-				[]string{models.Testnet.String(), models.Mainnet.String()},
+				models.GetNetworkNames([]models.NetworkKind{models.Testnet, models.Mainnet, models.Custom}),
 			)
 			if err != nil {
 				return err
@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/olekukonko/tablewriter"
@@ -36,6 +37,34 @@ shows additional information including the VMID, BlockchainID and SubnetID.`,
 	return cmd
 }
 
+// subnetListItem is the --output json|yaml schema of subnet list
+type subnetListItem struct {
+	Subnet    string `json:"subnet" yaml:"subnet"`
+	Chain     string `json:"chain" yaml:"chain"`
+	ChainID   string `json:"chainID" yaml:"chainID"`
+	VMID      string `json:"vmID" yaml:"vmID"`
+	VM        string `json:"type" yaml:"type"`
+	VMVersion string `json:"vmVersion" yaml:"vmVersion"`
+	FromRepo  bool   `json:"fromRepo" yaml:"fromRepo"`
+}
+
+// subnetDeployInfo is the deploy information of a subnet on a public network
+type subnetDeployInfo struct {
+	SubnetID     string `json:"subnetID" yaml:"subnetID"`
+	BlockchainID string `json:"blockchainID" yaml:"blockchainID"`
+}
+
+// subnetDeployedListItem is the --output json|yaml schema of subnet list --deployed.
+// Testnet and Mainnet are omitted if the subnet was not deployed there.
+type subnetDeployedListItem struct {
+	Subnet        string            `json:"subnet" yaml:"subnet"`
+	Chain         string            `json:"chain" yaml:"chain"`
+	VMID          string            `json:"vmID" yaml:"vmID"`
+	LocalDeployed bool              `json:"localNetwork" yaml:"localNetwork"`
+	Testnet       *subnetDeployInfo `json:"testnet,omitempty" yaml:"testnet,omitempty"`
+	Mainnet       *subnetDeployInfo `json:"mainnet,omitempty" yaml:"mainnet,omitempty"`
+}
+
 type subnetMatrix [][]string
 
 func (c subnetMatrix) Len() int {
@@ -63,6 +92,7 @@ func listSubnets(cmd *cobra.Command, args []string) error {
 	table.SetRowLine(true)
 
 	rows := subnetMatrix{}
+	items := []subnetListItem{}
 
 	cars, err := getSidecars(app)
 	if err != nil {
@@ -98,13 +128,24 @@ func listSubnets(cmd *cobra.Command, args []string) error {
 			sc.VMVersion,
 			strconv.FormatBool(sc.ImportedFromOPM),
 		})
+		items = append(items, subnetListItem{
+			Subnet:    sc.Subnet,
+			Chain:     sc.Name,
+			ChainID:   chainID,
+			VMID:      vmID,
+			VM:        string(sc.VM),
+			VMVersion: sc.VMVersion,
+			FromRepo:  sc.ImportedFromOPM,
+		})
 	}
 	sort.Sort(rows)
-	for _, row := range rows {
-		table.Append(row)
-	}
-	table.Render()
-	return nil
+	sort.Slice(items, func(i, j int) bool { return items[i].Subnet < items[j].Subnet })
+	return ux.Render(items, func() {
+		for _, row := range rows {
+			table.Append(row)
+		}
+		table.Render()
+	})
 }
 
 func getSidecars(app *application.Odyssey) ([]*models.Sidecar, error) {
@@ -148,6 +189,7 @@ func listDeployInfo(*cobra.Command, []string) error {
 	table.SetRowLine(true)
 
 	rows := subnetMatrix{}
+	items := []subnetDeployedListItem{}
 
 	deployedNames, err := subnet.GetLocallyDeployedSubnets()
 	if err != nil {
@@ -222,13 +264,34 @@ func listDeployInfo(*cobra.Command, []string) error {
 				netToID[mainKey][1],
 			})
 		}
-	}
 
-	sort.Sort(rows)
-	for _, row := range rows {
-		table.Append(row)
+		item := subnetDeployedListItem{
+			Subnet:        sc.Subnet,
+			Chain:         sc.Name,
+			VMID:          vmID,
+			LocalDeployed: deployedLocal == constants.YesLabel,
+		}
+		if network, ok := sc.Networks[testnetKey]; ok && network.SubnetID != ids.Empty {
+			item.Testnet = &subnetDeployInfo{
+				SubnetID:     network.SubnetID.String(),
+				BlockchainID: network.BlockchainID.String(),
+			}
+		}
+		if network, ok := sc.Networks[mainKey]; ok && network.SubnetID != ids.Empty {
+			item.Mainnet = &subnetDeployInfo{
+				SubnetID:     network.SubnetID.String(),
+				BlockchainID: network.BlockchainID.String(),
+			}
+		}
+		items = append(items, item)
 	}
-	table.Render()
 
-	return nil
+	sort.Sort(rows)
+	sort.Slice(items, func(i, j int) bool { return items[i].Subnet < items[j].Subnet })
+	return ux.Render(items, func() {
+		for _, row := range rows {
+			table.Append(row)
+		}
+		table.Render()
+	})
 }
@@ -0,0 +1,84 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package precompilecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+// odyssey subnet precompile add
+func newAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add [descriptorFile]",
+		Short: "Register a custom precompile descriptor",
+		Long: `The subnet precompile add command registers the precompile described by the JSON
+file descriptorFile. For example:
+
+{
+  "name": "Hello World",
+  "configKey": "helloWorldConfig",
+  "address": "0x0300000000000000000000000000000000000000",
+  "description": "Greets the callers",
+  "schema": {
+    "type": "object",
+    "properties": {
+      "greeting": {"type": "string", "maxLength": 32},
+      "adminAddresses": {"type": "array"},
+      "enabledAddresses": {"type": "array"}
+    },
+    "required": ["greeting"]
+  },
+  "prompts": [
+    {"key": "greeting", "prompt": "Enter the greeting", "type": "string"},
+    {"type": "allowList", "optional": true}
+  ]
+}
+
+The address must be in a range reserved for precompiles, and the config key and
+address can't be used by other precompiles. The schema uses the type, properties,
+required, additionalProperties, items, enum, minimum, maximum, minLength,
+maxLength, pattern, minItems and maxItems keywords of JSON schema. The prompt types
+are string, bool, uint64, bigint, address, addresses and allowList, which sets the
+adminAddresses and enabledAddresses params.`,
+		RunE:         addPrecompile,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+}
+
+func addPrecompile(_ *cobra.Command, args []string) error {
+	descriptorBytes, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var descriptor models.PrecompileDescriptor
+	if err := json.Unmarshal(descriptorBytes, &descriptor); err != nil {
+		return fmt.Errorf("invalid precompile descriptor %s: %w", args[0], err)
+	}
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := customPrecompilesConfig.Precompiles[descriptor.ConfigKey]; ok {
+		return fmt.Errorf("precompile %s is already registered", descriptor.ConfigKey)
+	}
+	if err := vm.ValidatePrecompileDescriptor(descriptor); err != nil {
+		return fmt.Errorf("invalid precompile descriptor %s: %w", args[0], err)
+	}
+	customPrecompilesConfig.Precompiles[descriptor.ConfigKey] = descriptor
+	if err := vm.SetCustomPrecompiles(customPrecompilesConfig.Precompiles); err != nil {
+		return err
+	}
+	if err := app.WriteCustomPrecompilesConfig(customPrecompilesConfig); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Precompile %s registered with config key %s", descriptor.Name, descriptor.ConfigKey)
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package precompilecmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type precompileInfo struct {
+	Name        string   `json:"name" yaml:"name"`
+	ConfigKey   string   `json:"configKey" yaml:"configKey"`
+	Address     string   `json:"address" yaml:"address"`
+	Params      []string `json:"params" yaml:"params"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// odyssey subnet precompile list
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the registered custom precompiles",
+		Long: `The subnet precompile list command lists the precompiles registered with
+subnet precompile add, with their config key, address and the params set by their prompts.`,
+		RunE:         listPrecompiles,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+	}
+}
+
+func listPrecompiles(*cobra.Command, []string) error {
+	precompileInfos := []precompileInfo{}
+	for _, descriptor := range vm.GetCustomPrecompileDescriptors() {
+		params := []string{}
+		for _, prompt := range descriptor.Prompts {
+			if prompt.Type == vm.PrecompilePromptAllowList {
+				params = append(params, "adminAddresses", "enabledAddresses")
+				continue
+			}
+			params = append(params, prompt.Key)
+		}
+		precompileInfos = append(precompileInfos, precompileInfo{
+			Name:        descriptor.Name,
+			ConfigKey:   descriptor.ConfigKey,
+			Address:     descriptor.Address,
+			Params:      params,
+			Description: descriptor.Description,
+		})
+	}
+	return ux.Render(precompileInfos, func() {
+		if len(precompileInfos) == 0 {
+			ux.Logger.PrintToUser("No precompiles registered. Use subnet precompile add to register one")
+			return
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Config Key", "Address", "Params"})
+		table.SetRowLine(true)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, info := range precompileInfos {
+			table.Append([]string{
+				info.Name,
+				info.ConfigKey,
+				info.Address,
+				strings.Join(info.Params, ", "),
+			})
+		}
+		table.Render()
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package precompilecmd
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/spf13/cobra"
+)
+
+var app *application.Odyssey
+
+// odyssey subnet precompile
+func NewCmd(injectedApp *application.Odyssey) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompile",
+		Short: "Register the custom precompiles of Subnet-EVM forks",
+		Long: `The subnet precompile command suite registers descriptors of stateful precompiles
+added by forks of Subnet-EVM, so that they can be configured by subnet create,
+subnet upgrade generate and genesis imports, as the built-in precompiles.
+
+A descriptor is a JSON file with the name of the precompile, its config key and
+address, an optional JSON schema of its params and the prompts that set them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := cmd.Help()
+			if err != nil {
+				fmt.Println(err)
+			}
+		},
+	}
+	app = injectedApp
+	// subnet precompile add
+	cmd.AddCommand(newAddCmd())
+	// subnet precompile list
+	cmd.AddCommand(newListCmd())
+	// subnet precompile remove
+	cmd.AddCommand(newRemoveCmd())
+	return cmd
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package precompilecmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/testutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testConfigKey  = "helloWorldConfig"
+	testDescriptor = `{
+	"name": "Hello World",
+	"configKey": "helloWorldConfig",
+	"address": "0x0300000000000000000000000000000000000000",
+	"schema": {"type": "object", "properties": {"greeting": {"type": "string"}}, "required": ["greeting"]},
+	"prompts": [{"key": "greeting", "type": "string"}]
+}`
+)
+
+func setupPrecompilesTest(t *testing.T) string {
+	app = testutils.SetupTestInTempDir(t)
+	ux.NewUserLog(logging.NoLog{}, os.Stdout)
+	t.Cleanup(func() { require.NoError(t, vm.SetCustomPrecompiles(nil)) })
+	forceRemove = false
+	descriptorPath := filepath.Join(t.TempDir(), "descriptor.json")
+	require.NoError(t, os.WriteFile(descriptorPath, []byte(testDescriptor), 0o600))
+	return descriptorPath
+}
+
+func TestAddPrecompile(t *testing.T) {
+	require := require.New(t)
+	descriptorPath := setupPrecompilesTest(t)
+
+	require.NoError(addPrecompile(nil, []string{descriptorPath}))
+
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	require.NoError(err)
+	require.Contains(customPrecompilesConfig.Precompiles, testConfigKey)
+	descriptor, ok := vm.GetCustomPrecompileDescriptorByName("Hello World")
+	require.True(ok)
+	require.Equal(testConfigKey, descriptor.ConfigKey)
+	require.Contains(vm.GetPrecompileNames(), "Hello World")
+	require.Equal(testConfigKey, vm.PrecompileToUpgradeString("Hello World"))
+
+	require.EqualError(addPrecompile(nil, []string{descriptorPath}), "precompile helloWorldConfig is already registered")
+}
+
+func TestAddPrecompileInvalid(t *testing.T) {
+	require := require.New(t)
+	descriptorPath := setupPrecompilesTest(t)
+	require.NoError(os.WriteFile(descriptorPath, []byte(`{"name": "Fee Tweaker", "configKey": "feeManagerConfig", "address": "0x0300000000000000000000000000000000000001"}`), 0o600))
+
+	require.ErrorContains(addPrecompile(nil, []string{descriptorPath}), "config key feeManagerConfig is used by a built-in precompile")
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	require.NoError(err)
+	require.Empty(customPrecompilesConfig.Precompiles)
+}
+
+func TestRemovePrecompile(t *testing.T) {
+	require := require.New(t)
+	descriptorPath := setupPrecompilesTest(t)
+	require.NoError(addPrecompile(nil, []string{descriptorPath}))
+
+	const subnetName = "hello"
+	genesisBytes := []byte(`{"config": {"chainId": 888888, "helloWorldConfig": {"blockTimestamp": 0, "greeting": "hi"}}}`)
+	require.NoError(app.WriteGenesisFile(subnetName, genesisBytes))
+	require.NoError(app.CreateSidecar(&models.Sidecar{Name: subnetName, VM: models.SubnetEvm, Subnet: subnetName}))
+
+	require.EqualError(removePrecompile(nil, []string{testConfigKey}),
+		"subnets hello configure precompile helloWorldConfig in their genesis. Use --force to remove it anyway")
+	forceRemove = true
+	require.NoError(removePrecompile(nil, []string{testConfigKey}))
+
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	require.NoError(err)
+	require.Empty(customPrecompilesConfig.Precompiles)
+	require.Empty(vm.GetCustomPrecompileDescriptors())
+	require.EqualError(removePrecompile(nil, []string{testConfigKey}), "precompile helloWorldConfig not found")
+}
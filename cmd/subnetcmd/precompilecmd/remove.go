@@ -0,0 +1,96 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package precompilecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odyssey-cli/pkg/vm"
+	"github.com/spf13/cobra"
+)
+
+var forceRemove bool
+
+// odyssey subnet precompile remove
+func newRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove [configKey]",
+		Short: "Remove a registered custom precompile",
+		Long: `The subnet precompile remove command removes the precompile registered with
+subnet precompile add under configKey.
+
+The configs of the precompile can't be read anymore once it is removed, and are
+dropped when the genesis files that have them are read. Because of this, precompiles
+configured in the genesis of a Subnet are only removed with --force.`,
+		RunE:         removePrecompile,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+	cmd.Flags().BoolVar(&forceRemove, "force", false, "remove the precompile even if Subnets use it")
+	return cmd
+}
+
+func removePrecompile(_ *cobra.Command, args []string) error {
+	configKey := args[0]
+	customPrecompilesConfig, err := app.LoadCustomPrecompilesConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := customPrecompilesConfig.Precompiles[configKey]; !ok {
+		return fmt.Errorf("precompile %s not found", configKey)
+	}
+	subnets, err := getSubnetsUsingPrecompile(configKey)
+	if err != nil {
+		return err
+	}
+	if len(subnets) > 0 && !forceRemove {
+		return fmt.Errorf("subnets %s configure precompile %s in their genesis. Use --force to remove it anyway",
+			strings.Join(subnets, ", "), configKey)
+	}
+	delete(customPrecompilesConfig.Precompiles, configKey)
+	if err := app.WriteCustomPrecompilesConfig(customPrecompilesConfig); err != nil {
+		return err
+	}
+	if err := vm.SetCustomPrecompiles(customPrecompilesConfig.Precompiles); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("Precompile %s removed", configKey)
+	return nil
+}
+
+// getSubnetsUsingPrecompile returns the names of the Subnet-EVM subnets whose genesis
+// configure the precompile with [configKey]
+func getSubnetsUsingPrecompile(configKey string) ([]string, error) {
+	sidecarNames, err := app.GetSidecarNames()
+	if err != nil {
+		return nil, err
+	}
+	subnets := []string{}
+	for _, sidecarName := range sidecarNames {
+		sc, err := app.LoadSidecar(sidecarName)
+		if err != nil {
+			return nil, err
+		}
+		if sc.VM != models.SubnetEvm || !app.GenesisExists(sidecarName) {
+			continue
+		}
+		genesisBytes, err := app.LoadRawGenesis(sidecarName)
+		if err != nil {
+			return nil, err
+		}
+		var genesis struct {
+			Config map[string]json.RawMessage `json:"config"`
+		}
+		if err := json.Unmarshal(genesisBytes, &genesis); err != nil {
+			return nil, fmt.Errorf("invalid genesis of subnet %s: %w", sidecarName, err)
+		}
+		if _, ok := genesis.Config[configKey]; ok {
+			subnets = append(subnets, sidecarName)
+		}
+	}
+	return subnets, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/api/info"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -22,6 +23,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const statsNetworkPrompt = "Choose a network from which you want to get the statistics (this command only supports public networks)"
+
 // odyssey subnet stats
 func newStatsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -34,6 +37,8 @@ func newStatsCmd() *cobra.Command {
 	}
 	cmd.Flags().BoolVar(&deployTestnet, "testnet", false, "print stats on `testnet`")
 	cmd.Flags().BoolVar(&deployMainnet, "mainnet", false, "print stats on `mainnet`")
+	cmd.Flags().StringVar(&deployNetworkName, "network", "", "print stats on the given network, registered with network add")
+	prompts.RegisterFlagHint(cmd, statsNetworkPrompt, "--testnet", "--mainnet", "--network")
 	return cmd
 }
 
@@ -44,23 +49,33 @@ func stats(_ *cobra.Command, args []string) error {
 		network = models.TestnetNetwork
 	case deployMainnet:
 		network = models.MainnetNetwork
+	case deployNetworkName != "":
+		var err error
+		network, err = models.GetNetworkByName(deployNetworkName)
+		if err != nil {
+			return err
+		}
 	}
 
 	if network.Kind == models.Undefined {
 		networkStr, err := app.Prompt.CaptureList(
-			"Choose a network from which you want to get the statistics (this command only supports public networks)",
-			[]string{models.Testnet.String(), models.Mainnet.String()},
+			statsNetworkPrompt,
+			models.GetNetworkNames([]models.NetworkKind{models.Testnet, models.Mainnet, models.Custom}),
 		)
 		if err != nil {
 			return err
 		}
 		// flag provided
-		networkStr = strings.Title(networkStr)
-		// as we are allowing a flag, we need to check if a supported network has been provided
-		if !(networkStr == models.Testnet.String() || networkStr == models.Mainnet.String()) {
-			return errors.New("unsupported network")
+		if customNetwork, ok := models.GetCustomNetwork(networkStr); ok {
+			network = customNetwork.Network()
+		} else {
+			networkStr = strings.Title(networkStr)
+			// as we are allowing a flag, we need to check if a supported network has been provided
+			if !(networkStr == models.Testnet.String() || networkStr == models.Mainnet.String()) {
+				return errors.New("unsupported network")
+			}
+			network = models.NetworkFromString(networkStr)
 		}
-		network = models.NetworkFromString(networkStr)
 	}
 
 	chains, err := ValidateSubnetNameAndGetChains(args)
@@ -84,33 +99,121 @@ func stats(_ *cobra.Command, args []string) error {
 		return errors.New("failed to create a client to an API endpoint")
 	}
 
-	table := tablewriter.NewWriter(os.Stdout)
-	rows, err := buildCurrentValidatorStats(oClient, infoClient, table, subnetID)
+	current, err := buildCurrentValidatorStats(oClient, infoClient, subnetID)
 	if err != nil {
 		return err
 	}
-	for _, row := range rows {
+	pending, err := buildPendingValidatorStats(oClient, infoClient, subnetID)
+	if err != nil {
+		return err
+	}
+
+	return ux.Render(subnetStats{Current: current, Pending: pending}, func() {
+		printCurrentValidatorStats(current)
+		printPendingValidatorStats(pending)
+	})
+}
+
+// subnetStats is the --output json|yaml schema of subnet stats
+type subnetStats struct {
+	Current []currentValidatorStat `json:"current" yaml:"current"`
+	Pending []pendingValidatorStat `json:"pending" yaml:"pending"`
+}
+
+// currentValidatorStat describes a validator already validating the subnet.
+// Connected is null if the API endpoint did not report it.
+// Weight includes the weight of the delegators.
+type currentValidatorStat struct {
+	NodeID    string    `json:"nodeID" yaml:"nodeID"`
+	Connected *bool     `json:"connected" yaml:"connected"`
+	Weight    uint64    `json:"weight" yaml:"weight"`
+	StartTime time.Time `json:"startTime" yaml:"startTime"`
+	EndTime   time.Time `json:"endTime" yaml:"endTime"`
+	VMVersion string    `json:"vmVersion" yaml:"vmVersion"`
+}
+
+// pendingValidatorStat describes a validator not yet validating the subnet.
+// Weight includes the weight of the pending delegators.
+type pendingValidatorStat struct {
+	NodeID    string    `json:"nodeID" yaml:"nodeID"`
+	Weight    uint64    `json:"weight" yaml:"weight"`
+	StartTime time.Time `json:"startTime" yaml:"startTime"`
+	EndTime   time.Time `json:"endTime" yaml:"endTime"`
+	VMVersion string    `json:"vmVersion" yaml:"vmVersion"`
+}
+
+func printCurrentValidatorStats(current []currentValidatorStat) {
+	ux.Logger.PrintToUser("Current validators (already validating the subnet)")
+	ux.Logger.PrintToUser("==================================================")
+
+	table := tablewriter.NewWriter(os.Stdout)
+	header := []string{"nodeID", "connected", "weight", "remaining", "vmversion"}
+	table.SetHeader(header)
+	table.SetAutoMergeCellsByColumnIndex([]int{0})
+	table.SetAutoMergeCells(true)
+	table.SetRowLine(true)
+	for _, row := range currentValidatorRows(current) {
 		table.Append(row)
 	}
 	table.Render()
+}
 
-	table = tablewriter.NewWriter(os.Stdout)
-	rows, err = buildPendingValidatorStats(oClient, infoClient, table, subnetID)
-	if err != nil {
-		return err
+func currentValidatorRows(current []currentValidatorStat) [][]string {
+	rows := [][]string{}
+	for _, v := range current {
+		// some members of the returned object are pointers
+		// so we need to check the pointer is actually valid
+		connected := constants.NotAvailableLabel
+		if v.Connected != nil {
+			connected = strconv.FormatBool(*v.Connected)
+		}
+		rows = append(rows, []string{
+			v.NodeID,
+			connected,
+			strconv.FormatUint(v.Weight, 10),
+			ux.FormatDuration(v.EndTime.Sub(v.StartTime)),
+			v.VMVersion,
+		})
 	}
+	return rows
+}
 
-	if len(rows) == 0 {
-		return nil
+func printPendingValidatorStats(pending []pendingValidatorStat) {
+	if len(pending) == 0 {
+		ux.Logger.PrintToUser("No pending validators found.")
+		return
 	}
-	for _, row := range rows {
+
+	ux.Logger.PrintToUser("Pending validators (not yet validating the subnet)")
+	ux.Logger.PrintToUser("==================================================")
+
+	table := tablewriter.NewWriter(os.Stdout)
+	header := []string{"nodeID", "weight", "start-time", "end-time", "vmversion"}
+	table.SetHeader(header)
+	table.SetAutoMergeCellsByColumnIndex([]int{0})
+	table.SetAutoMergeCells(true)
+	table.SetRowLine(true)
+	for _, row := range pendingValidatorRows(pending) {
 		table.Append(row)
 	}
 	table.Render()
-	return nil
 }
 
-func buildPendingValidatorStats(oClient omegavm.Client, infoClient info.Client, table *tablewriter.Table, subnetID ids.ID) ([][]string, error) {
+func pendingValidatorRows(pending []pendingValidatorStat) [][]string {
+	rows := [][]string{}
+	for _, v := range pending {
+		rows = append(rows, []string{
+			v.NodeID,
+			strconv.FormatUint(v.Weight, 10),
+			v.StartTime.Local().String(),
+			v.EndTime.Local().String(),
+			v.VMVersion,
+		})
+	}
+	return rows
+}
+
+func buildPendingValidatorStats(oClient omegavm.Client, infoClient info.Client, subnetID ids.ID) ([]pendingValidatorStat, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -136,26 +239,14 @@ func buildPendingValidatorStats(oClient omegavm.Client, infoClient info.Client,
 		}
 	}
 
-	rows := [][]string{}
+	stats := []pendingValidatorStat{}
 
 	if len(pendingValidators) == 0 {
-		ux.Logger.PrintToUser("No pending validators found.")
-		return rows, nil
+		return stats, nil
 	}
 
-	ux.Logger.PrintToUser("Pending validators (not yet validating the subnet)")
-	ux.Logger.PrintToUser("==================================================")
-
-	header := []string{"nodeID", "weight", "start-time", "end-time", "vmversion"}
-	table.SetHeader(header)
-	table.SetAutoMergeCellsByColumnIndex([]int{0})
-	table.SetAutoMergeCells(true)
-	table.SetRowLine(true)
-
 	var (
-		startTime, endTime          time.Time
 		localNodeID                 ids.NodeID
-		weight                      string
 		localVersionStr, versionStr string
 	)
 
@@ -170,14 +261,10 @@ func buildPendingValidatorStats(oClient omegavm.Client, infoClient info.Client,
 	}
 
 	for _, v := range pendingValidators {
-		startTime = time.Unix(int64(v.StartTime), 0)
-		endTime = time.Unix(int64(v.EndTime), 0)
-
-		uint64Weight := v.Weight
+		weight := v.Weight
 		for _, d := range pendingDelegators {
-			uint64Weight += d.Weight
+			weight += d.Weight
 		}
-		weight = strconv.FormatUint(uint64(uint64Weight), 10)
 
 		// if retrieval of localNodeID failed, it will be empty,
 		// and this comparison fails
@@ -185,19 +272,19 @@ func buildPendingValidatorStats(oClient omegavm.Client, infoClient info.Client,
 			versionStr = localVersionStr
 		}
 		// query peers for IP address of this NodeID...
-		rows = append(rows, []string{
-			v.NodeID.String(),
-			weight,
-			startTime.Local().String(),
-			endTime.Local().String(),
-			versionStr,
+		stats = append(stats, pendingValidatorStat{
+			NodeID:    v.NodeID.String(),
+			Weight:    uint64(weight),
+			StartTime: time.Unix(int64(v.StartTime), 0),
+			EndTime:   time.Unix(int64(v.EndTime), 0),
+			VMVersion: versionStr,
 		})
 	}
 
-	return rows, nil
+	return stats, nil
 }
 
-func buildCurrentValidatorStats(oClient omegavm.Client, infoClient info.Client, table *tablewriter.Table, subnetID ids.ID) ([][]string, error) {
+func buildCurrentValidatorStats(oClient omegavm.Client, infoClient info.Client, subnetID ids.ID) ([]currentValidatorStat, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -206,21 +293,11 @@ func buildCurrentValidatorStats(oClient omegavm.Client, infoClient info.Client,
 		return nil, fmt.Errorf("failed to query the API endpoint for the current validators: %w", err)
 	}
 
-	ux.Logger.PrintToUser("Current validators (already validating the subnet)")
-	ux.Logger.PrintToUser("==================================================")
-
-	header := []string{"nodeID", "connected", "weight", "remaining", "vmversion"}
-	table.SetHeader(header)
-	table.SetAutoMergeCellsByColumnIndex([]int{0})
-	table.SetAutoMergeCells(true)
-	table.SetRowLine(true)
-	rows := [][]string{}
+	stats := []currentValidatorStat{}
 
 	var (
-		startTime, endTime           time.Time
-		localNodeID                  ids.NodeID
-		remaining, connected, weight string
-		localVersionStr, versionStr  string
+		localNodeID                 ids.NodeID
+		localVersionStr, versionStr string
 	)
 
 	// try querying the local node for its node version
@@ -234,24 +311,10 @@ func buildCurrentValidatorStats(oClient omegavm.Client, infoClient info.Client,
 	}
 
 	for _, v := range currValidators {
-		startTime = time.Unix(int64(v.StartTime), 0)
-		endTime = time.Unix(int64(v.EndTime), 0)
-		remaining = ux.FormatDuration(endTime.Sub(startTime))
-
-		// some members of the returned object are pointers
-		// so we need to check the pointer is actually valid
-		if v.Connected != nil {
-			connected = strconv.FormatBool(*v.Connected)
-		} else {
-			connected = constants.NotAvailableLabel
-		}
-
-		uint64Weight := v.Weight
-		delegators := v.Delegators
-		for _, d := range delegators {
-			uint64Weight += d.Weight
+		weight := v.Weight
+		for _, d := range v.Delegators {
+			weight += d.Weight
 		}
-		weight = strconv.FormatUint(uint64Weight, 10)
 
 		// if retrieval of localNodeID failed, it will be empty,
 		// and this comparison fails
@@ -259,16 +322,17 @@ func buildCurrentValidatorStats(oClient omegavm.Client, infoClient info.Client,
 			versionStr = localVersionStr
 		}
 		// query peers for IP address of this NodeID...
-		rows = append(rows, []string{
-			v.NodeID.String(),
-			connected,
-			weight,
-			remaining,
-			versionStr,
+		stats = append(stats, currentValidatorStat{
+			NodeID:    v.NodeID.String(),
+			Connected: v.Connected,
+			Weight:    weight,
+			StartTime: time.Unix(int64(v.StartTime), 0),
+			EndTime:   time.Unix(int64(v.EndTime), 0),
+			VMVersion: versionStr,
 		})
 	}
 
-	return rows, nil
+	return stats, nil
 }
 
 // findAPIEndpoint tries first to create a client to a local node
@@ -62,10 +62,15 @@ func TestStats(t *testing.T) {
 
 	expectedVerStr := subnetID.String() + ": 0.1.23\n"
 
-	rows, err := buildCurrentValidatorStats(oClient, iClient, table, subnetID)
+	current, err := buildCurrentValidatorStats(oClient, iClient, subnetID)
+	require.NoError(err)
+	require.Len(current, 1)
+	require.Equal(&conn, current[0].Connected)
+	require.Equal(weight, current[0].Weight)
+
+	rows := currentValidatorRows(current)
 	table.Append(rows[0])
 
-	require.NoError(err)
 	require.Equal(1, table.NumLines())
 	require.Equal(localNodeID.String(), rows[0][0])
 	require.Equal("true", rows[0][1])
@@ -89,7 +94,12 @@ func TestStats(t *testing.T) {
 	oClient.On("GetPendingValidators", mock.Anything, mock.Anything, mock.Anything).Return(pendingV, nil, nil)
 
 	table = tablewriter.NewWriter(io.Discard)
-	rows, err = buildPendingValidatorStats(oClient, iClient, table, subnetID)
+	pending, err := buildPendingValidatorStats(oClient, iClient, subnetID)
+	require.NoError(err)
+	require.Len(pending, 1)
+	require.Equal(weight, pending[0].Weight)
+
+	rows = pendingValidatorRows(pending)
 	table.Append(rows[0])
 
 	// we can't use `startTime` resp. `endTime` for controlling the end string:
@@ -100,7 +110,6 @@ func TestStats(t *testing.T) {
 	controlStartTime := time.Unix(startTime.Unix(), 0)
 	controlEndTime := time.Unix(endTime.Unix(), 0)
 
-	require.NoError(err)
 	require.Equal(1, table.NumLines())
 	require.Equal(localNodeID.String(), rows[0][0])
 	require.Equal("42", rows[0][1])
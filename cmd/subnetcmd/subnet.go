@@ -5,6 +5,9 @@ package subnetcmd
 import (
 	"fmt"
 
+	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd/feepresetcmd"
+	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd/genesiscmd"
+	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd/precompilecmd"
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd/upgradecmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/application"
 	"github.com/spf13/cobra"
@@ -54,6 +57,12 @@ manage your Subnet configurations and live deployments.`,
 	cmd.AddCommand(newPublishCmd())
 	// subnet upgrade
 	cmd.AddCommand(upgradecmd.NewCmd(app))
+	// subnet genesis
+	cmd.AddCommand(genesiscmd.NewCmd(app))
+	// subnet precompile
+	cmd.AddCommand(precompilecmd.NewCmd(app))
+	// subnet fee-preset
+	cmd.AddCommand(feepresetcmd.NewCmd(app))
 	// subnet stats
 	cmd.AddCommand(newStatsCmd())
 	// subnet configure
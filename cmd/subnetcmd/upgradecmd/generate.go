@@ -14,6 +14,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"go.uber.org/zap"
 
+	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
 	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
@@ -42,9 +43,43 @@ const (
 
 	enabledLabel = "enabled"
 	adminLabel   = "admin"
+
+	upgradeConfirmPrompt      = "Press [Enter] to continue, or abort by choosing 'no'"
+	selectPrecompilePrompt    = "Select the precompile to configure"
+	configureAnotherPrompt    = "Should we configure another precompile?"
+	activationPrompt          = "When should the precompile be activated?"
+	activationDatePrompt      = "Enter the block activation UTC datetime in 'YYYY-MM-DD HH:MM:SS' format"
+	airdropMorePrompt         = "Airdrop more tokens?"
+	initialMintDecisionPrompt = "How would you like to distribute your funds"
+	initialMintAddressPrompt  = "Address to airdrop to"
+	initialMintAmountPrompt   = "Amount to airdrop (in DIONE units)"
+	updateFeeConfigPrompt     = "Do you want to update the fee config upon precompile activation?"
+	addAddressesPrompt        = "Add '%sAddresses'?"
+	provideAddressesPrompt    = "Provide '%sAddresses'"
 )
 
-var subnetName string
+var (
+	subnetName      string
+	generateAnswers flags.AnswersFiles
+
+	generateAnswerKeys = prompts.AnswerKeys{
+		upgradeConfirmPrompt:      "upgrade.confirm",
+		selectPrecompilePrompt:    "upgrade.precompiles",
+		configureAnotherPrompt:    "upgrade.configureAnother",
+		activationPrompt:          "upgrade.activation",
+		activationDatePrompt:      "upgrade.activationDate",
+		airdropMorePrompt:         "upgrade.nativeMinter.airdrop",
+		initialMintDecisionPrompt: "upgrade.nativeMinter.initialMint",
+		initialMintAddressPrompt:  "upgrade.nativeMinter.initialMintAddresses",
+		initialMintAmountPrompt:   "upgrade.nativeMinter.initialMintAmounts",
+		updateFeeConfigPrompt:     "upgrade.feeManager.updateFeeConfig",
+
+		fmt.Sprintf(addAddressesPrompt, adminLabel):       "upgrade.addAdmins",
+		fmt.Sprintf(addAddressesPrompt, enabledLabel):     "upgrade.addEnabled",
+		fmt.Sprintf(provideAddressesPrompt, adminLabel):   "upgrade.admins",
+		fmt.Sprintf(provideAddressesPrompt, enabledLabel): "upgrade.enabled",
+	}
+)
 
 // odyssey subnet upgrade generate
 func newUpgradeGenerateCmd() *cobra.Command {
@@ -53,9 +88,10 @@ func newUpgradeGenerateCmd() *cobra.Command {
 		Short: "Generate the configuration file to upgrade subnet nodes",
 		Long: `The subnet upgrade generate command builds a new upgrade.json file to customize your Subnet. It
 guides the user through the process using an interactive wizard.`,
-		RunE: upgradeGenerateCmd,
+		RunE: flags.RunWithAnswers(&app.Prompt, &generateAnswers, upgradeGenerateCmd, vm.AnswerKeys, generateAnswerKeys),
 		Args: cobra.ExactArgs(1),
 	}
+	flags.AddAnswersFlags(cmd, &generateAnswers)
 	return cmd
 }
 
@@ -76,8 +112,7 @@ func upgradeGenerateCmd(_ *cobra.Command, args []string) error {
 		"Any mistakes in configuring network upgrades or coordinating them on validators " +
 			"may cause the network to halt and recovering may be difficult.")))
 
-	txt := "Press [Enter] to continue, or abort by choosing 'no'"
-	yes, err := app.Prompt.CaptureYesNo(txt)
+	yes, err := app.Prompt.CaptureYesNo(upgradeConfirmPrompt)
 	if err != nil {
 		return err
 	}
@@ -86,13 +121,7 @@ func upgradeGenerateCmd(_ *cobra.Command, args []string) error {
 		return nil
 	}
 
-	allPreComps := []string{
-		vm.ContractAllowList,
-		vm.FeeManager,
-		vm.NativeMint,
-		vm.TxAllowList,
-		vm.RewardManager,
-	}
+	allPreComps := vm.GetPrecompileNames()
 
 	fmt.Println()
 	ux.Logger.PrintToUser(logging.Yellow.Wrap(
@@ -106,7 +135,7 @@ func upgradeGenerateCmd(_ *cobra.Command, args []string) error {
 	}
 
 	for {
-		precomp, err := app.Prompt.CaptureList("Select the precompile to configure", allPreComps)
+		precomp, err := app.Prompt.CaptureList(selectPrecompilePrompt, allPreComps)
 		if err != nil {
 			return err
 		}
@@ -117,7 +146,7 @@ func upgradeGenerateCmd(_ *cobra.Command, args []string) error {
 		}
 
 		if len(allPreComps) > 1 {
-			yes, err := app.Prompt.CaptureNoYes("Should we configure another precompile?")
+			yes, err := app.Prompt.CaptureNoYes(configureAnotherPrompt)
 			if err != nil {
 				return err
 			}
@@ -151,7 +180,7 @@ func queryActivationTimestamp() (time.Time, error) {
 		custom   = "Custom"
 	)
 	options := []string{in5min, in1day, in1week, in2weeks, custom}
-	choice, err := app.Prompt.CaptureList("When should the precompile be activated?", options)
+	choice, err := app.Prompt.CaptureList(activationPrompt, options)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -169,8 +198,7 @@ func queryActivationTimestamp() (time.Time, error) {
 	case in2weeks:
 		date = now.Add(14 * 24 * time.Hour)
 	case custom:
-		date, err = app.Prompt.CaptureFutureDate(
-			"Enter the block activation UTC datetime in 'YYYY-MM-DD HH:MM:SS' format", time.Now().Add(time.Minute).UTC())
+		date, err = app.Prompt.CaptureFutureDate(activationDatePrompt, time.Now().Add(time.Minute).UTC())
 		if err != nil {
 			return time.Time{}, err
 		}
@@ -196,11 +224,49 @@ func promptParams(precomp string, precompiles *[]params.PrecompileUpgrade) error
 		return promptFeeManagerParams(precompiles, date)
 	case vm.RewardManager:
 		return promptRewardManagerParams(precompiles, date)
+	case vm.Warp:
+		return promptWarpParams(precompiles, date)
 	default:
-		return fmt.Errorf("unexpected precompile identifier: %q", precomp)
+		descriptor, ok := vm.GetCustomPrecompileDescriptorByName(precomp)
+		if !ok {
+			return fmt.Errorf("unexpected precompile identifier: %q", precomp)
+		}
+		return promptCustomPrecompileParams(precompiles, date, descriptor.ConfigKey)
 	}
 }
 
+func promptWarpParams(precompiles *[]params.PrecompileUpgrade, date time.Time) error {
+	config, err := vm.ConfigureWarp(app, uint64(date.Unix()))
+	if err != nil {
+		return err
+	}
+	upgrade := params.PrecompileUpgrade{
+		Config: config,
+	}
+	*precompiles = append(*precompiles, upgrade)
+	return nil
+}
+
+func promptCustomPrecompileParams(precompiles *[]params.PrecompileUpgrade, date time.Time, configKey string) error {
+	config, cancelled, err := vm.ConfigureCustomPrecompile(app, configKey, uint64(date.Unix()))
+	if err != nil {
+		return err
+	}
+	if cancelled {
+		return errors.New("aborted by user")
+	}
+	if allowList, ok := config.AllowListConfig(); ok {
+		if err := ensureAdminsHaveBalance(allowList.AdminAddresses, subnetName); err != nil {
+			return err
+		}
+	}
+	upgrade := params.PrecompileUpgrade{
+		Config: config,
+	}
+	*precompiles = append(*precompiles, upgrade)
+	return nil
+}
+
 func promptNativeMintParams(precompiles *[]params.PrecompileUpgrade, date time.Time) error {
 	initialMint := map[common.Address]*math.HexOrDecimal256{}
 
@@ -209,7 +275,7 @@ func promptNativeMintParams(precompiles *[]params.PrecompileUpgrade, date time.T
 		return err
 	}
 
-	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("Airdrop more tokens? (`%s` section in file)", initialMintKey))
+	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("%s (`%s` section in file)", airdropMorePrompt, initialMintKey))
 	if err != nil {
 		return err
 	}
@@ -217,13 +283,13 @@ func promptNativeMintParams(precompiles *[]params.PrecompileUpgrade, date time.T
 	if yes {
 		_, cancel, err := prompts.CaptureListDecision(
 			app.Prompt,
-			"How would you like to distribute your funds",
+			initialMintDecisionPrompt,
 			func(s string) (string, error) {
-				addr, err := app.Prompt.CaptureAddress("Address to airdrop to")
+				addr, err := app.Prompt.CaptureAddress(initialMintAddressPrompt)
 				if err != nil {
 					return "", err
 				}
-				amount, err := app.Prompt.CaptureUint64("Amount to airdrop (in DIONE units)")
+				amount, err := app.Prompt.CaptureUint64(initialMintAmountPrompt)
 				if err != nil {
 					return "", err
 				}
@@ -290,7 +356,7 @@ func promptFeeManagerParams(precompiles *[]params.PrecompileUpgrade, date time.T
 	}
 
 	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf(
-		"Do you want to update the fee config upon precompile activation? ('%s' section in file)", feeConfigKey))
+		"%s ('%s' section in file)", updateFeeConfigPrompt, feeConfigKey))
 	if err != nil {
 		return err
 	}
@@ -468,7 +534,7 @@ func promptAdminAndEnabledAddresses() ([]common.Address, []common.Address, error
 }
 
 func captureAddress(which string, addrsField *[]common.Address) error {
-	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf("Add '%sAddresses'?", which))
+	yes, err := app.Prompt.CaptureYesNo(fmt.Sprintf(addAddressesPrompt, which))
 	if err != nil {
 		return err
 	}
@@ -479,7 +545,7 @@ func captureAddress(which string, addrsField *[]common.Address) error {
 		)
 		*addrsField, cancel, err = prompts.CaptureListDecision(
 			app.Prompt,
-			fmt.Sprintf("Provide '%sAddresses'", which),
+			fmt.Sprintf(provideAddressesPrompt, which),
 			app.Prompt.CaptureAddress,
 			"Add an address",
 			"Address",
@@ -11,13 +11,17 @@ import (
 
 	"github.com/DioneProtocol/odyssey-cli/cmd/flags"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
+const validatorsNetworkPrompt = "Choose a network to list validators from"
+
 var (
 	validatorsLocal   bool
 	validatorsTestnet bool
@@ -38,6 +42,7 @@ severarl statistics about them.`,
 	cmd.Flags().BoolVarP(&validatorsLocal, "local", "l", false, "deploy to a local network")
 	cmd.Flags().BoolVarP(&validatorsTestnet, "testnet", "t", false, "deploy to testnet")
 	cmd.Flags().BoolVarP(&validatorsMainnet, "mainnet", "m", false, "deploy to mainnet")
+	prompts.RegisterFlagHint(cmd, validatorsNetworkPrompt, "--local", "--testnet", "--mainnet")
 	return cmd
 }
 
@@ -59,7 +64,7 @@ func printValidators(_ *cobra.Command, args []string) error {
 	if network.Kind == models.Undefined {
 		// no flag was set, prompt user
 		networkStr, err := app.Prompt.CaptureList(
-			"Choose a network to list validators from",
+			validatorsNetworkPrompt,
 			[]string{models.Local.String(), models.Testnet.String(), models.Mainnet.String()},
 		)
 		if err != nil {
@@ -106,12 +111,25 @@ func printPublicValidators(subnetID ids.ID, network models.Network) error {
 	return printValidatorsFromList(validators)
 }
 
+// validatorItem is the --output json|yaml schema of subnet validators.
+// Start and end times are RFC3339 formatted.
+type validatorItem struct {
+	NodeID          string `json:"nodeID" yaml:"nodeID"`
+	StakeAmount     uint64 `json:"stakeAmount" yaml:"stakeAmount"`
+	DelegatorWeight uint64 `json:"delegatorWeight" yaml:"delegatorWeight"`
+	StartTime       string `json:"startTime" yaml:"startTime"`
+	EndTime         string `json:"endTime" yaml:"endTime"`
+	Type            string `json:"type" yaml:"type"`
+}
+
 func printValidatorsFromList(validators []omegavm.ClientPermissionlessValidator) error {
 	header := []string{"NodeID", "Stake Amount", "Delegator Weight", "Start Time", "End Time", "Type"}
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader(header)
 	table.SetRowLine(true)
 
+	items := []validatorItem{}
+
 	for _, validator := range validators {
 		var delegatorWeight uint64
 		if validator.DelegatorWeight != nil {
@@ -123,19 +141,27 @@ func printValidatorsFromList(validators []omegavm.ClientPermissionlessValidator)
 			validatorType = "elastic"
 		}
 
+		item := validatorItem{
+			NodeID:          validator.NodeID.String(),
+			StakeAmount:     *validator.StakeAmount,
+			DelegatorWeight: delegatorWeight,
+			StartTime:       formatUnixTime(validator.StartTime),
+			EndTime:         formatUnixTime(validator.EndTime),
+			Type:            validatorType,
+		}
+		items = append(items, item)
+
 		table.Append([]string{
-			validator.NodeID.String(),
-			strconv.FormatUint(*validator.StakeAmount, 10),
-			strconv.FormatUint(delegatorWeight, 10),
-			formatUnixTime(validator.StartTime),
-			formatUnixTime(validator.EndTime),
-			validatorType,
+			item.NodeID,
+			strconv.FormatUint(item.StakeAmount, 10),
+			strconv.FormatUint(item.DelegatorWeight, 10),
+			item.StartTime,
+			item.EndTime,
+			item.Type,
 		})
 	}
 
-	table.Render()
-
-	return nil
+	return ux.Render(items, table.Render)
 }
 
 func formatUnixTime(unixTime uint64) string {
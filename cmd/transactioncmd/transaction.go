@@ -29,5 +29,9 @@ func NewCmd(injectedApp *application.Odyssey) *cobra.Command {
 	cmd.AddCommand(newTransactionSignCmd())
 	// subnet upgrade generate
 	cmd.AddCommand(newTransactionCommitCmd())
+	// transaction inspect
+	cmd.AddCommand(newTransactionInspectCmd())
+	// transaction merge
+	cmd.AddCommand(newTransactionMergeCmd())
 	return cmd
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
 	"github.com/DioneProtocol/odyssey-cli/pkg/keychain"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/subnet"
 	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/spf13/cobra"
 )
@@ -18,11 +20,13 @@ import (
 // odyssey transaction commit
 func newTransactionCommitCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:          "commit [subnetName]",
-		Short:        "commit a transaction",
-		Long:         "The transaction commit command commits a transaction by submitting it to the O-Chain.",
+		Use:   "commit [subnetName]",
+		Short: "commit a transaction",
+		Long: `The transaction commit command commits a transaction by submitting it to the O-Chain.
+
+The subnet name is only needed for transactions authorized by the subnet control keys.`,
 		RunE:         commitTx,
-		Args:         cobra.ExactArgs(1),
+		Args:         cobra.MaximumNArgs(1),
 		SilenceUsage: true,
 	}
 
@@ -48,6 +52,26 @@ func commitTx(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	if !txutils.HasSubnetAuth(tx) {
+		missingSigs, err := txutils.GetMissingSignatures(tx)
+		if err != nil {
+			return err
+		}
+		if missingSigs != 0 {
+			ux.Logger.PrintToUser("%d signatures of the tx inputs are missing.", missingSigs)
+			return fmt.Errorf("tx is not fully signed")
+		}
+		txID, err := commitSignedTx(tx, network)
+		if err != nil {
+			return err
+		}
+		ux.Logger.PrintToUser("Transaction successful, transaction ID: %s", txID)
+		return nil
+	}
+
+	if len(args) == 0 {
+		return errNoSubnetName
+	}
 	subnetName := args[0]
 	sc, err := app.LoadSidecar(subnetName)
 	if err != nil {
@@ -74,15 +98,7 @@ func commitTx(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("tx is not fully signed")
 	}
 
-	// get kc with some random address, to pass wallet creation checks
-	kc := secp256k1fx.NewKeychain()
-	_, err = kc.New()
-	if err != nil {
-		return err
-	}
-
-	deployer := subnet.NewPublicDeployer(app, keychain.NewKeychain(network, kc, nil, nil), network)
-	txID, err := deployer.Commit(tx)
+	txID, err := commitSignedTx(tx, network)
 	if err != nil {
 		return err
 	}
@@ -97,3 +113,14 @@ func commitTx(_ *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// issues the fully signed [tx] to [network]
+func commitSignedTx(tx *txs.Tx, network models.Network) (ids.ID, error) {
+	// get kc with some random address, to pass wallet creation checks
+	kc := secp256k1fx.NewKeychain()
+	if _, err := kc.New(); err != nil {
+		return ids.Empty, err
+	}
+	deployer := subnet.NewPublicDeployer(app, keychain.NewKeychain(network, kc, nil, nil), network)
+	return deployer.Commit(tx)
+}
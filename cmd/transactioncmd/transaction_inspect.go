@@ -0,0 +1,151 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package transactioncmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// odyssey transaction inspect
+func newTransactionInspectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "decode and explain a transaction",
+		Long: `The transaction inspect command decodes a multisig transaction file and shows
+its type, all its fields, the fee inputs and outputs, the subnet auth keys
+required to sign it, and which of them have already signed.
+
+Use it to check a transaction before signing it.`,
+		RunE:         inspectTx,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&inputTxPath, inputTxPathFlag, "", "Path to the transaction file to inspect")
+	return cmd
+}
+
+func inspectTx(_ *cobra.Command, _ []string) error {
+	var err error
+	if inputTxPath == "" {
+		inputTxPath, err = app.Prompt.CaptureExistingFilepath("What is the path to the transactions file to inspect?")
+		if err != nil {
+			return err
+		}
+	}
+	tx, err := txutils.LoadFromDisk(inputTxPath)
+	if err != nil {
+		return err
+	}
+	desc, err := txutils.Describe(tx)
+	if err != nil {
+		return err
+	}
+
+	ux.Logger.PrintToUser("Transaction %s of type %s", tx.ID(), desc.Type)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Field", "Value"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, field := range desc.Fields {
+		table.Append([]string{field.Name, field.Value})
+	}
+	table.Render()
+
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("Inputs:")
+	for _, in := range desc.Inputs {
+		ux.Logger.PrintToUser("  %s", in)
+	}
+	ux.Logger.PrintToUser("Outputs:")
+	for _, out := range desc.Outputs {
+		ux.Logger.PrintToUser("  %s", out)
+	}
+	ux.Logger.PrintToUser("Fee:")
+	for assetID, burned := range desc.Burned {
+		ux.Logger.PrintToUser("  %s", txutils.FormatAmount(assetID, burned))
+	}
+
+	if !txutils.HasSubnetAuth(tx) {
+		missingSigs, err := txutils.GetMissingSignatures(tx)
+		if err != nil {
+			return err
+		}
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("The transaction does not require subnet auth signatures")
+		ux.Logger.PrintToUser("%d signatures of the tx inputs are missing.", missingSigs)
+		return nil
+	}
+	return printSigners(tx)
+}
+
+// printSigners shows the subnet auth keys required to sign [tx] and which of them already signed.
+// If the subnet control keys can't be obtained from the network, the keys are shown by
+// their index among them.
+func printSigners(tx *txs.Tx) error {
+	controlKeys, threshold, err := getControlKeys(tx)
+	if err != nil {
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("Could not get the subnet control keys: %s", err)
+		ux.Logger.PrintToUser("Subnet auth keys are shown by their index among the control keys")
+		sigIndices, err := txutils.GetSubnetAuthIndices(tx)
+		if err != nil {
+			return err
+		}
+		maxIndex := uint32(0)
+		for _, sigIndex := range sigIndices {
+			if sigIndex > maxIndex {
+				maxIndex = sigIndex
+			}
+		}
+		controlKeys = []string{}
+		for i := uint32(0); i <= maxIndex; i++ {
+			controlKeys = append(controlKeys, fmt.Sprintf("control key #%d", i))
+		}
+	}
+	subnetAuthKeys, remainingSubnetAuthKeys, err := txutils.GetRemainingSigners(tx, controlKeys)
+	if err != nil {
+		return err
+	}
+	remaining := map[string]bool{}
+	for _, addr := range remainingSubnetAuthKeys {
+		remaining[addr] = true
+	}
+	ux.Logger.PrintToUser("")
+	if threshold != 0 {
+		ux.Logger.PrintToUser("Subnet control keys (threshold %d):", threshold)
+		for _, addr := range controlKeys {
+			ux.Logger.PrintToUser("  %s", addr)
+		}
+	}
+	ux.Logger.PrintToUser("Required subnet auth keys:")
+	for _, addr := range subnetAuthKeys {
+		status := "signed"
+		if remaining[addr] {
+			status = "not signed"
+		}
+		ux.Logger.PrintToUser("  %s (%s)", addr, status)
+	}
+	signedCount := len(subnetAuthKeys) - len(remainingSubnetAuthKeys)
+	ux.Logger.PrintToUser("%d of %d required signatures have been signed.", signedCount, len(subnetAuthKeys))
+	return nil
+}
+
+func getControlKeys(tx *txs.Tx) ([]string, uint32, error) {
+	network, err := txutils.GetNetwork(tx)
+	if err != nil {
+		return nil, 0, err
+	}
+	subnetID, err := txutils.GetSubnetID(tx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return txutils.GetOwners(network, subnetID)
+}
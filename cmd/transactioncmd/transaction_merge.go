@@ -0,0 +1,105 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package transactioncmd
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odyssey-cli/cmd/subnetcmd"
+	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inputTxPaths []string
+	outputTxPath string
+
+	errNotEnoughTxsToMerge = errors.New("at least two transaction files are needed to merge")
+)
+
+// odyssey transaction merge
+func newTransactionMergeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge [subnetName]",
+		Short: "merge partially signed copies of a transaction",
+		Long: `The transaction merge command combines several copies of the same multisig
+transaction, each signed by different subnet auth keys, into a single file.
+
+This allows the signers to sign the transaction in parallel, instead of passing
+a single file from one signer to the next.`,
+		RunE:         mergeTx,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringSliceVar(&inputTxPaths, "input-tx-filepaths", nil, "Paths to the partially signed copies of the transaction")
+	cmd.Flags().StringVar(&outputTxPath, "output-tx-filepath", "", "Path to write the merged transaction to")
+	return cmd
+}
+
+func mergeTx(_ *cobra.Command, args []string) error {
+	if len(inputTxPaths) < 2 {
+		return errNotEnoughTxsToMerge
+	}
+	txsToMerge := []*txs.Tx{}
+	for _, txPath := range inputTxPaths {
+		tx, err := txutils.LoadFromDisk(txPath)
+		if err != nil {
+			return err
+		}
+		txsToMerge = append(txsToMerge, tx)
+	}
+	tx, err := txutils.Merge(txsToMerge)
+	if err != nil {
+		return err
+	}
+
+	if !txutils.HasSubnetAuth(tx) {
+		return txutils.SaveNotFullySignedInputsTx(app, "Tx", tx, outputTxPath, false)
+	}
+
+	network, err := txutils.GetNetwork(tx)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errNoSubnetName
+	}
+	subnetName := args[0]
+	sc, err := app.LoadSidecar(subnetName)
+	if err != nil {
+		return err
+	}
+	subnetID := sc.Networks[network.Name()].SubnetID
+	if subnetID == ids.Empty {
+		return errNoSubnetID
+	}
+	subnetIDFromTX, err := txutils.GetSubnetID(tx)
+	if err != nil {
+		return err
+	}
+	if subnetIDFromTX != ids.Empty {
+		subnetID = subnetIDFromTX
+	}
+
+	controlKeys, _, err := txutils.GetOwners(network, subnetID)
+	if err != nil {
+		return err
+	}
+	subnetAuthKeys, remainingSubnetAuthKeys, err := txutils.GetRemainingSigners(tx, controlKeys)
+	if err != nil {
+		return err
+	}
+
+	return subnetcmd.SaveNotFullySignedTx(
+		"Tx",
+		tx,
+		subnetName,
+		subnetAuthKeys,
+		remainingSubnetAuthKeys,
+		outputTxPath,
+		false,
+	)
+}
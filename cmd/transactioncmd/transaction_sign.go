@@ -14,6 +14,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/txutils"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/spf13/cobra"
 )
 
@@ -25,17 +26,22 @@ var (
 	useLedger       bool
 	ledgerAddresses []string
 
-	errNoSubnetID = errors.New("failed to find the subnet ID for this subnet, has it been deployed/created on this network?")
+	errNoSubnetID   = errors.New("failed to find the subnet ID for this subnet, has it been deployed/created on this network?")
+	errNoSubnetName = errors.New("a subnet name is required for transactions authorized by the subnet control keys")
 )
 
 // odyssey transaction sign
 func newTransactionSignCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:          "sign [subnetName]",
-		Short:        "sign a transaction",
-		Long:         "The transaction sign command signs a multisig transaction.",
+		Use:   "sign [subnetName]",
+		Short: "sign a transaction",
+		Long: `The transaction sign command signs a multisig transaction.
+
+The subnet name is only needed for transactions authorized by the subnet control keys.
+Other transactions, as export, import or permissionless delegator ones, are signed by
+the owners of their inputs.`,
 		RunE:         signTx,
-		Args:         cobra.ExactArgs(1),
+		Args:         cobra.MaximumNArgs(1),
 		SilenceUsage: true,
 	}
 
@@ -89,7 +95,14 @@ func signTx(_ *cobra.Command, args []string) error {
 		return errors.New("unsupported network")
 	}
 
+	if !txutils.HasSubnetAuth(tx) {
+		return signInputsTx(tx, network)
+	}
+
 	// we need subnet wallet signing validation + process
+	if len(args) == 0 {
+		return errNoSubnetName
+	}
 	subnetName := args[0]
 	sc, err := app.LoadSidecar(subnetName)
 	if err != nil {
@@ -171,3 +184,42 @@ func signTx(_ *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// signs a [tx] without subnet auth with the keys owning its inputs
+func signInputsTx(tx *txs.Tx, network models.Network) error {
+	missingSigs, err := txutils.GetMissingSignatures(tx)
+	if err != nil {
+		return err
+	}
+	if missingSigs == 0 {
+		subnetcmd.PrintReadyToSignMsg("", inputTxPath)
+		ux.Logger.PrintToUser("")
+		return fmt.Errorf("tx is already fully signed")
+	}
+
+	kc, err := keychain.GetKeychain(app, false, useLedger, ledgerAddresses, keyName, network, 0)
+	if err != nil {
+		return err
+	}
+	deployer := subnet.NewPublicDeployer(app, kc, network)
+	if err := deployer.SignInputs(tx); err != nil {
+		if errors.Is(err, subnet.ErrNoSignersInWallet) {
+			ux.Logger.PrintToUser("None of the missing signers of the tx inputs are present in the wallet")
+			ux.Logger.PrintToUser("")
+			return fmt.Errorf("no remaining signer address present in wallet")
+		}
+		return err
+	}
+
+	txName := txutils.GetLedgerDisplayName(tx)
+	if txName == "" {
+		txName = "Tx"
+	}
+	return txutils.SaveNotFullySignedInputsTx(
+		app,
+		txName,
+		tx,
+		inputTxPath,
+		true,
+	)
+}
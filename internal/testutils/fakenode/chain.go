@@ -0,0 +1,311 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package fakenode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/api/info"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	odygojson "github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm"
+	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// TxFee is the fee of every O-Chain tx of the chain
+	TxFee = units.MilliDione
+	// MinValidatorStake is the minimum stake of a Primary Network validator of the chain
+	MinValidatorStake = 2 * units.KiloDione
+)
+
+var (
+	// DIONEAssetID is the ID of the DIONE asset of the chain
+	DIONEAssetID = ids.ID{'d', 'i', 'o', 'n', 'e'}
+	// AChainID and DChainID are the IDs of the A-Chain and the D-Chain
+	AChainID = ids.ID{'a'}
+	DChainID = ids.ID{'d'}
+)
+
+// Chain is an in-process API server of the Primary Network chains of a network, serving
+// the requests made by the CLI wallet. It only keeps O-Chain state: the DIONE UTXOs, the
+// txs and the validators added by the issued txs, which are committed right away.
+type Chain struct {
+	// URL is the endpoint of the chain APIs
+	URL       string
+	lock      sync.Mutex
+	networkID uint32
+	utxos     map[ids.ID]*dione.UTXO
+	txs       map[ids.ID]*txs.Tx
+	// validators maps subnet ID to the validators of the subnet, in issue order
+	validators map[ids.ID][]omegaapi.PermissionlessValidator
+}
+
+// NewChain starts the API server of the chains of network [networkID], that is closed
+// at the end of the test
+func NewChain(t *testing.T, networkID uint32) *Chain {
+	c := &Chain{
+		networkID:  networkID,
+		utxos:      map[ids.ID]*dione.UTXO{},
+		txs:        map[ids.ID]*txs.Tx{},
+		validators: map[ids.ID][]omegaapi.PermissionlessValidator{},
+	}
+	server := httptest.NewServer(c)
+	t.Cleanup(server.Close)
+	c.URL = server.URL
+	return c
+}
+
+// Fund gives [amount] nDIONE on the O-Chain to [addr]
+func (c *Chain) Fund(addr ids.ShortID, amount uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	utxo := &dione.UTXO{
+		UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  dione.Asset{ID: DIONEAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+			},
+		},
+	}
+	c.utxos[utxo.InputID()] = utxo
+}
+
+// CreateSubnet commits the creation of a subnet owned by [owner], and returns its ID
+func (c *Chain) CreateSubnet(t *testing.T, owner ids.ShortID) ids.ID {
+	tx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    c.networkID,
+			BlockchainID: constants.OmegaChainID,
+		}},
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{owner},
+		},
+	}}
+	require.NoError(t, tx.Initialize(txs.Codec))
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.txs[tx.ID()] = tx
+	return tx.ID()
+}
+
+// IsValidator returns if [nodeID] was added as a validator of [subnetID], ids.Empty being
+// the Primary Network
+func (c *Chain) IsValidator(subnetID ids.ID, nodeID ids.NodeID) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, validator := range c.validators[subnetID] {
+		if validator.NodeID == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// ServeHTTP serves the chain API methods used by the CLI wallet
+func (c *Chain) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request apiRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := c.handleAPIRequest(request)
+	response := apiResponse{JSONRPC: "2.0", ID: request.ID, Result: result}
+	if err != nil {
+		response.Result = nil
+		response.Error = map[string]interface{}{"code": -32000, "message": err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (c *Chain) handleAPIRequest(request apiRequest) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	switch request.Method {
+	case "info.getNetworkID":
+		return info.GetNetworkIDReply{NetworkID: odygojson.Uint32(c.networkID)}, nil
+	case "info.getBlockchainID":
+		var params info.GetBlockchainIDArgs
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		chainIDs := map[string]ids.ID{"O": constants.OmegaChainID, "A": AChainID, "D": DChainID}
+		chainID, ok := chainIDs[params.Alias]
+		if !ok {
+			return nil, fmt.Errorf("there is no chain with alias/ID '%s'", params.Alias)
+		}
+		return info.GetBlockchainIDReply{BlockchainID: chainID}, nil
+	case "info.getTxFee":
+		fee := odygojson.Uint64(TxFee)
+		return info.GetTxFeeResponse{
+			TxFee:                 fee,
+			CreateAssetTxFee:      fee,
+			CreateSubnetTxFee:     fee,
+			TransformSubnetTxFee:  fee,
+			CreateBlockchainTxFee: fee,
+			AddSubnetValidatorFee: fee,
+			AddSubnetDelegatorFee: fee,
+		}, nil
+	case "alpha.getAssetDescription":
+		return map[string]interface{}{
+			"assetID":      DIONEAssetID,
+			"name":         "Dione",
+			"symbol":       "DIONE",
+			"denomination": "9",
+		}, nil
+	case "omega.getUTXOs", "alpha.getUTXOs", "dione.getUTXOs":
+		var params api.GetUTXOsArgs
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return c.getUTXOs(request.Method == "omega.getUTXOs" && params.SourceChain == constants.OmegaChainID.String())
+	case "omega.getTx":
+		var params api.GetTxArgs
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		tx, ok := c.txs[params.TxID]
+		if !ok {
+			return nil, fmt.Errorf("couldn't get tx %s: not found", params.TxID)
+		}
+		txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return api.FormattedTx{Tx: txStr, Encoding: formatting.Hex}, nil
+	case "omega.issueTx":
+		var params api.FormattedTx
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		txBytes, err := formatting.Decode(params.Encoding, params.Tx)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := txs.Parse(txs.Codec, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		return api.JSONTxID{TxID: tx.ID()}, c.commitTx(tx)
+	case "omega.getTxStatus":
+		var params omegavm.GetTxStatusArgs
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		txStatus := status.Unknown
+		if _, ok := c.txs[params.TxID]; ok {
+			txStatus = status.Committed
+		}
+		return omegavm.GetTxStatusResponse{Status: txStatus}, nil
+	case "omega.getMinStake":
+		return omegavm.GetMinStakeReply{
+			MinValidatorStake: odygojson.Uint64(MinValidatorStake),
+			MinDelegatorStake: odygojson.Uint64(MinValidatorStake / 2),
+		}, nil
+	case "omega.getCurrentValidators":
+		var params omegavm.GetCurrentValidatorsArgs
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		validators := []interface{}{}
+		for _, validator := range c.validators[params.SubnetID] {
+			if len(params.NodeIDs) == 0 || slices.Contains(params.NodeIDs, validator.NodeID) {
+				validators = append(validators, validator)
+			}
+		}
+		return omegavm.GetCurrentValidatorsReply{Validators: validators}, nil
+	default:
+		return nil, fmt.Errorf("method %s not supported", request.Method)
+	}
+}
+
+// getUTXOs returns all the O-Chain UTXOs if [oChainUTXOs], else no UTXOs
+func (c *Chain) getUTXOs(oChainUTXOs bool) (interface{}, error) {
+	// the whole set is returned in one page, so the end index only has to be well formed
+	endAddr, err := address.Format("O", constants.GetHRP(c.networkID), ids.ShortEmpty[:])
+	if err != nil {
+		return nil, err
+	}
+	reply := api.GetUTXOsReply{
+		UTXOs:    []string{},
+		EndIndex: api.Index{Address: endAddr, UTXO: ids.Empty.String()},
+		Encoding: formatting.Hex,
+	}
+	if !oChainUTXOs {
+		return reply, nil
+	}
+	for _, utxo := range c.utxos {
+		utxoBytes, err := txs.Codec.Marshal(txs.Version, utxo)
+		if err != nil {
+			return nil, err
+		}
+		utxoStr, err := formatting.Encode(formatting.Hex, utxoBytes)
+		if err != nil {
+			return nil, err
+		}
+		reply.UTXOs = append(reply.UTXOs, utxoStr)
+	}
+	reply.NumFetched = odygojson.Uint64(len(reply.UTXOs))
+	return reply, nil
+}
+
+// commitTx spends the inputs of [tx], adds its outputs and the validator it adds, if any
+func (c *Chain) commitTx(tx *txs.Tx) error {
+	for inputID := range tx.Unsigned.InputIDs() {
+		if _, ok := c.utxos[inputID]; !ok {
+			return fmt.Errorf("failed to issue tx %s: input %s not found", tx.ID(), inputID)
+		}
+	}
+	for inputID := range tx.Unsigned.InputIDs() {
+		delete(c.utxos, inputID)
+	}
+	for _, utxo := range tx.UTXOs() {
+		c.utxos[utxo.InputID()] = utxo
+	}
+	c.txs[tx.ID()] = tx
+	var (
+		subnetID  ids.ID
+		validator txs.Validator
+	)
+	switch unsignedTx := tx.Unsigned.(type) {
+	case *txs.AddPermissionlessValidatorTx:
+		subnetID = unsignedTx.Subnet
+		validator = unsignedTx.Validator
+	case *txs.AddSubnetValidatorTx:
+		subnetID = unsignedTx.SubnetValidator.Subnet
+		validator = unsignedTx.SubnetValidator.Validator
+	default:
+		return nil
+	}
+	c.validators[subnetID] = append(c.validators[subnetID], omegaapi.PermissionlessValidator{
+		Staker: omegaapi.Staker{
+			TxID:      tx.ID(),
+			NodeID:    validator.NodeID,
+			StartTime: odygojson.Uint64(validator.Start),
+			EndTime:   odygojson.Uint64(validator.End),
+			Weight:    odygojson.Uint64(validator.Wght),
+		},
+	})
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package fakenode
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud/fake"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"golang.org/x/crypto/ssh"
+)
+
+// Cloud makes the instances created in a fake cloud reachable over SSH, as the
+// instances created by node create. The SSH connections to the public IP of a
+// running instance are served by a fresh machine, started on the first connection
+// and accepting the SSH key the instance was created with.
+type Cloud struct {
+	Provider *fake.Provider
+	lock     sync.Mutex
+	servers  map[string]*Server // maps instance ID to the server of its machine
+}
+
+// NewCloud makes the instances of [provider] reachable over SSH until the end of the test
+func NewCloud(t *testing.T, provider *fake.Provider) *Cloud {
+	c := &Cloud{
+		Provider: provider,
+		servers:  map[string]*Server{},
+	}
+	dialSSH := models.DialSSH
+	models.DialSSH = c.dial
+	t.Cleanup(func() {
+		models.DialSSH = dialSSH
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		for _, server := range c.servers {
+			_ = server.Close()
+		}
+	})
+	return c
+}
+
+// Server returns the SSH server of the machine of instance [instanceID], or nil if the
+// instance was never connected to
+func (c *Cloud) Server(instanceID string) *Server {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.servers[instanceID]
+}
+
+// dial connects to the SSH server of the running instance with public IP and SSH port [address]
+func (c *Cloud) dial(network, address string, timeout time.Duration) (net.Conn, error) {
+	ip, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := c.Provider.GetRunningInstanceByIP(ip)
+	if !ok || port != strconv.Itoa(constants.SSHTCPPort) {
+		return nil, fmt.Errorf("dial %s %s: connection refused", network, address)
+	}
+	server, err := c.getServer(instance)
+	if err != nil {
+		return nil, err
+	}
+	return net.DialTimeout(network, server.Addr.String(), timeout)
+}
+
+// getServer returns the SSH server of the machine of [instance], starting it if needed
+func (c *Cloud) getServer(instance fake.Instance) (*Server, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if server, ok := c.servers[instance.ID]; ok {
+		return server, nil
+	}
+	authorizedKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(instance.Spec.SSHPublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("instance %s has no valid SSH key: %w", instance.ID, err)
+	}
+	// a fresh machine has neither odysseygo nor a node ID
+	node := NewNode(ids.EmptyNodeID, "")
+	node.Running = false
+	delete(node.Files, constants.CloudNodeOdysseyGoBinaryPath)
+	server, err := NewServer(node, authorizedKey)
+	if err != nil {
+		return nil, err
+	}
+	c.servers[instance.ID] = server
+	return server, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package fakenode
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud/fake"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// Location is the fake cloud location of the cluster nodes
+	Location = "local"
+	// LocalIP is the IP of the cluster nodes, as their SSH servers listen locally
+	LocalIP = "127.0.0.1"
+)
+
+// Cluster is a cluster of fake nodes, created in a fake cloud and stored in the
+// CLI config as if created by node create
+type Cluster struct {
+	Name     string
+	Provider *fake.Provider
+	// InstanceIDs are the instance IDs of the nodes, in creation order
+	InstanceIDs []string
+	// Nodes maps the instance ID of the nodes to them
+	Nodes   map[string]*Node
+	servers []*Server
+}
+
+// NewCluster creates cluster [clusterName] of [count] nodes running odysseygo at
+// [odysseyGoVersion] on [network], in [provider]. The SSH servers of the nodes are
+// closed at the end of the test.
+//
+// The nodes are stored with the AWS ansible prefix, as the inventory only supports
+// real clouds, but their node configs refer to the fake cloud.
+func NewCluster(
+	t *testing.T,
+	app *application.Odyssey,
+	provider *fake.Provider,
+	clusterName string,
+	network models.Network,
+	count int,
+	odysseyGoVersion string,
+) *Cluster {
+	require := require.New(t)
+	authorizedKey, keyPath := newSSHKey(t, clusterName)
+	keyPairName := clusterName + "-kp"
+	sgName := clusterName + "-sg"
+	sgID, err := provider.SetupSecurityGroup(Location, sgName, LocalIP)
+	require.NoError(err)
+	require.NoError(provider.ImportSSHKey(Location, keyPairName, string(ssh.MarshalAuthorizedKey(authorizedKey))))
+	instanceIDs, err := provider.CreateInstances(cloud.InstanceSpec{
+		Location:      Location,
+		Count:         count,
+		SSHKeyName:    keyPairName,
+		SecurityGroup: sgID,
+	})
+	require.NoError(err)
+
+	cluster := &Cluster{
+		Name:        clusterName,
+		Provider:    provider,
+		InstanceIDs: instanceIDs,
+		Nodes:       map[string]*Node{},
+	}
+	inventory := []string{}
+	for _, instanceID := range instanceIDs {
+		node := NewNode(newStakingFiles(t, app.GetNodeInstanceDirPath(instanceID)), odysseyGoVersion)
+		server, err := NewServer(node, authorizedKey)
+		require.NoError(err)
+		t.Cleanup(func() { _ = server.Close() })
+		cluster.Nodes[instanceID] = node
+		cluster.servers = append(cluster.servers, server)
+
+		require.NoError(app.CreateNodeCloudConfigFile(instanceID, &models.NodeConfig{
+			NodeID:        instanceID,
+			Region:        Location,
+			KeyPair:       keyPairName,
+			CertPath:      keyPath,
+			SecurityGroup: sgName,
+			ElasticIP:     LocalIP,
+			CloudService:  fake.CloudService,
+		}))
+		ansibleID, err := models.HostCloudIDToAnsibleID(constants.AWSCloudService, instanceID)
+		require.NoError(err)
+		host := models.Host{
+			NodeID:            ansibleID,
+			IP:                LocalIP,
+			SSHPort:           server.Addr.Port,
+			SSHUser:           constants.AnsibleSSHUser,
+			SSHPrivateKeyPath: keyPath,
+			SSHCommonArgs:     fmt.Sprintf(constants.AnsibleSSHKnownHostsParams, app.GetSSHKnownHostsPath(clusterName)),
+		}
+		inventory = append(inventory, host.GetAnsibleInventoryRecord())
+	}
+	inventoryDir := app.GetAnsibleInventoryDirPath(clusterName)
+	require.NoError(os.MkdirAll(inventoryDir, constants.DefaultPerms755))
+	require.NoError(os.WriteFile(
+		filepath.Join(inventoryDir, constants.AnsibleHostInventoryFileName),
+		[]byte(strings.Join(inventory, "\n")+"\n"),
+		constants.WriteReadReadPerms,
+	))
+
+	clustersConfig := models.ClustersConfig{}
+	if app.ClustersConfigExists() {
+		clustersConfig, err = app.LoadClustersConfig()
+		require.NoError(err)
+	}
+	if clustersConfig.Clusters == nil {
+		clustersConfig.Clusters = map[string]models.ClusterConfig{}
+	}
+	if clustersConfig.KeyPair == nil {
+		clustersConfig.KeyPair = map[string]string{}
+	}
+	clustersConfig.KeyPair[keyPairName] = keyPath
+	clustersConfig.Clusters[clusterName] = models.ClusterConfig{
+		Nodes:   instanceIDs,
+		Network: network,
+	}
+	require.NoError(app.WriteClustersConfigFile(&clustersConfig))
+	return cluster
+}
+
+// NewExistingHosts starts [count] fake nodes running odysseygo at [odysseyGoVersion] outside
+// of any cloud, as the machines registered by node add-host, and returns their SSH servers
+// and the path of the SSH key accepted by them. The servers are closed at the end of the test.
+func NewExistingHosts(t *testing.T, name string, count int, odysseyGoVersion string) ([]*Server, string) {
+	require := require.New(t)
+	authorizedKey, keyPath := newSSHKey(t, name)
+	servers := []*Server{}
+	for i := 0; i < count; i++ {
+		server, err := NewServer(NewNode(ids.GenerateTestNodeID(), odysseyGoVersion), authorizedKey)
+		require.NoError(err)
+		t.Cleanup(func() { _ = server.Close() })
+		servers = append(servers, server)
+	}
+	return servers, keyPath
+}
+
+// Node returns the [i]th node of the cluster, in creation order
+func (c *Cluster) Node(i int) *Node {
+	return c.Nodes[c.InstanceIDs[i]]
+}
+
+// newSSHKey writes a new private SSH key for [name] to a temporary dir, and returns
+// its public key and path
+func newSSHKey(t *testing.T, name string) (ssh.PublicKey, string) {
+	require := require.New(t)
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	pemBlock, err := ssh.MarshalPrivateKey(privateKey, name)
+	require.NoError(err)
+	keyPath := filepath.Join(t.TempDir(), name+".pem")
+	require.NoError(os.WriteFile(keyPath, pem.EncodeToMemory(pemBlock), constants.WriteReadUserOnlyPerms))
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(err)
+	return sshPublicKey, keyPath
+}
+
+// newStakingFiles writes a new staking cert and key, and a BLS key, to [nodeDir], as node create
+// does, and returns the node ID they give. The staking key is an ECDSA key instead of the RSA key
+// generated by node create, which is too slow to generate for every test node
+func newStakingFiles(t *testing.T, nodeDir string) ids.NodeID {
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	certTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Now().AddDate(100, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, certTemplate, certTemplate, &key.PublicKey, key)
+	require.NoError(err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(err)
+	certBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	require.NoError(os.MkdirAll(nodeDir, constants.DefaultPerms755))
+	require.NoError(os.WriteFile(filepath.Join(nodeDir, constants.StakerCertFileName), certBytes, constants.WriteReadUserOnlyPerms))
+	require.NoError(os.WriteFile(filepath.Join(nodeDir, constants.StakerKeyFileName), keyBytes, constants.WriteReadUserOnlyPerms))
+	blsKeyBytes, err := utils.NewBlsSecretKeyBytes()
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(nodeDir, constants.BLSKeyFileName), blsKeyBytes, constants.WriteReadUserOnlyPerms))
+	nodeID, err := utils.ToNodeID(certBytes, keyBytes)
+	require.NoError(err)
+	return nodeID
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fakenode provides in-process cloud nodes, to exercise the node
+// commands end to end without cloud servers.
+//
+// A Node is an SSH server that serves the scripts run by the CLI with a stub
+// shell, and the odysseygo API requests forwarded over SSH with a stub odysseygo.
+package fakenode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+)
+
+const (
+	// subnetEVMBinaryName is the binary unpacked from a Subnet EVM release archive
+	subnetEVMBinaryName = "subnet-evm"
+	apiVersionPrefix    = "odysseygo/"
+)
+
+var (
+	installerRegexp  = regexp.MustCompile(`odysseygo-installer\.sh .*--version (\S+)`)
+	releaseURLRegexp = regexp.MustCompile(`/download/(v[^/]+)/`)
+	subnetJoinRegexp = regexp.MustCompile(`odyssey subnet join (\S+)`)
+	conditionalRegex = regexp.MustCompile(`^if \[ -f (\S+) \]; then (.*); fi$`)
+)
+
+// Node is a fake cloud node running odysseygo
+type Node struct {
+	lock sync.Mutex
+	// NodeID is the odysseygo node ID
+	NodeID ids.NodeID
+	// Running is false once odysseygo is stopped. The API is not reachable then
+	Running      bool
+	Healthy      bool
+	Bootstrapped bool
+	// Files maps the path of the binaries on the node to their version
+	Files map[string]string
+	// BlockchainStatus maps blockchain ID to the status returned by omega.getBlockchainStatus
+	BlockchainStatus map[string]status.BlockchainStatus
+	// Subnets are the names of the subnets joined by the node
+	Subnets []string
+	// Scripts are the scripts run on the node, in order
+	Scripts []string
+	// Fail maps an API method name, or a script line prefix, to the error it fails with
+	Fail map[string]error
+	// downloaded is the version of the Subnet EVM release downloaded and not yet unpacked
+	downloaded string
+}
+
+// NewNode creates a running, healthy and bootstrapped node with [nodeID], running
+// odysseygo at [odysseyGoVersion]
+func NewNode(nodeID ids.NodeID, odysseyGoVersion string) *Node {
+	return &Node{
+		NodeID:       nodeID,
+		Running:      true,
+		Healthy:      true,
+		Bootstrapped: true,
+		Files: map[string]string{
+			constants.CloudNodeOdysseyGoBinaryPath: odysseyGoVersion,
+		},
+		BlockchainStatus: map[string]status.BlockchainStatus{},
+		Fail:             map[string]error{},
+	}
+}
+
+// Update calls [f] on the node while holding its lock, to safely change its state
+// while it is serving requests
+func (n *Node) Update(f func(n *Node)) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	f(n)
+}
+
+// GetOdysseyGoVersion returns the version of the odysseygo binary of the node
+func (n *Node) GetOdysseyGoVersion() string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.Files[constants.CloudNodeOdysseyGoBinaryPath]
+}
+
+// GetVMVersions maps the ID of the VMs installed on the node to their version
+func (n *Node) GetVMVersions() map[string]string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.getVMVersions()
+}
+
+func (n *Node) getVMVersions() map[string]string {
+	pluginsDir := fmt.Sprintf(constants.CloudNodeSubnetEvmBinaryPath, "")
+	vmVersions := map[string]string{}
+	for path, version := range n.Files {
+		if strings.HasPrefix(path, pluginsDir) {
+			vmVersions[strings.TrimPrefix(path, pluginsDir)] = version
+		}
+	}
+	return vmVersions
+}
+
+// SetVMVersion installs the VM [vmID] at [version] on the node
+func (n *Node) SetVMVersion(vmID string, version string) {
+	n.Update(func(n *Node) {
+		n.Files[fmt.Sprintf(constants.CloudNodeSubnetEvmBinaryPath, vmID)] = version
+	})
+}
+
+// IsRunning returns if odysseygo is running on the node
+func (n *Node) IsRunning() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.Running
+}
+
+// GetScripts returns the scripts run on the node, in order
+func (n *Node) GetScripts() []string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]string{}, n.Scripts...)
+}
+
+// GetSubnets returns the names of the subnets joined by the node
+func (n *Node) GetSubnets() []string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]string{}, n.Subnets...)
+}
+
+// RunScript runs [script] with the stub shell of the node. The lines known to
+// change the node state are applied, in order, and the other ones are ignored.
+// As with set -e, the script stops at the first failing line
+func (n *Node) RunScript(script string) ([]byte, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.Scripts = append(n.Scripts, script)
+	output := []string{}
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			if strings.HasPrefix(line, "#name:") {
+				output = append(output, strings.TrimPrefix(line, "#name:"))
+			}
+			continue
+		}
+		lineOutput, err := n.runLine(line)
+		if err != nil {
+			output = append(output, err.Error())
+			return []byte(strings.Join(output, "\n")), fmt.Errorf("script failed on %q: %w", line, err)
+		}
+		if lineOutput != "" {
+			output = append(output, lineOutput)
+		}
+	}
+	return []byte(strings.Join(output, "\n")), nil
+}
+
+// runLine applies [line] to the node state, and returns its output
+func (n *Node) runLine(line string) (string, error) {
+	for prefix, err := range n.Fail {
+		if strings.HasPrefix(line, prefix) {
+			return "", err
+		}
+	}
+	if matches := conditionalRegex.FindStringSubmatch(line); matches != nil {
+		if _, ok := n.Files[matches[1]]; !ok {
+			return "", nil
+		}
+		line = matches[2]
+	}
+	fields := strings.Fields(strings.TrimPrefix(line, "sudo "))
+	switch {
+	case len(fields) == 3 && fields[0] == "systemctl" && fields[2] == "odysseygo":
+		switch fields[1] {
+		case "stop":
+			n.Running = false
+		case "start", "restart":
+			n.Running = true
+		}
+	case len(fields) == 3 && fields[0] == "test" && fields[1] == "-f":
+		if _, ok := n.Files[fields[2]]; !ok {
+			return "", fmt.Errorf("%s not found", fields[2])
+		}
+	case len(fields) == 4 && fields[0] == "test" && fields[1] == "!" && fields[2] == "-e":
+		if _, ok := n.Files[fields[3]]; ok {
+			return "", fmt.Errorf("%s exists", fields[3])
+		}
+	case len(fields) == 2 && fields[1] == "--version":
+		version, ok := n.Files[fields[0]]
+		if !ok {
+			return "", fmt.Errorf("%s: command not found", fields[0])
+		}
+		// binaries print their version as odysseygo does, without the v prefix
+		return fmt.Sprintf("fakenode/%s [database=v1.4.5]", strings.TrimPrefix(version, "v")), nil
+	case len(fields) >= 3 && fields[0] == "cp":
+		src, dst := fields[len(fields)-2], fields[len(fields)-1]
+		version, ok := n.Files[src]
+		if !ok {
+			return "", fmt.Errorf("cp: cannot stat %s: No such file or directory", src)
+		}
+		n.Files[dst] = version
+	case len(fields) >= 2 && fields[0] == "wget":
+		// only release archives are tracked, other downloads are install scripts
+		if matches := releaseURLRegexp.FindStringSubmatch(line); matches != nil {
+			n.downloaded = matches[1]
+		}
+	case len(fields) >= 2 && fields[0] == "tar":
+		if n.downloaded != "" {
+			n.Files[subnetEVMBinaryName] = n.downloaded
+			n.downloaded = ""
+		}
+	default:
+		if matches := installerRegexp.FindStringSubmatch(line); matches != nil {
+			n.Files[constants.CloudNodeOdysseyGoBinaryPath] = matches[1]
+			// the installer starts the odysseygo service
+			n.Running = true
+		}
+		if matches := subnetJoinRegexp.FindStringSubmatch(line); matches != nil {
+			n.Subnets = append(n.Subnets, matches[1])
+		}
+	}
+	return "", nil
+}
+
+type apiRequest struct {
+	ID     interface{}     `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type apiResponse struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      interface{} `json:"id"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   interface{} `json:"error,omitempty"`
+}
+
+// ServeHTTP serves the odysseygo API methods used by the CLI
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request apiRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := n.handleAPIRequest(request)
+	response := apiResponse{JSONRPC: "2.0", ID: request.ID, Result: result}
+	if err != nil {
+		response.Result = nil
+		response.Error = map[string]interface{}{"code": -32000, "message": err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (n *Node) handleAPIRequest(request apiRequest) (interface{}, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if err := n.Fail[request.Method]; err != nil {
+		return nil, err
+	}
+	switch request.Method {
+	case "info.getNodeVersion":
+		odysseyGoVersion := n.Files[constants.CloudNodeOdysseyGoBinaryPath]
+		vmVersions := map[string]string{
+			constants.PlatformKeyName: odysseyGoVersion,
+			constants.AVMKeyName:      odysseyGoVersion,
+			constants.EVMKeyName:      odysseyGoVersion,
+		}
+		for vmID, version := range n.getVMVersions() {
+			vmVersions[vmID] = version
+		}
+		return map[string]interface{}{
+			"version":    apiVersionPrefix + strings.TrimPrefix(odysseyGoVersion, "v"),
+			"vmVersions": vmVersions,
+		}, nil
+	case "info.getNodeID":
+		return map[string]interface{}{"nodeID": n.NodeID.String()}, nil
+	case "info.isBootstrapped":
+		return map[string]interface{}{"isBootstrapped": n.Bootstrapped}, nil
+	case "health.health":
+		return map[string]interface{}{"healthy": n.Healthy}, nil
+	case "omega.getBlockchainStatus":
+		var params struct {
+			BlockchainID string `json:"blockchainID"`
+		}
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		blockchainStatus, ok := n.BlockchainStatus[params.BlockchainID]
+		if !ok {
+			blockchainStatus = status.UnknownChain
+		}
+		return map[string]interface{}{"status": blockchainStatus.String()}, nil
+	default:
+		return nil, fmt.Errorf("method %s not supported", request.Method)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package fakenode
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sftpSubsystem = "sftp"
+	// scripts are run as arguments of the remote shell
+	shellPrefix = constants.SSHShell + " "
+)
+
+var errUnauthorized = errors.New("unauthorized key")
+
+// Server is an in-process SSH server of a Node. Commands are run with the node stub
+// shell, files are kept in memory, and TCP connections forwarded to the odysseygo
+// API are served by the node stub odysseygo
+type Server struct {
+	Node     *Node
+	Addr     *net.TCPAddr
+	HostKey  ssh.PublicKey
+	config   *ssh.ServerConfig
+	listener net.Listener
+	files    sftp.Handlers
+	wg       sync.WaitGroup
+}
+
+// NewServer starts an SSH server for [node] on a local port, accepting connections
+// authenticated with [authorizedKey]
+func NewServer(node *Node, authorizedKey ssh.PublicKey) (*Server, error) {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+				return nil, errUnauthorized
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Node:     node,
+		Addr:     listener.Addr().(*net.TCPAddr),
+		HostKey:  hostSigner.PublicKey(),
+		config:   config,
+		listener: listener,
+		files:    newFileHandlers(),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close stops accepting connections
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "session":
+			go s.handleSession(newChannel)
+		case "direct-tcpip":
+			go s.handleForward(newChannel)
+		default:
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+		}
+	}
+}
+
+func (s *Server) handleSession(newChannel ssh.NewChannel) {
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	for req := range requests {
+		switch req.Type {
+		case "exec":
+			var payload struct{ Command string }
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			_ = req.Reply(true, nil)
+			output, err := s.Node.RunScript(strings.TrimPrefix(payload.Command, shellPrefix))
+			_, _ = channel.Write(output)
+			exitStatus := uint32(0)
+			if err != nil {
+				exitStatus = 1
+			}
+			_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{exitStatus}))
+			return
+		case "subsystem":
+			var payload struct{ Name string }
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil || payload.Name != sftpSubsystem {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			_ = req.Reply(true, nil)
+			server := sftp.NewRequestServer(channel, s.files)
+			_ = server.Serve()
+			_ = server.Close()
+			return
+		default:
+			// env and pty requests are accepted and ignored
+			_ = req.Reply(req.Type == "env" || req.Type == "pty-req", nil)
+		}
+	}
+}
+
+// handleForward serves one HTTP request to the odysseygo API. It is refused if
+// odysseygo is not running on the node
+func (s *Server) handleForward(newChannel ssh.NewChannel) {
+	var payload struct {
+		DestAddr   string
+		DestPort   uint32
+		OriginAddr string
+		OriginPort uint32
+	}
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &payload); err != nil {
+		_ = newChannel.Reject(ssh.Prohibited, err.Error())
+		return
+	}
+	if payload.DestPort != constants.OdysseygoAPIPort {
+		_ = newChannel.Reject(ssh.Prohibited, fmt.Sprintf("port %d is not forwarded", payload.DestPort))
+		return
+	}
+	if !s.Node.IsRunning() {
+		_ = newChannel.Reject(ssh.ConnectionFailed, "connection refused")
+		return
+	}
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(requests)
+	req, err := http.ReadRequest(bufio.NewReader(channel))
+	if err != nil {
+		return
+	}
+	recorder := httptest.NewRecorder()
+	s.Node.ServeHTTP(recorder, req)
+	// the response is written at once, as the CLI reads it with a single read
+	var response bytes.Buffer
+	if err := recorder.Result().Write(&response); err != nil {
+		return
+	}
+	_, _ = io.Copy(channel, &response)
+}
+
+// newFileHandlers keeps the files uploaded to the node in memory. As the stub shell
+// does not create dirs, the parent dirs of the uploaded files are created on upload
+func newFileHandlers() sftp.Handlers {
+	files := sftp.InMemHandler()
+	files.FilePut = parentDirsWriter{FileWriter: files.FilePut, cmder: files.FileCmd}
+	return files
+}
+
+type parentDirsWriter struct {
+	sftp.FileWriter
+	cmder sftp.FileCmder
+}
+
+func (w parentDirsWriter) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	dir := path.Dir(r.Filepath)
+	parents := []string{}
+	for ; dir != "/" && dir != "."; dir = path.Dir(dir) {
+		parents = append([]string{dir}, parents...)
+	}
+	for _, parent := range parents {
+		// existing dirs fail to be created
+		_ = w.cmder.Filecmd(sftp.NewRequest("Mkdir", parent))
+	}
+	return w.FileWriter.Filewrite(r)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
@@ -17,8 +18,16 @@ import (
 )
 
 // CreateAnsibleHostInventory creates inventory file for ansible
-// specifies the ip address of the cloud server and the corresponding ssh cert path for the cloud server
-func CreateAnsibleHostInventory(inventoryDirPath, certFilePath, cloudService string, publicIPMap map[string]string, cloudConfigMap models.CloudConfig) error {
+// specifies the ip address of the cloud server and the corresponding ssh cert path for the cloud server.
+// SSH host keys are verified against the known_hosts file at [knownHostsPath]
+func CreateAnsibleHostInventory(
+	inventoryDirPath,
+	certFilePath,
+	cloudService string,
+	publicIPMap map[string]string,
+	cloudConfigMap models.CloudConfig,
+	knownHostsPath string,
+) error {
 	if err := os.MkdirAll(inventoryDirPath, os.ModePerm); err != nil {
 		return err
 	}
@@ -35,7 +44,7 @@ func CreateAnsibleHostInventory(inventoryDirPath, certFilePath, cloudService str
 				if err != nil {
 					return err
 				}
-				if err = writeToInventoryFile(inventoryFile, ansibleInstanceID, publicIPMap[instanceID], cloudConfig.CertFilePath); err != nil {
+				if err = writeToInventoryFile(inventoryFile, ansibleInstanceID, publicIPMap[instanceID], cloudConfig.CertFilePath, knownHostsPath); err != nil {
 					return err
 				}
 			}
@@ -46,7 +55,7 @@ func CreateAnsibleHostInventory(inventoryDirPath, certFilePath, cloudService str
 			if err != nil {
 				return err
 			}
-			if err = writeToInventoryFile(inventoryFile, ansibleInstanceID, publicIPMap[instanceID], certFilePath); err != nil {
+			if err = writeToInventoryFile(inventoryFile, ansibleInstanceID, publicIPMap[instanceID], certFilePath, knownHostsPath); err != nil {
 				return err
 			}
 		}
@@ -54,13 +63,13 @@ func CreateAnsibleHostInventory(inventoryDirPath, certFilePath, cloudService str
 	return nil
 }
 
-func writeToInventoryFile(inventoryFile *os.File, ansibleInstanceID, publicIP, certFilePath string) error {
+func writeToInventoryFile(inventoryFile *os.File, ansibleInstanceID, publicIP, certFilePath, knownHostsPath string) error {
 	inventoryContent := ansibleInstanceID
 	inventoryContent += " ansible_host="
 	inventoryContent += publicIP
 	inventoryContent += " ansible_user=ubuntu"
 	inventoryContent += fmt.Sprintf(" ansible_ssh_private_key_file=%s", certFilePath)
-	inventoryContent += fmt.Sprintf(" ansible_ssh_common_args='%s'", constants.AnsibleSSHUseAgentParams)
+	inventoryContent += fmt.Sprintf(" ansible_ssh_common_args='%s'", fmt.Sprintf(constants.AnsibleSSHKnownHostsParams, knownHostsPath))
 	if _, err := inventoryFile.WriteString(inventoryContent + "\n"); err != nil {
 		return err
 	}
@@ -95,9 +104,16 @@ func GetInventoryFromAnsibleInventoryFile(inventoryDirPath string) ([]*models.Ho
 		if err != nil {
 			return nil, err
 		}
+		sshPort := 0
+		if port, ok := parsedHost["ansible_port"]; ok {
+			if sshPort, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("invalid ansible_port %q: %w", port, err)
+			}
+		}
 		host := &models.Host{
 			NodeID:            strings.Split(scanner.Text(), " ")[0],
 			IP:                parsedHost["ansible_host"],
+			SSHPort:           sshPort,
 			SSHUser:           parsedHost["ansible_user"],
 			SSHPrivateKeyPath: parsedHost["ansible_ssh_private_key_file"],
 			SSHCommonArgs:     parsedHost["ansible_ssh_common_args"],
@@ -4,20 +4,24 @@ package application
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/config"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/monitoring"
 	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/opm/opm"
+	"github.com/DioneProtocol/subnet-evm/commontype"
 	"github.com/DioneProtocol/subnet-evm/core"
 )
 
@@ -29,6 +33,9 @@ type Odyssey struct {
 	Opm        *opm.OPM
 	OpmDir     string
 	Downloader Downloader
+
+	// passphrases of encrypted keys already given in this execution
+	keyPassphrases map[string]string
 }
 
 func New() *Odyssey {
@@ -180,6 +187,18 @@ func (app *Odyssey) GetClustersConfigPath() string {
 	return filepath.Join(app.GetNodesDir(), constants.ClustersConfigFileName)
 }
 
+func (app *Odyssey) GetCustomNetworksConfigPath() string {
+	return filepath.Join(app.baseDir, constants.CustomNetworksFileName)
+}
+
+func (app *Odyssey) GetCustomPrecompilesConfigPath() string {
+	return filepath.Join(app.baseDir, constants.CustomPrecompilesFileName)
+}
+
+func (app *Odyssey) GetFeePresetsConfigPath() string {
+	return filepath.Join(app.baseDir, constants.FeePresetsFileName)
+}
+
 func (app *Odyssey) GetNodeBLSSecretKeyPath(instanceID string) string {
 	return filepath.Join(app.GetNodeInstanceDirPath(instanceID), constants.BLSKeyFileName)
 }
@@ -357,6 +376,107 @@ func (app *Odyssey) CopyKeyFile(inputFilename string, keyName string) error {
 	return os.WriteFile(keyPath, keyBytes, constants.WriteReadReadPerms)
 }
 
+// GetKeyPassphrase returns the passphrase used to decrypt the stored key [keyName].
+// It is taken, in order of precedence, from the passphrase env var, from the file pointed
+// to by the passphrase file env var, or captured from the user. The passphrase is kept in
+// memory so the user is asked only once per execution.
+func (app *Odyssey) GetKeyPassphrase(keyName string) (string, error) {
+	if passphrase, ok := app.keyPassphrases[keyName]; ok {
+		return passphrase, nil
+	}
+	passphrase, err := getKeyPassphraseFromEnv()
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		passphrase, err = app.Prompt.CapturePassword(fmt.Sprintf("Enter passphrase for key %s", keyName))
+		if err != nil {
+			return "", withKeyPassphraseFlagHint(err)
+		}
+	}
+	if app.keyPassphrases == nil {
+		app.keyPassphrases = map[string]string{}
+	}
+	app.keyPassphrases[keyName] = passphrase
+	return passphrase, nil
+}
+
+// GetNewKeyPassphrase returns the passphrase used to encrypt the new stored key [keyName].
+// Same sources as GetKeyPassphrase are used, but the user is asked to confirm it if prompted.
+func (app *Odyssey) GetNewKeyPassphrase(keyName string) (string, error) {
+	passphrase, err := getKeyPassphraseFromEnv()
+	if err != nil {
+		return "", err
+	}
+	if passphrase != "" {
+		return passphrase, nil
+	}
+	passphrase, err = app.Prompt.CapturePassword(fmt.Sprintf("Enter passphrase to encrypt key %s", keyName))
+	if err != nil {
+		return "", withKeyPassphraseFlagHint(err)
+	}
+	confirmation, err := app.Prompt.CapturePassword("Confirm passphrase")
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirmation {
+		return "", errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+// GetKeystorePassphrase returns the passphrase used to decrypt the keystore file [keystorePath],
+// taken from the same sources as GetKeyPassphrase.
+func (app *Odyssey) GetKeystorePassphrase(keystorePath string) (string, error) {
+	passphrase, err := getKeyPassphraseFromEnv()
+	if err != nil || passphrase != "" {
+		return passphrase, err
+	}
+	passphrase, err = app.Prompt.CapturePassword(fmt.Sprintf("Enter passphrase for keystore %s", keystorePath))
+	if err != nil {
+		return "", withKeyPassphraseFlagHint(err)
+	}
+	return passphrase, nil
+}
+
+// withKeyPassphraseFlagHint points non-interactive users to the passphrase env vars
+func withKeyPassphraseFlagHint(err error) error {
+	return prompts.WithFlagHint(err, constants.KeyPassphraseEnvVarName, constants.KeyPassphraseFileEnvVarName)
+}
+
+func getKeyPassphraseFromEnv() (string, error) {
+	if passphrase := os.Getenv(constants.KeyPassphraseEnvVarName); passphrase != "" {
+		return passphrase, nil
+	}
+	if passphraseFile := os.Getenv(constants.KeyPassphraseFileEnvVarName); passphraseFile != "" {
+		passphraseBytes, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read passphrase file %s: %w", passphraseFile, err)
+		}
+		return strings.TrimRight(string(passphraseBytes), "\r\n"), nil
+	}
+	return "", nil
+}
+
+// LoadKey loads the stored key at [keyPath], asking for its passphrase if it is encrypted.
+func (app *Odyssey) LoadKey(networkID uint32, keyPath string) (*key.SoftKey, error) {
+	keyName := strings.TrimSuffix(filepath.Base(keyPath), constants.KeySuffix)
+	sk, err := key.LoadSoftWithPassphrase(networkID, keyPath, func() (string, error) {
+		return app.GetKeyPassphrase(keyName)
+	})
+	if err != nil {
+		// do not keep a wrong passphrase around
+		delete(app.keyPassphrases, keyName)
+		return nil, err
+	}
+	return sk, nil
+}
+
+// LoadKeyByName loads the stored key [keyName], asking for its passphrase if it is encrypted.
+func (app *Odyssey) LoadKeyByName(networkID uint32, keyName string) (*key.SoftKey, error) {
+	return app.LoadKey(networkID, app.GetKeyPath(keyName))
+}
+
 func (app *Odyssey) LoadEvmGenesis(subnetName string) (core.Genesis, error) {
 	genesisPath := app.GetGenesisPath(subnetName)
 	jsonBytes, err := os.ReadFile(genesisPath)
@@ -672,6 +792,104 @@ func (app *Odyssey) WriteClustersConfigFile(clustersConfig *models.ClustersConfi
 	return os.WriteFile(clustersConfigPath, clustersConfigBytes, constants.WriteReadReadPerms)
 }
 
+// LoadCustomNetworksConfig loads the registry of user-defined networks, and makes
+// them known to the network lookups
+func (app *Odyssey) LoadCustomNetworksConfig() (models.CustomNetworksConfig, error) {
+	customNetworksConfig := models.CustomNetworksConfig{
+		Networks: map[string]models.CustomNetwork{},
+	}
+	jsonBytes, err := os.ReadFile(app.GetCustomNetworksConfigPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			models.SetCustomNetworks(customNetworksConfig.Networks)
+			return customNetworksConfig, nil
+		}
+		return models.CustomNetworksConfig{}, err
+	}
+	if err := json.Unmarshal(jsonBytes, &customNetworksConfig); err != nil {
+		return models.CustomNetworksConfig{}, fmt.Errorf("invalid networks config %s: %w", app.GetCustomNetworksConfigPath(), err)
+	}
+	if customNetworksConfig.Networks == nil {
+		customNetworksConfig.Networks = map[string]models.CustomNetwork{}
+	}
+	models.SetCustomNetworks(customNetworksConfig.Networks)
+	return customNetworksConfig, nil
+}
+
+// WriteCustomNetworksConfig stores the registry of user-defined networks, and makes
+// them known to the network lookups
+func (app *Odyssey) WriteCustomNetworksConfig(customNetworksConfig models.CustomNetworksConfig) error {
+	customNetworksConfigBytes, err := json.MarshalIndent(customNetworksConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(app.GetCustomNetworksConfigPath(), customNetworksConfigBytes, constants.WriteReadReadPerms); err != nil {
+		return err
+	}
+	models.SetCustomNetworks(customNetworksConfig.Networks)
+	return nil
+}
+
+// LoadCustomPrecompilesConfig loads the registry of precompile descriptors
+func (app *Odyssey) LoadCustomPrecompilesConfig() (models.CustomPrecompilesConfig, error) {
+	customPrecompilesConfig := models.CustomPrecompilesConfig{
+		Precompiles: map[string]models.PrecompileDescriptor{},
+	}
+	jsonBytes, err := os.ReadFile(app.GetCustomPrecompilesConfigPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return customPrecompilesConfig, nil
+		}
+		return models.CustomPrecompilesConfig{}, err
+	}
+	if err := json.Unmarshal(jsonBytes, &customPrecompilesConfig); err != nil {
+		return models.CustomPrecompilesConfig{}, fmt.Errorf("invalid precompiles config %s: %w", app.GetCustomPrecompilesConfigPath(), err)
+	}
+	if customPrecompilesConfig.Precompiles == nil {
+		customPrecompilesConfig.Precompiles = map[string]models.PrecompileDescriptor{}
+	}
+	return customPrecompilesConfig, nil
+}
+
+// WriteCustomPrecompilesConfig stores the registry of precompile descriptors
+func (app *Odyssey) WriteCustomPrecompilesConfig(customPrecompilesConfig models.CustomPrecompilesConfig) error {
+	customPrecompilesConfigBytes, err := json.MarshalIndent(customPrecompilesConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(app.GetCustomPrecompilesConfigPath(), customPrecompilesConfigBytes, constants.WriteReadReadPerms)
+}
+
+// LoadFeePresetsConfig loads the registry of saved fee configs
+func (app *Odyssey) LoadFeePresetsConfig() (models.FeePresetsConfig, error) {
+	feePresetsConfig := models.FeePresetsConfig{
+		Presets: map[string]commontype.FeeConfig{},
+	}
+	jsonBytes, err := os.ReadFile(app.GetFeePresetsConfigPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return feePresetsConfig, nil
+		}
+		return models.FeePresetsConfig{}, err
+	}
+	if err := json.Unmarshal(jsonBytes, &feePresetsConfig); err != nil {
+		return models.FeePresetsConfig{}, fmt.Errorf("invalid fee presets config %s: %w", app.GetFeePresetsConfigPath(), err)
+	}
+	if feePresetsConfig.Presets == nil {
+		feePresetsConfig.Presets = map[string]commontype.FeeConfig{}
+	}
+	return feePresetsConfig, nil
+}
+
+// WriteFeePresetsConfig stores the registry of saved fee configs
+func (app *Odyssey) WriteFeePresetsConfig(feePresetsConfig models.FeePresetsConfig) error {
+	feePresetsConfigBytes, err := json.MarshalIndent(feePresetsConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(app.GetFeePresetsConfigPath(), feePresetsConfigBytes, constants.WriteReadReadPerms)
+}
+
 func (*Odyssey) GetSSHCertFilePath(certName string) (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -721,6 +939,12 @@ func (app *Odyssey) GetAnsibleInventoryDirPath(clusterName string) string {
 	return filepath.Join(app.GetNodesDir(), constants.AnsibleInventoryDir, clusterName)
 }
 
+// GetSSHKnownHostsPath returns the path of the known_hosts file with the SSH host keys
+// pinned for the hosts of [clusterName]
+func (app *Odyssey) GetSSHKnownHostsPath(clusterName string) string {
+	return filepath.Join(app.GetAnsibleInventoryDirPath(clusterName), constants.SSHKnownHostsFileName)
+}
+
 // CreateAnsibleNodeConfigDir creates the ansible node config directory specific for nodeID inside .odyssey-cli
 func (app *Odyssey) CreateAnsibleNodeConfigDir(nodeID string) error {
 	return os.MkdirAll(filepath.Join(app.GetAnsibleDir(), nodeID), constants.DefaultPerms755)
@@ -282,3 +282,21 @@ func newTestApp(t *testing.T) *Odyssey {
 		Log:     logging.NoLog{},
 	}
 }
+
+func TestGetKeystorePassphrase(t *testing.T) {
+	require := require.New(t)
+	ap := newTestApp(t)
+
+	t.Setenv(constants.KeyPassphraseEnvVarName, "from env")
+	passphrase, err := ap.GetKeystorePassphrase("keystore.json")
+	require.NoError(err)
+	require.Equal("from env", passphrase)
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(os.WriteFile(passphraseFile, []byte("from file\n"), 0o600))
+	t.Setenv(constants.KeyPassphraseEnvVarName, "")
+	t.Setenv(constants.KeyPassphraseFileEnvVarName, passphraseFile)
+	passphrase, err = ap.GetKeystorePassphrase("keystore.json")
+	require.NoError(err)
+	require.Equal("from file", passphrase)
+}
@@ -138,8 +138,13 @@ func installZipArchive(zipfile []byte, binDir string) error {
 
 // installTarGzArchive expects a byte array in targz format
 func installTarGzArchive(targz []byte, binDir string) error {
-	byteReader := bytes.NewReader(targz)
-	uncompressedStream, err := gzip.NewReader(byteReader)
+	return InstallTarGzStream(bytes.NewReader(targz), binDir)
+}
+
+// InstallTarGzStream extracts the tar.gz archive read from [targz] into [binDir], without
+// loading the whole archive in memory
+func InstallTarGzStream(targz io.Reader, binDir string) error {
+	uncompressedStream, err := gzip.NewReader(targz)
 	if err != nil {
 		return fmt.Errorf("failed creating gzip reader from odysseygo binary stream: %w", err)
 	}
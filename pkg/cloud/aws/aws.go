@@ -12,10 +12,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
-	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
-	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -26,7 +25,7 @@ import (
 var (
 	ErrNoInstanceState         = errors.New("unable to get instance state")
 	ErrNoAddressFound          = errors.New("unable to get public IP address info on AWS")
-	ErrNodeNotFoundToBeRunning = errors.New("node not found to be running")
+	ErrNodeNotFoundToBeRunning = cloud.ErrNodeNotFoundToBeRunning
 )
 
 type AwsCloud struct {
@@ -271,20 +270,6 @@ func (c *AwsCloud) checkInstanceIsRunning(nodeID string) (bool, error) {
 	return false, nil
 }
 
-// StopAWSNode stops an EC2 instance with the given ID.
-func (c *AwsCloud) StopAWSNode(nodeConfig models.NodeConfig, clusterName string) error {
-	isRunning, err := c.checkInstanceIsRunning(nodeConfig.NodeID)
-	if err != nil {
-		ux.Logger.PrintToUser(fmt.Sprintf("Failed to stop node %s due to %s", nodeConfig.NodeID, err.Error()))
-		return err
-	}
-	if !isRunning {
-		return fmt.Errorf("%w: instance %s, cluster %s", ErrNodeNotFoundToBeRunning, nodeConfig.NodeID, clusterName)
-	}
-	ux.Logger.PrintToUser(fmt.Sprintf("Stopping node instance %s in cluster %s...", nodeConfig.NodeID, clusterName))
-	return c.StopInstance(nodeConfig.NodeID, nodeConfig.ElasticIP, nodeConfig.UseStaticIP)
-}
-
 // StopInstance stops an EC2 instance with the given ID.
 func (c *AwsCloud) StopInstance(instanceID, publicIP string, releasePublicIP bool) error {
 	input := &ec2.StopInstancesInput{
@@ -294,26 +279,56 @@ func (c *AwsCloud) StopInstance(instanceID, publicIP string, releasePublicIP boo
 		return err
 	}
 	if releasePublicIP {
-		describeAddressInput := &ec2.DescribeAddressesInput{
-			Filters: []types.Filter{
-				{Name: aws.String("public-ip"), Values: []string{publicIP}},
-			},
-		}
-		addressOutput, err := c.ec2Client.DescribeAddresses(c.ctx, describeAddressInput)
-		if err != nil {
+		return c.releaseAddress(publicIP)
+	}
+	return nil
+}
+
+// TerminateInstance terminates an EC2 instance with the given ID.
+func (c *AwsCloud) TerminateInstance(instanceID, publicIP string, releasePublicIP bool) error {
+	if releasePublicIP {
+		// the address has to be disassociated before the instance is gone to be released
+		if err := c.releaseAddress(publicIP); err != nil {
 			return err
 		}
-		if len(addressOutput.Addresses) == 0 {
-			return ErrNoAddressFound
-		}
-		releaseAddressInput := &ec2.ReleaseAddressInput{
-			AllocationId: aws.String(*addressOutput.Addresses[0].AllocationId),
-		}
-		if _, err = c.ec2Client.ReleaseAddress(c.ctx, releaseAddressInput); err != nil {
+	}
+	input := &ec2.TerminateInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+	if _, err := c.ec2Client.TerminateInstances(c.ctx, input); err != nil {
+		return err
+	}
+	waiter := ec2.NewInstanceTerminatedWaiter(c.ec2Client)
+	return waiter.Wait(c.ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}}, constants.CloudOperationTimeout)
+}
+
+// releaseAddress disassociates the Elastic IP address [publicIP] from its instance, if any, and releases it.
+func (c *AwsCloud) releaseAddress(publicIP string) error {
+	describeAddressInput := &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("public-ip"), Values: []string{publicIP}},
+		},
+	}
+	addressOutput, err := c.ec2Client.DescribeAddresses(c.ctx, describeAddressInput)
+	if err != nil {
+		return err
+	}
+	if len(addressOutput.Addresses) == 0 {
+		return ErrNoAddressFound
+	}
+	address := addressOutput.Addresses[0]
+	if address.AssociationId != nil {
+		if _, err := c.ec2Client.DisassociateAddress(c.ctx, &ec2.DisassociateAddressInput{
+			AssociationId: address.AssociationId,
+		}); err != nil {
 			return err
 		}
 	}
-	return nil
+	releaseAddressInput := &ec2.ReleaseAddressInput{
+		AllocationId: aws.String(*address.AllocationId),
+	}
+	_, err = c.ec2Client.ReleaseAddress(c.ctx, releaseAddressInput)
+	return err
 }
 
 // CreateEIP creates an Elastic IP address.
@@ -368,7 +383,12 @@ func (c *AwsCloud) UploadSSHIdentityKeyPair(keyName string, identity string) err
 	if err != nil {
 		return err
 	}
-	_, err = c.ec2Client.ImportKeyPair(c.ctx, &ec2.ImportKeyPairInput{
+	return c.ImportKeyPair(keyName, publicKeyMaterial)
+}
+
+// ImportKeyPair uploads the public key [publicKeyMaterial] as key pair [keyName] to the AWS cloud.
+func (c *AwsCloud) ImportKeyPair(keyName string, publicKeyMaterial string) error {
+	_, err := c.ec2Client.ImportKeyPair(c.ctx, &ec2.ImportKeyPairInput{
 		KeyName:           aws.String(keyName),
 		PublicKeyMaterial: []byte(publicKeyMaterial),
 	})
@@ -399,6 +419,14 @@ func (c *AwsCloud) SetupSecurityGroup(ipAddress, securityGroupName string) (stri
 	return sgID, nil
 }
 
+// DeleteSecurityGroup deletes the security group [groupID].
+func (c *AwsCloud) DeleteSecurityGroup(groupID string) error {
+	_, err := c.ec2Client.DeleteSecurityGroup(c.ctx, &ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(groupID),
+	})
+	return err
+}
+
 // CheckUserIPInSg checks if the user IP is present in the SecurityGroup.
 func CheckUserIPInSg(sg *types.SecurityGroup, currentIP string, port int32) bool {
 	for _, ipPermission := range sg.IpPermissions {
@@ -428,6 +456,14 @@ func (c *AwsCloud) CheckKeyPairExists(kpName string) (bool, error) {
 	return true, nil
 }
 
+// DeleteKeyPair deletes the key pair [kpName] from the AWS Cloud.
+func (c *AwsCloud) DeleteKeyPair(kpName string) error {
+	_, err := c.ec2Client.DeleteKeyPair(c.ctx, &ec2.DeleteKeyPairInput{
+		KeyName: aws.String(kpName),
+	})
+	return err
+}
+
 // GetUbuntuAMIID returns the ID of the latest Ubuntu Amazon Machine Image (AMI).
 func (c *AwsCloud) GetUbuntuAMIID() (string, error) {
 	descriptionFilterValue := "Canonical, Ubuntu, 20.04 LTS, amd64*"
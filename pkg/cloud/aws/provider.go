@@ -0,0 +1,236 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aws
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+)
+
+var _ cloud.Provider = (*Provider)(nil)
+
+// Provider implements cloud.Provider on AWS, where locations are regions
+type Provider struct {
+	awsProfile string
+	lock       sync.Mutex
+	clouds     map[string]*AwsCloud
+}
+
+// NewProvider creates an AWS provider using the credentials of [awsProfile]
+func NewProvider(awsProfile string) *Provider {
+	return &Provider{
+		awsProfile: awsProfile,
+		clouds:     map[string]*AwsCloud{},
+	}
+}
+
+// Cloud returns the AWS cloud client for [region]
+func (p *Provider) Cloud(region string) (*AwsCloud, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if c, ok := p.clouds[region]; ok {
+		return c, nil
+	}
+	c, err := NewAwsCloud(p.awsProfile, region)
+	if err != nil {
+		return nil, err
+	}
+	p.clouds[region] = c
+	return c, nil
+}
+
+func (*Provider) Name() string {
+	return constants.AWSCloudService
+}
+
+func (p *Provider) GetUbuntuImageID(region string) (string, error) {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return "", err
+	}
+	return c.GetUbuntuAMIID()
+}
+
+func (p *Provider) CheckSSHKeyExists(region, keyName string) (bool, error) {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return false, err
+	}
+	return c.CheckKeyPairExists(keyName)
+}
+
+func (p *Provider) ImportSSHKey(region, keyName, publicKey string) error {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return err
+	}
+	return c.ImportKeyPair(keyName, publicKey)
+}
+
+func (p *Provider) SetupSecurityGroup(region, name, ipAddress string) (string, error) {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return "", err
+	}
+	exists, sg, err := c.CheckSecurityGroupExists(name)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return c.SetupSecurityGroup(ipAddress, name)
+	}
+	for _, port := range []int32{
+		constants.SSHTCPPort,
+		constants.OdysseygoAPIPort,
+		constants.OdysseygoMonitoringPort,
+		constants.OdysseygoGrafanaPort,
+	} {
+		if !CheckUserIPInSg(&sg, ipAddress, port) {
+			if err := c.AddSecurityGroupRule(*sg.GroupId, "ingress", "tcp", ipAddress, port); err != nil {
+				return "", err
+			}
+		}
+	}
+	return *sg.GroupId, nil
+}
+
+func (p *Provider) AddSecurityGroupRule(region, name, ipAddress string, ports []int) error {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return err
+	}
+	exists, sg, err := c.CheckSecurityGroupExists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("security group %s doesn't exist in region %s", name, region)
+	}
+	for _, port := range ports {
+		if !CheckUserIPInSg(&sg, ipAddress, int32(port)) {
+			if err := c.AddSecurityGroupRule(*sg.GroupId, "ingress", "tcp", ipAddress, int32(port)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Provider) AllocateStaticIPs(region, _ string, count int) ([]cloud.StaticIP, error) {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return nil, err
+	}
+	staticIPs := []cloud.StaticIP{}
+	for i := 0; i < count; i++ {
+		allocationID, publicIP, err := c.CreateEIP()
+		if err != nil {
+			return nil, err
+		}
+		staticIPs = append(staticIPs, cloud.StaticIP{ID: allocationID, Address: publicIP})
+	}
+	return staticIPs, nil
+}
+
+// CreateInstances creates the EC2 instances of [spec]. Elastic IPs can only be associated
+// to running instances, so when static IPs are given it also waits for the instances.
+func (p *Provider) CreateInstances(spec cloud.InstanceSpec) ([]string, error) {
+	c, err := p.Cloud(spec.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.StaticIPs) > 0 && len(spec.StaticIPs) != spec.Count {
+		return nil, fmt.Errorf("expected %d static IPs, got %d", spec.Count, len(spec.StaticIPs))
+	}
+	instanceIDs, err := c.CreateEC2Instances(
+		spec.Count,
+		spec.ImageID,
+		spec.InstanceType,
+		spec.SSHKeyName,
+		spec.SecurityGroup,
+		spec.ForMonitoring,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.StaticIPs) == 0 {
+		return instanceIDs, nil
+	}
+	if err := c.WaitForEC2Instances(instanceIDs); err != nil {
+		return instanceIDs, err
+	}
+	for i, instanceID := range instanceIDs {
+		if err := c.AssociateEIP(instanceID, spec.StaticIPs[i].ID); err != nil {
+			return instanceIDs, err
+		}
+	}
+	return instanceIDs, nil
+}
+
+func (p *Provider) WaitForInstances(region string, instanceIDs []string) error {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return err
+	}
+	return c.WaitForEC2Instances(instanceIDs)
+}
+
+func (p *Provider) GetInstancePublicIPs(region string, instanceIDs []string) (map[string]string, error) {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetInstancePublicIPs(instanceIDs)
+}
+
+func (p *Provider) StopInstance(nodeConfig models.NodeConfig) error {
+	c, err := p.Cloud(nodeConfig.Region)
+	if err != nil {
+		return err
+	}
+	isRunning, err := c.checkInstanceIsRunning(nodeConfig.NodeID)
+	if err != nil {
+		return err
+	}
+	if !isRunning {
+		return fmt.Errorf("%w: instance %s", ErrNodeNotFoundToBeRunning, nodeConfig.NodeID)
+	}
+	return c.StopInstance(nodeConfig.NodeID, nodeConfig.ElasticIP, nodeConfig.UseStaticIP)
+}
+
+func (p *Provider) TerminateInstance(nodeConfig models.NodeConfig) error {
+	c, err := p.Cloud(nodeConfig.Region)
+	if err != nil {
+		return err
+	}
+	return c.TerminateInstance(nodeConfig.NodeID, nodeConfig.ElasticIP, nodeConfig.UseStaticIP && nodeConfig.ElasticIP != "")
+}
+
+func (p *Provider) DeleteSecurityGroup(region, name string) error {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return err
+	}
+	exists, sg, err := c.CheckSecurityGroupExists(name)
+	if err != nil || !exists {
+		return err
+	}
+	return c.DeleteSecurityGroup(*sg.GroupId)
+}
+
+func (p *Provider) DeleteSSHKey(region, keyName string) error {
+	c, err := p.Cloud(region)
+	if err != nil {
+		return err
+	}
+	exists, err := c.CheckKeyPairExists(keyName)
+	if err != nil || !exists {
+		return err
+	}
+	return c.DeleteKeyPair(keyName)
+}
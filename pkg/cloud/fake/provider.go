@@ -0,0 +1,343 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fake provides an in-memory cloud.Provider, to exercise the node
+// provisioning flows without cloud credentials.
+package fake
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// CloudService is the name of the fake cloud
+	CloudService = "Fake Cloud"
+
+	InstanceRunning    = "running"
+	InstanceStopped    = "stopped"
+	InstanceTerminated = "terminated"
+)
+
+var _ cloud.Provider = (*Provider)(nil)
+
+// Instance is an instance created in the fake cloud
+type Instance struct {
+	ID       string
+	Location string
+	State    string
+	PublicIP string
+	StaticIP *cloud.StaticIP
+	Spec     cloud.InstanceSpec
+}
+
+// SecurityGroup is a security group created in the fake cloud
+type SecurityGroup struct {
+	ID    string
+	Name  string
+	Rules map[string][]int // maps allowed IP to its allowed ports
+}
+
+// Provider is a cloud.Provider keeping its resources in memory.
+// Errors can be injected per method name through Fail.
+type Provider struct {
+	lock           sync.Mutex
+	nextID         int
+	Images         map[string]string // maps location to image ID
+	SSHKeys        map[string]string // maps location/keyName to public key
+	SecurityGroups map[string]*SecurityGroup
+	StaticIPs      map[string]*cloud.StaticIP // maps static IP ID to static IP
+	Instances      map[string]*Instance
+	Fail           map[string]error // maps method name to the error it returns
+}
+
+// NewProvider creates an empty fake cloud
+func NewProvider() *Provider {
+	return &Provider{
+		Images:         map[string]string{},
+		SSHKeys:        map[string]string{},
+		SecurityGroups: map[string]*SecurityGroup{},
+		StaticIPs:      map[string]*cloud.StaticIP{},
+		Instances:      map[string]*Instance{},
+		Fail:           map[string]error{},
+	}
+}
+
+func (p *Provider) newID(prefix string) string {
+	p.nextID++
+	return fmt.Sprintf("%s-%d", prefix, p.nextID)
+}
+
+// newIP returns a distinct public IP in the documentation range 203.0.113.0/24
+func (p *Provider) newIP() string {
+	p.nextID++
+	return fmt.Sprintf("203.0.113.%d", p.nextID%256)
+}
+
+func (*Provider) Name() string {
+	return CloudService
+}
+
+func (p *Provider) GetUbuntuImageID(location string) (string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["GetUbuntuImageID"]; err != nil {
+		return "", err
+	}
+	if imageID, ok := p.Images[location]; ok {
+		return imageID, nil
+	}
+	return "ubuntu-" + location, nil
+}
+
+func (p *Provider) CheckSSHKeyExists(location, keyName string) (bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["CheckSSHKeyExists"]; err != nil {
+		return false, err
+	}
+	_, ok := p.SSHKeys[location+"/"+keyName]
+	return ok, nil
+}
+
+func (p *Provider) ImportSSHKey(location, keyName, publicKey string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["ImportSSHKey"]; err != nil {
+		return err
+	}
+	if _, ok := p.SSHKeys[location+"/"+keyName]; ok {
+		return fmt.Errorf("key pair %s already exists in %s", keyName, location)
+	}
+	p.SSHKeys[location+"/"+keyName] = publicKey
+	return nil
+}
+
+func (p *Provider) SetupSecurityGroup(location, name, ipAddress string) (string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["SetupSecurityGroup"]; err != nil {
+		return "", err
+	}
+	sg, ok := p.SecurityGroups[location+"/"+name]
+	if !ok {
+		sg = &SecurityGroup{
+			ID:    p.newID("sg"),
+			Name:  name,
+			Rules: map[string][]int{},
+		}
+		p.SecurityGroups[location+"/"+name] = sg
+	}
+	sg.allow(ipAddress, []int{
+		constants.SSHTCPPort,
+		constants.OdysseygoAPIPort,
+		constants.OdysseygoMonitoringPort,
+		constants.OdysseygoGrafanaPort,
+	})
+	return sg.ID, nil
+}
+
+func (p *Provider) AddSecurityGroupRule(location, name, ipAddress string, ports []int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["AddSecurityGroupRule"]; err != nil {
+		return err
+	}
+	sg, ok := p.SecurityGroups[location+"/"+name]
+	if !ok {
+		return fmt.Errorf("security group %s doesn't exist in %s", name, location)
+	}
+	sg.allow(ipAddress, ports)
+	return nil
+}
+
+func (sg *SecurityGroup) allow(ipAddress string, ports []int) {
+	for _, port := range ports {
+		if !slices.Contains(sg.Rules[ipAddress], port) {
+			sg.Rules[ipAddress] = append(sg.Rules[ipAddress], port)
+		}
+	}
+}
+
+func (p *Provider) AllocateStaticIPs(_, _ string, count int) ([]cloud.StaticIP, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["AllocateStaticIPs"]; err != nil {
+		return nil, err
+	}
+	staticIPs := []cloud.StaticIP{}
+	for i := 0; i < count; i++ {
+		staticIP := cloud.StaticIP{ID: p.newID("ip"), Address: p.newIP()}
+		p.StaticIPs[staticIP.ID] = &staticIP
+		staticIPs = append(staticIPs, staticIP)
+	}
+	return staticIPs, nil
+}
+
+func (p *Provider) CreateInstances(spec cloud.InstanceSpec) ([]string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["CreateInstances"]; err != nil {
+		return nil, err
+	}
+	if len(spec.StaticIPs) > 0 && len(spec.StaticIPs) != spec.Count {
+		return nil, fmt.Errorf("expected %d static IPs, got %d", spec.Count, len(spec.StaticIPs))
+	}
+	instanceIDs := []string{}
+	for i := 0; i < spec.Count; i++ {
+		instance := &Instance{
+			ID:       p.newID("i"),
+			Location: spec.Location,
+			State:    InstanceRunning,
+			Spec:     spec,
+		}
+		if len(spec.StaticIPs) > 0 {
+			staticIP, ok := p.StaticIPs[spec.StaticIPs[i].ID]
+			if !ok {
+				return nil, fmt.Errorf("static IP %s not found", spec.StaticIPs[i].ID)
+			}
+			instance.StaticIP = staticIP
+			instance.PublicIP = staticIP.Address
+		} else {
+			instance.PublicIP = p.newIP()
+		}
+		p.Instances[instance.ID] = instance
+		instanceIDs = append(instanceIDs, instance.ID)
+	}
+	return instanceIDs, nil
+}
+
+func (p *Provider) WaitForInstances(location string, instanceIDs []string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["WaitForInstances"]; err != nil {
+		return err
+	}
+	for _, instanceID := range instanceIDs {
+		instance, err := p.getInstance(location, instanceID)
+		if err != nil {
+			return err
+		}
+		if instance.State != InstanceRunning {
+			return fmt.Errorf("instance %s is %s", instanceID, instance.State)
+		}
+	}
+	return nil
+}
+
+func (p *Provider) GetInstancePublicIPs(location string, instanceIDs []string) (map[string]string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["GetInstancePublicIPs"]; err != nil {
+		return nil, err
+	}
+	publicIPs := map[string]string{}
+	for _, instanceID := range instanceIDs {
+		instance, err := p.getInstance(location, instanceID)
+		if err != nil {
+			return nil, err
+		}
+		publicIPs[instanceID] = instance.PublicIP
+	}
+	return publicIPs, nil
+}
+
+func (p *Provider) StopInstance(nodeConfig models.NodeConfig) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["StopInstance"]; err != nil {
+		return err
+	}
+	instance, err := p.getInstance(nodeConfig.Region, nodeConfig.NodeID)
+	if err != nil {
+		return err
+	}
+	if instance.State != InstanceRunning {
+		return fmt.Errorf("%w: instance %s", cloud.ErrNodeNotFoundToBeRunning, nodeConfig.NodeID)
+	}
+	instance.State = InstanceStopped
+	instance.PublicIP = ""
+	if nodeConfig.UseStaticIP {
+		p.releaseStaticIP(instance)
+	}
+	return nil
+}
+
+func (p *Provider) TerminateInstance(nodeConfig models.NodeConfig) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["TerminateInstance"]; err != nil {
+		return err
+	}
+	instance, err := p.getInstance(nodeConfig.Region, nodeConfig.NodeID)
+	if err != nil {
+		return err
+	}
+	instance.State = InstanceTerminated
+	instance.PublicIP = ""
+	if nodeConfig.UseStaticIP {
+		p.releaseStaticIP(instance)
+	}
+	return nil
+}
+
+func (p *Provider) DeleteSecurityGroup(location, name string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["DeleteSecurityGroup"]; err != nil {
+		return err
+	}
+	sg, ok := p.SecurityGroups[location+"/"+name]
+	if !ok {
+		return nil
+	}
+	for _, instance := range p.Instances {
+		if instance.Spec.SecurityGroup == sg.ID && instance.State != InstanceTerminated {
+			return fmt.Errorf("security group %s is in use by instance %s", name, instance.ID)
+		}
+	}
+	delete(p.SecurityGroups, location+"/"+name)
+	return nil
+}
+
+func (p *Provider) DeleteSSHKey(location, keyName string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.Fail["DeleteSSHKey"]; err != nil {
+		return err
+	}
+	delete(p.SSHKeys, location+"/"+keyName)
+	return nil
+}
+
+// GetRunningInstanceByIP returns a copy of the running instance with public IP [ip], if any
+func (p *Provider) GetRunningInstanceByIP(ip string) (Instance, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, instance := range p.Instances {
+		if instance.State == InstanceRunning && instance.PublicIP == ip {
+			return *instance, true
+		}
+	}
+	return Instance{}, false
+}
+
+func (p *Provider) releaseStaticIP(instance *Instance) {
+	if instance.StaticIP != nil {
+		delete(p.StaticIPs, instance.StaticIP.ID)
+		instance.StaticIP = nil
+	}
+}
+
+func (p *Provider) getInstance(location, instanceID string) (*Instance, error) {
+	instance, ok := p.Instances[instanceID]
+	if !ok || instance.Location != location {
+		return nil, fmt.Errorf("instance %s not found in %s", instanceID, location)
+	}
+	return instance, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderLifecycle(t *testing.T) {
+	require := require.New(t)
+	p := NewProvider()
+
+	sgID, err := p.SetupSecurityGroup("loc", "sg", "198.51.100.1")
+	require.NoError(err)
+	sgID2, err := p.SetupSecurityGroup("loc", "sg", "198.51.100.2")
+	require.NoError(err)
+	require.Equal(sgID, sgID2)
+	require.Len(p.SecurityGroups["loc/sg"].Rules, 2)
+	require.NoError(p.AddSecurityGroupRule("loc", "sg", "198.51.100.3", []int{9650}))
+	require.Error(p.AddSecurityGroupRule("other", "sg", "198.51.100.3", []int{9650}))
+
+	staticIPs, err := p.AllocateStaticIPs("loc", "node", 2)
+	require.NoError(err)
+	instanceIDs, err := p.CreateInstances(cloud.InstanceSpec{
+		Location:      "loc",
+		Count:         2,
+		SecurityGroup: sgID,
+		StaticIPs:     staticIPs,
+	})
+	require.NoError(err)
+	require.Len(instanceIDs, 2)
+	require.NoError(p.WaitForInstances("loc", instanceIDs))
+	publicIPs, err := p.GetInstancePublicIPs("loc", instanceIDs)
+	require.NoError(err)
+	require.Equal(staticIPs[0].Address, publicIPs[instanceIDs[0]])
+	require.Equal(staticIPs[1].Address, publicIPs[instanceIDs[1]])
+
+	stopped := models.NodeConfig{NodeID: instanceIDs[0], Region: "loc", UseStaticIP: true}
+	require.NoError(p.StopInstance(stopped))
+	require.ErrorIs(p.StopInstance(stopped), cloud.ErrNodeNotFoundToBeRunning)
+	require.NotContains(p.StaticIPs, staticIPs[0].ID)
+	require.Contains(p.StaticIPs, staticIPs[1].ID)
+
+	require.NoError(p.TerminateInstance(models.NodeConfig{NodeID: instanceIDs[1], Region: "loc", UseStaticIP: true}))
+	require.Equal(InstanceTerminated, p.Instances[instanceIDs[1]].State)
+	require.Empty(p.StaticIPs)
+}
+
+func TestProviderFail(t *testing.T) {
+	require := require.New(t)
+	p := NewProvider()
+	errCreate := errors.New("quota exceeded")
+	p.Fail["CreateInstances"] = errCreate
+	_, err := p.CreateInstances(cloud.InstanceSpec{Location: "loc", Count: 1})
+	require.ErrorIs(err, errCreate)
+	require.Empty(p.Instances)
+}
@@ -5,7 +5,6 @@ package gcp
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -16,9 +15,8 @@ import (
 
 	"google.golang.org/api/compute/v1"
 
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
-	"github.com/DioneProtocol/odyssey-cli/pkg/models"
-	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 )
 
 const (
@@ -27,7 +25,7 @@ const (
 	opScopeGlobal = "global"
 )
 
-var ErrNodeNotFoundToBeRunning = errors.New("node not found to be running")
+var ErrNodeNotFoundToBeRunning = cloud.ErrNodeNotFoundToBeRunning
 
 type GcpCloud struct {
 	gcpClient *compute.Service
@@ -163,6 +161,31 @@ func (c *GcpCloud) SetupNetwork(ipAddress, networkName string) (*compute.Network
 	return createdNetwork, nil
 }
 
+// DeleteNetwork deletes network [networkName] in GCP with all its firewall rules
+func (c *GcpCloud) DeleteNetwork(networkName string) error {
+	firewallList, err := c.gcpClient.Firewalls.List(c.projectID).Do()
+	if err != nil {
+		return err
+	}
+	for _, firewall := range firewallList.Items {
+		if getNameFromURL(firewall.Network) != networkName {
+			continue
+		}
+		deleteOp, err := c.gcpClient.Firewalls.Delete(c.projectID, firewall.Name).Do()
+		if err != nil {
+			return fmt.Errorf("error deleting firewall rule %s: %w", firewall.Name, err)
+		}
+		if err := c.waitForOperation(deleteOp); err != nil {
+			return err
+		}
+	}
+	deleteOp, err := c.gcpClient.Networks.Delete(c.projectID, networkName).Do()
+	if err != nil {
+		return fmt.Errorf("error deleting network %s: %w", networkName, err)
+	}
+	return c.waitForOperation(deleteOp)
+}
+
 // SetFirewallRule creates a new firewall rule in GCP
 func (c *GcpCloud) SetFirewallRule(ipAddress, firewallName, networkName string, ports []string) (*compute.Firewall, error) {
 	if !strings.Contains(ipAddress, "/") {
@@ -286,7 +309,7 @@ func (c *GcpCloud) SetupInstances(
 					AutomaticRestart: &automaticRestart,
 				},
 			}
-			if staticIP != nil {
+			if len(staticIP) > 0 {
 				instance.NetworkInterfaces[0].AccessConfigs[0].NatIP = staticIP[currentIndex]
 			}
 			insertOp, err := c.gcpClient.Instances.Insert(c.projectID, zone, instance).Do()
@@ -402,34 +425,70 @@ func (c *GcpCloud) checkInstanceIsRunning(zone, nodeID string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if instance.Status != "RUNNING" {
-		return false, fmt.Errorf("error %s is not running", nodeID)
+	return instance.Status == "RUNNING", nil
+}
+
+// WaitForInstances waits for the GCP instances [nodeIDs] in [zone] to be running
+func (c *GcpCloud) WaitForInstances(zone string, nodeIDs []string) error {
+	deadline := time.Now().Add(constants.CloudOperationTimeout)
+	for _, nodeID := range nodeIDs {
+		for {
+			isRunning, err := c.checkInstanceIsRunning(zone, nodeID)
+			if err != nil {
+				return err
+			}
+			if isRunning {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timeout waiting for instance %s to be running", nodeID)
+			}
+			time.Sleep(1 * time.Second)
+		}
 	}
-	return true, nil
+	return nil
 }
 
-// StopGCPNode stops GCP node in GCP
-func (c *GcpCloud) StopGCPNode(nodeConfig models.NodeConfig, clusterName string) error {
-	isRunning, err := c.checkInstanceIsRunning(nodeConfig.Region, nodeConfig.NodeID)
+// StopInstance stops GCP instance [nodeID] in [zone], releasing its static IP if [releaseStaticIP]
+func (c *GcpCloud) StopInstance(zone, nodeID string, releaseStaticIP bool) error {
+	isRunning, err := c.checkInstanceIsRunning(zone, nodeID)
 	if err != nil {
 		return err
 	}
 	if !isRunning {
-		return fmt.Errorf("%w: instance %s, cluster %s", ErrNodeNotFoundToBeRunning, nodeConfig.NodeID, clusterName)
+		return fmt.Errorf("%w: instance %s", ErrNodeNotFoundToBeRunning, nodeID)
 	}
-	ux.Logger.PrintToUser(fmt.Sprintf("Stopping node instance %s in cluster %s...", nodeConfig.NodeID, clusterName))
-	instancesStopCall := c.gcpClient.Instances.Stop(c.projectID, nodeConfig.Region, nodeConfig.NodeID)
+	instancesStopCall := c.gcpClient.Instances.Stop(c.projectID, zone, nodeID)
 	if _, err = instancesStopCall.Do(); err != nil {
 		return err
 	}
-	if nodeConfig.UseStaticIP {
-		ux.Logger.PrintToUser(fmt.Sprintf("Releasing static IP address %s ...", nodeConfig.ElasticIP))
-		// GCP node region is stored in format of "us-east1-b", we need "us-east1"
-		region := strings.Join(strings.Split(nodeConfig.Region, "-")[:2], "-")
-		addressReleaseCall := c.gcpClient.Addresses.Delete(c.projectID, region, fmt.Sprintf("%s-%s", constants.GCPStaticIPPrefix, nodeConfig.NodeID))
-		if _, err = addressReleaseCall.Do(); err != nil {
-			return fmt.Errorf("%s, %w", constants.ErrReleasingGCPStaticIP, err)
-		}
+	if releaseStaticIP {
+		return c.releaseStaticIP(zone, nodeID)
+	}
+	return nil
+}
+
+// DeleteInstance deletes GCP instance [nodeID] in [zone], releasing its static IP if [releaseStaticIP]
+func (c *GcpCloud) DeleteInstance(zone, nodeID string, releaseStaticIP bool) error {
+	deleteOp, err := c.gcpClient.Instances.Delete(c.projectID, zone, nodeID).Do()
+	if err != nil {
+		return err
+	}
+	// the static IP can only be released once the instance no longer uses it
+	if err := c.waitForOperation(deleteOp); err != nil {
+		return err
+	}
+	if releaseStaticIP {
+		return c.releaseStaticIP(zone, nodeID)
+	}
+	return nil
+}
+
+// releaseStaticIP releases the static IP created for GCP instance [nodeID] in [zone]
+func (c *GcpCloud) releaseStaticIP(zone, nodeID string) error {
+	addressReleaseCall := c.gcpClient.Addresses.Delete(c.projectID, zoneToRegion(zone), fmt.Sprintf("%s-%s", constants.GCPStaticIPPrefix, nodeID))
+	if _, err := addressReleaseCall.Do(); err != nil {
+		return fmt.Errorf("%s, %w", constants.ErrReleasingGCPStaticIP, err)
 	}
 	return nil
 }
@@ -0,0 +1,169 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/cloud"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+)
+
+var _ cloud.Provider = (*Provider)(nil)
+
+// Provider implements cloud.Provider on GCP, where locations are zones and
+// security groups are networks. SSH keys are not registered in the project
+// but given to each instance.
+type Provider struct {
+	*GcpCloud
+}
+
+// NewProvider creates a GCP provider for [gcpCloud]
+func NewProvider(gcpCloud *GcpCloud) *Provider {
+	return &Provider{GcpCloud: gcpCloud}
+}
+
+func (*Provider) Name() string {
+	return constants.GCPCloudService
+}
+
+func (p *Provider) GetUbuntuImageID(string) (string, error) {
+	return p.GcpCloud.GetUbuntuImageID()
+}
+
+func (*Provider) CheckSSHKeyExists(string, string) (bool, error) {
+	return false, nil
+}
+
+func (*Provider) ImportSSHKey(string, string, string) error {
+	return nil
+}
+
+func (p *Provider) SetupSecurityGroup(_, networkName, ipAddress string) (string, error) {
+	networkExists, err := p.CheckNetworkExists(networkName)
+	if err != nil {
+		return "", err
+	}
+	if !networkExists {
+		if _, err := p.SetupNetwork(ipAddress, networkName); err != nil {
+			return "", err
+		}
+		return networkName, nil
+	}
+	firewallName := fmt.Sprintf("%s-%s", networkName, strings.ReplaceAll(ipAddress, ".", ""))
+	firewallExists, err := p.CheckFirewallExists(firewallName, false)
+	if err != nil {
+		return "", err
+	}
+	if !firewallExists {
+		_, err := p.SetFirewallRule(
+			ipAddress,
+			firewallName,
+			networkName,
+			[]string{
+				strconv.Itoa(constants.SSHTCPPort),
+				strconv.Itoa(constants.OdysseygoAPIPort),
+				strconv.Itoa(constants.OdysseygoMonitoringPort),
+				strconv.Itoa(constants.OdysseygoGrafanaPort),
+			},
+		)
+		return networkName, err
+	}
+	firewallMonitoringName := fmt.Sprintf("%s-monitoring", firewallName)
+	// check that firewallName contains the monitoring ports
+	firewallContainsMonitoringPorts, err := p.CheckFirewallExists(firewallName, true)
+	if err != nil {
+		return "", err
+	}
+	// check that the separate monitoring firewall doesn't exist
+	firewallExists, err = p.CheckFirewallExists(firewallMonitoringName, false)
+	if err != nil {
+		return "", err
+	}
+	if !firewallContainsMonitoringPorts && !firewallExists {
+		if _, err := p.SetFirewallRule(
+			ipAddress,
+			firewallMonitoringName,
+			networkName,
+			[]string{strconv.Itoa(constants.OdysseygoMonitoringPort), strconv.Itoa(constants.OdysseygoGrafanaPort)},
+		); err != nil {
+			return "", err
+		}
+	}
+	return networkName, nil
+}
+
+func (p *Provider) AddSecurityGroupRule(_, networkName, ipAddress string, ports []int) error {
+	firewallName := fmt.Sprintf("%s-%s-monitoring", networkName, strings.ReplaceAll(ipAddress, ".", ""))
+	return p.AddFirewall(
+		ipAddress,
+		networkName,
+		p.projectID,
+		firewallName,
+		utils.Map(ports, strconv.Itoa),
+		false,
+	)
+}
+
+// AllocateStaticIPs creates [count] static IPs in the region of [zone], named after
+// the instances [namePrefix]-<i> they are meant for
+func (p *Provider) AllocateStaticIPs(zone, namePrefix string, count int) ([]cloud.StaticIP, error) {
+	addresses, err := p.SetPublicIP(zone, namePrefix, count)
+	if err != nil {
+		return nil, err
+	}
+	staticIPs := []cloud.StaticIP{}
+	for i, address := range addresses {
+		staticIPs = append(staticIPs, cloud.StaticIP{
+			ID:      fmt.Sprintf("%s-%s-%d", constants.GCPStaticIPPrefix, namePrefix, i),
+			Address: address,
+		})
+	}
+	return staticIPs, nil
+}
+
+func (p *Provider) CreateInstances(spec cloud.InstanceSpec) ([]string, error) {
+	if _, err := p.SetupInstances(
+		spec.Location,
+		spec.SecurityGroup,
+		spec.SSHPublicKey,
+		spec.ImageID,
+		spec.NamePrefix,
+		spec.InstanceType,
+		utils.Map(spec.StaticIPs, func(ip cloud.StaticIP) string { return ip.Address }),
+		spec.Count,
+		spec.ForMonitoring,
+	); err != nil {
+		return nil, err
+	}
+	instanceIDs := []string{}
+	for i := 0; i < spec.Count; i++ {
+		instanceIDs = append(instanceIDs, fmt.Sprintf("%s-%d", spec.NamePrefix, i))
+	}
+	return instanceIDs, nil
+}
+
+func (p *Provider) StopInstance(nodeConfig models.NodeConfig) error {
+	return p.GcpCloud.StopInstance(nodeConfig.Region, nodeConfig.NodeID, nodeConfig.UseStaticIP)
+}
+
+func (p *Provider) TerminateInstance(nodeConfig models.NodeConfig) error {
+	return p.DeleteInstance(nodeConfig.Region, nodeConfig.NodeID, nodeConfig.UseStaticIP)
+}
+
+func (p *Provider) DeleteSecurityGroup(_, networkName string) error {
+	networkExists, err := p.CheckNetworkExists(networkName)
+	if err != nil || !networkExists {
+		return err
+	}
+	return p.DeleteNetwork(networkName)
+}
+
+func (*Provider) DeleteSSHKey(string, string) error {
+	return nil
+}
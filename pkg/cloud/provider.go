@@ -0,0 +1,74 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cloud
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+)
+
+var ErrNodeNotFoundToBeRunning = errors.New("node not found to be running")
+
+// StaticIP is a static public IP allocated in a cloud
+type StaticIP struct {
+	// ID identifies the IP in the cloud (allocation ID in AWS, address name in GCP)
+	ID      string
+	Address string
+}
+
+// InstanceSpec describes a set of instances to be created in a location
+type InstanceSpec struct {
+	Location     string
+	Count        int
+	ImageID      string
+	InstanceType string
+	// NamePrefix is used to name the instances, in clouds where they are named by the caller
+	NamePrefix string
+	// SSHKeyName is the name of the key pair registered in the cloud to access the instances
+	SSHKeyName string
+	// SSHPublicKey is the public key to access the instances, in clouds without registered key pairs
+	SSHPublicKey string
+	// SecurityGroup is the ID of the security group (or network) the instances are placed in
+	SecurityGroup string
+	// StaticIPs, if given, are associated to the instances, one per instance
+	StaticIPs     []StaticIP
+	ForMonitoring bool
+}
+
+// Provider is a cloud service where node instances are provisioned.
+// Locations are the cloud specific placements of the instances, AWS regions or GCP zones.
+type Provider interface {
+	// Name returns the cloud service name, as stored in node configs
+	Name() string
+	// GetUbuntuImageID returns the ID of the Ubuntu image to use for instances in [location]
+	GetUbuntuImageID(location string) (string, error)
+	// CheckSSHKeyExists checks if the key pair [keyName] is registered in [location]
+	CheckSSHKeyExists(location, keyName string) (bool, error)
+	// ImportSSHKey registers [publicKey] as key pair [keyName] in [location]
+	ImportSSHKey(location, keyName, publicKey string) error
+	// SetupSecurityGroup makes sure security group [name] exists in [location] and gives
+	// [ipAddress] access to the SSH, API and monitoring ports. It returns the group ID.
+	SetupSecurityGroup(location, name, ipAddress string) (string, error)
+	// AddSecurityGroupRule gives [ipAddress] access to [ports] in security group [name] of [location]
+	AddSecurityGroupRule(location, name, ipAddress string, ports []int) error
+	// AllocateStaticIPs allocates [count] static public IPs in [location]
+	AllocateStaticIPs(location, namePrefix string, count int) ([]StaticIP, error)
+	// CreateInstances creates the instances described by [spec] and returns their IDs
+	CreateInstances(spec InstanceSpec) ([]string, error)
+	// WaitForInstances waits for [instanceIDs] in [location] to be running
+	WaitForInstances(location string, instanceIDs []string) error
+	// GetInstancePublicIPs returns a map from instance ID to public IP for [instanceIDs] in [location]
+	GetInstancePublicIPs(location string, instanceIDs []string) (map[string]string, error)
+	// StopInstance stops the instance of [nodeConfig], releasing its static IP if any.
+	// It returns ErrNodeNotFoundToBeRunning if the instance is not running.
+	StopInstance(nodeConfig models.NodeConfig) error
+	// TerminateInstance deletes the instance of [nodeConfig], releasing its static IP if any.
+	// It returns once the instance is gone.
+	TerminateInstance(nodeConfig models.NodeConfig) error
+	// DeleteSecurityGroup deletes security group [name] of [location], if it exists
+	DeleteSecurityGroup(location, name string) error
+	// DeleteSSHKey deletes key pair [keyName] of [location], if it exists
+	DeleteSSHKey(location, keyName string) error
+}
@@ -33,6 +33,9 @@ const (
 	GetAWSNodeIP                 = "get-aws-node-ip"
 	ClustersConfigFileName       = "cluster_config.json"
 	ClustersConfigVersion        = "1"
+	CustomNetworksFileName       = "networks.json"
+	CustomPrecompilesFileName    = "precompiles.json"
+	FeePresetsFileName           = "fee_presets.json"
 	StakerCertFileName           = "staker.crt"
 	StakerKeyFileName            = "staker.key"
 	BLSKeyFileName               = "signer.key"
@@ -153,6 +156,10 @@ const (
 	AnsibleExtraArgsIdentitiesOnlyFlag = "--ssh-extra-args='-o IdentitiesOnly=yes'"
 	AnsibleSSHShellParams              = "-o IdentitiesOnly=yes -o StrictHostKeyChecking=no"
 	AnsibleSSHUseAgentParams           = "-o StrictHostKeyChecking=no"
+	AnsibleSSHKnownHostsParams         = "-o StrictHostKeyChecking=yes -o UserKnownHostsFile=%s"
+	SSHIdentitiesOnlyParams            = "-o IdentitiesOnly=yes"
+	SSHKnownHostsParams                = "-o StrictHostKeyChecking=accept-new -o UserKnownHostsFile=%s"
+	SSHKnownHostsFileName              = "known_hosts"
 	AnsibleExtraVarsFlag               = "--extra-vars"
 
 	ConfigOPMCredentialsFileKey  = "credentials-file"
@@ -166,11 +173,14 @@ const (
 
 	AWSCloudService              = "Amazon Web Services"
 	GCPCloudService              = "Google Cloud Platform"
+	ExistingHostsService         = "Existing Hosts"
+	ExistingHostsRegion          = "existing-hosts"
 	AWSDefaultInstanceType       = "c5.2xlarge"
 	GCPDefaultInstanceType       = "e2-standard-8"
 	AnsibleSSHUser               = "ubuntu"
 	AWSNodeAnsiblePrefix         = "aws_node"
 	GCPNodeAnsiblePrefix         = "gcp_node"
+	ExistingNodeAnsiblePrefix    = "existing_node"
 	CustomVMDir                  = "vms"
 	GCPStaticIPPrefix            = "static-ip"
 	DioneProtocolOrg             = "DioneProtocol"
@@ -181,6 +191,8 @@ const (
 	SubnetEVMArchive             = "subnet-evm_%s_linux_amd64.tar.gz"
 	CloudNodeConfigBasePath      = "/home/ubuntu/.odysseygo/"
 	CloudNodeSubnetEvmBinaryPath = "/home/ubuntu/.odysseygo/plugins/%s"
+	CloudNodeOdysseyGoBinaryPath = "/home/ubuntu/odyssey-node/odysseygo"
+	CloudNodeBinaryBackupPath    = "/home/ubuntu/.odyssey-cli/backups/"
 	CloudNodeStakingPath         = "/home/ubuntu/.odysseygo/staking/"
 	CloudNodeConfigPath          = "/home/ubuntu/.odysseygo/configs/"
 	CloudNodeCLIConfigBasePath   = "/home/ubuntu/.odyssey-cli/"
@@ -205,6 +217,11 @@ const (
 
 	// #nosec G101
 	GithubAPITokenEnvVarName = "ODYSSEY_CLI_GITHUB_TOKEN"
+	// #nosec G101
+	KeyPassphraseEnvVarName = "ODYSSEY_CLI_KEY_PASSPHRASE"
+	// #nosec G101
+	KeyPassphraseFileEnvVarName = "ODYSSEY_CLI_KEY_PASSPHRASE_FILE"
+	NonInteractiveEnvVarName    = "ODYSSEY_NON_INTERACTIVE"
 
 	ReposDir                   = "repos"
 	SubnetDir                  = "subnets"
@@ -245,10 +262,12 @@ const (
 
 	PluginDir = "plugins"
 
-	Network        = "network"
-	MultiSig       = "multi-sig"
-	SkipUpdateFlag = "skip-update-check"
-	LastFileName   = ".last_actions.json"
+	Network            = "network"
+	MultiSig           = "multi-sig"
+	SkipUpdateFlag     = "skip-update-check"
+	OutputFlag         = "output"
+	NonInteractiveFlag = "non-interactive"
+	LastFileName       = ".last_actions.json"
 
 	DefaultWalletCreationTimeout = 5 * time.Second
 
@@ -41,6 +41,43 @@ const (
 	defaultUptimeRequirement                    = 0.8
 )
 
+const (
+	elasticConfigPrompt             = "How would you like to set fees"
+	initialSupplyPrompt             = "Initial Supply amount"
+	maxSupplyPrompt                 = "Maximum Supply amount"
+	minConsumptionRatePrompt        = "Minimum Consumption Rate"
+	maxConsumptionRatePrompt        = "Maximum Consumption Rate"
+	minValidatorStakePrompt         = "Minimum Validator Stake"
+	maxValidatorStakePrompt         = "Maximum Validator Stake"
+	minValidatorStakeDurationPrompt = "Minimum Validator Stake Duration"
+	maxValidatorStakeDurationPrompt = "Maximum Validator Stake Duration"
+	minDelegatorStakeDurationPrompt = "Minimum Delegator Stake Duration"
+	maxDelegatorStakeDurationPrompt = "Maximum Delegator Stake Duration"
+	minDelegationFeePrompt          = "Minimum Delegation Fee"
+	minDelegatorStakePrompt         = "Minimum Delegator Stake"
+	maxValidatorWeightFactorPrompt  = "Maximum Validator Weight Factor"
+	uptimeRequirementPrompt         = "Uptime Requirement"
+)
+
+// AnswerKeys are the stable answers file keys of the elastic subnet config prompts
+var AnswerKeys = prompts.AnswerKeys{
+	elasticConfigPrompt:             "elastic.config",
+	initialSupplyPrompt:             "elastic.initialSupply",
+	maxSupplyPrompt:                 "elastic.maxSupply",
+	minConsumptionRatePrompt:        "elastic.minConsumptionRate",
+	maxConsumptionRatePrompt:        "elastic.maxConsumptionRate",
+	minValidatorStakePrompt:         "elastic.minValidatorStake",
+	maxValidatorStakePrompt:         "elastic.maxValidatorStake",
+	minValidatorStakeDurationPrompt: "elastic.minValidatorStakeDuration",
+	maxValidatorStakeDurationPrompt: "elastic.maxValidatorStakeDuration",
+	minDelegatorStakeDurationPrompt: "elastic.minDelegatorStakeDuration",
+	maxDelegatorStakeDurationPrompt: "elastic.maxDelegatorStakeDuration",
+	minDelegationFeePrompt:          "elastic.minDelegationFee",
+	minDelegatorStakePrompt:         "elastic.minDelegatorStake",
+	maxValidatorWeightFactorPrompt:  "elastic.maxValidatorWeightFactor",
+	uptimeRequirementPrompt:         "elastic.uptimeRequirement",
+}
+
 func GetElasticSubnetConfig(app *application.Odyssey, tokenSymbol string, useDefaultConfig bool) (models.ElasticSubnetConfig, error) {
 	const (
 		defaultConfig   = "Use default elastic subnet config"
@@ -67,7 +104,7 @@ func GetElasticSubnetConfig(app *application.Odyssey, tokenSymbol string, useDef
 	}
 	elasticSubnetConfigOptions := []string{defaultConfig, customizeConfig}
 	chosenConfig, err := app.Prompt.CaptureList(
-		"How would you like to set fees",
+		elasticConfigPrompt,
 		elasticSubnetConfigOptions,
 	)
 	if err != nil {
@@ -148,7 +185,7 @@ func getCustomElasticSubnetConfig(app *application.Odyssey, tokenSymbol string)
 func getInitialSupply(app *application.Odyssey, tokenName string) (uint64, error) {
 	ux.Logger.PrintToUser(fmt.Sprintf("Select the Initial Supply of %s. \"_\" can be used as thousand separator", tokenName))
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Initial Supply is %s", ux.ConvertToStringWithThousandSeparator(defaultInitialSupply)))
-	initialSupply, err := app.Prompt.CaptureUint64("Initial Supply amount")
+	initialSupply, err := app.Prompt.CaptureUint64(initialSupplyPrompt)
 	if err != nil {
 		return 0, err
 	}
@@ -159,7 +196,7 @@ func getMaximumSupply(app *application.Odyssey, tokenName string, initialSupply
 	ux.Logger.PrintToUser(fmt.Sprintf("Select the Maximum Supply of %s. \"_\" can be used as thousand separator", tokenName))
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Supply is %s", ux.ConvertToStringWithThousandSeparator(defaultMaximumSupply)))
 	maxSupply, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Supply amount",
+		maxSupplyPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Initial Supply",
@@ -179,7 +216,7 @@ func getConsumptionRate(app *application.Odyssey) (uint64, uint64, error) {
 	ux.Logger.PrintToUser("To denominate your percentage in PercentDenominator just multiply it by 10_000. For example, 1 percent corresponds to 10_000")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Consumption Rate is %s", ux.ConvertToStringWithThousandSeparator(uint64(defaultMinConsumptionRate*reward.PercentDenominator))))
 	minConsumptionRate, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Consumption Rate",
+		minConsumptionRatePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Percent Denominator(1_0000_0000)",
@@ -196,7 +233,7 @@ func getConsumptionRate(app *application.Odyssey) (uint64, uint64, error) {
 	ux.Logger.PrintToUser("To denominate your percentage in PercentDenominator just multiply it by 10_000. For example, 1 percent corresponds to 10_000")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Consumption Rate is %s", ux.ConvertToStringWithThousandSeparator(uint64(defaultMaxConsumptionRate*reward.PercentDenominator))))
 	maxConsumptionRate, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Consumption Rate",
+		maxConsumptionRatePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Percent Denominator(1_0000_0000)",
@@ -220,7 +257,7 @@ func getValidatorStake(app *application.Odyssey, initialSupply uint64, maximumSu
 	ux.Logger.PrintToUser("Select the Minimum Validator Stake. \"_\" can be used as thousand separator")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Validator Stake is %s", ux.ConvertToStringWithThousandSeparator(defaultMinValidatorStake)))
 	minValidatorStake, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Validator Stake",
+		minValidatorStakePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Initial Supply",
@@ -241,7 +278,7 @@ func getValidatorStake(app *application.Odyssey, initialSupply uint64, maximumSu
 	ux.Logger.PrintToUser("Select the Maximum Validator Stake. \"_\" can be used as thousand separator")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Validator Stake is %s", ux.ConvertToStringWithThousandSeparator(defaultMaxValidatorStake)))
 	maxValidatorStake, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Validator Stake",
+		maxValidatorStakePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Maximum Supply",
@@ -265,7 +302,7 @@ func getValidatorStakeDuration(app *application.Odyssey) (time.Duration, time.Du
 	ux.Logger.PrintToUser("Select the Minimum Stake Duration. Please enter in units of hours")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Stake Duration is %d (%s)", defaultMinValidatorStakeDurationHours, defaultMinValidatorStakeDurationHoursString))
 	minStakeDuration, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Stake Duration",
+		minValidatorStakeDurationPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "0",
@@ -286,7 +323,7 @@ func getValidatorStakeDuration(app *application.Odyssey) (time.Duration, time.Du
 	ux.Logger.PrintToUser("Select the Maximum Stake Duration")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Stake Duration is %d (%s)", defaultMaxValidatorStakeDurationHours, defaultMaxValidatorStakeDurationHoursString))
 	maxStakeDuration, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Stake Duration",
+		maxValidatorStakeDurationPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Minimum Stake Duration",
@@ -311,7 +348,7 @@ func getDelegatorStakeDuration(app *application.Odyssey) (time.Duration, time.Du
 	ux.Logger.PrintToUser("Select the Minimum Stake Duration. Please enter in units of hours")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Stake Duration is %d (%s)", defaultMinDelegatorStakeDurationHours, defaultMinDelegatorStakeDurationHoursString))
 	minStakeDuration, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Stake Duration",
+		minDelegatorStakeDurationPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "0",
@@ -332,7 +369,7 @@ func getDelegatorStakeDuration(app *application.Odyssey) (time.Duration, time.Du
 	ux.Logger.PrintToUser("Select the Maximum Stake Duration")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Stake Duration is %d (%s)", defaultMaxDelegatorStakeDurationHours, defaultMaxDelegatorStakeDurationHoursString))
 	maxStakeDuration, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Stake Duration",
+		maxDelegatorStakeDurationPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Minimum Stake Duration",
@@ -358,7 +395,7 @@ func getMinDelegationFee(app *application.Odyssey) (uint32, error) {
 	ux.Logger.PrintToUser("To denominate your percentage in PercentDenominator just multiply it by 10_000. For example, 1 percent corresponds to 10_000")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Delegation Fee is %s", ux.ConvertToStringWithThousandSeparator(uint64(defaultMinDelegationFee))))
 	minDelegationFee, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Delegation Fee",
+		minDelegationFeePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Percent Denominator(1_0000_0000)",
@@ -380,7 +417,7 @@ func getMinDelegatorStake(app *application.Odyssey) (uint64, error) {
 	ux.Logger.PrintToUser("Select the Minimum Delegator Stake")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Minimum Delegator Stake is %d", defaultMinDelegatorStake))
 	minDelegatorStake, err := app.Prompt.CaptureUint64Compare(
-		"Minimum Delegator Stake",
+		minDelegatorStakePrompt,
 		[]prompts.Comparator{
 			{
 				Label: "0",
@@ -399,7 +436,7 @@ func getMaxValidatorWeightFactor(app *application.Odyssey) (byte, error) {
 	ux.Logger.PrintToUser("Select the Maximum Validator Weight Factor. A value of 1 effectively disables delegation")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Maximum Validator Weight Factor is %d", defaultMaxValidatorWeightFactor))
 	maxValidatorWeightFactor, err := app.Prompt.CaptureUint64Compare(
-		"Maximum Validator Weight Factor",
+		maxValidatorWeightFactorPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "0",
@@ -422,7 +459,7 @@ func getUptimeRequirement(app *application.Odyssey) (uint32, error) {
 	ux.Logger.PrintToUser("To denominate your percentage in PercentDenominator just multiply it by 10_000. For example, 1 percent corresponds to 10_000")
 	ux.Logger.PrintToUser(fmt.Sprintf("Mainnet Uptime Requirement is %s", ux.ConvertToStringWithThousandSeparator(uint64(defaultUptimeRequirement*reward.PercentDenominator))))
 	uptimeReq, err := app.Prompt.CaptureUint64Compare(
-		"Uptime Requirement",
+		uptimeRequirementPrompt,
 		[]prompts.Comparator{
 			{
 				Label: "Percent Denominator(1_0000_0000)",
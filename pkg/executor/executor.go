@@ -0,0 +1,161 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/olekukonko/tablewriter"
+)
+
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+var (
+	ErrTimeout = errors.New("operation timed out")
+	ErrSkipped = errors.New("not run because of a previous failure")
+)
+
+// Options control how an operation is run on a set of hosts
+type Options struct {
+	// maximum number of hosts the operation runs on at the same time. All hosts at once if 0
+	Concurrency int
+	// maximum duration of each attempt of the operation on a host. No limit if 0
+	Timeout time.Duration
+	// number of times the operation is attempted again on a host after failing
+	Retries int
+	// time to wait before attempting the operation again
+	RetryInterval time.Duration
+	// if false, the operation is not started on the remaining hosts once it fails on one
+	ContinueOnError bool
+}
+
+// Operation is run on each host. Its returned value is kept as the result of the host
+type Operation func(host *models.Host) (interface{}, error)
+
+// Run runs [op] on each of [hosts] as given by [opts], and returns one result for
+// each host, keyed by its node ID.
+//
+// An attempt that takes longer than [opts.Timeout] is reported as failed with
+// ErrTimeout as soon as the timeout expires. The SSH connection of the host is closed
+// to fail its remote commands, but the operation is left to return on its own, so the
+// host is not attempted again: two attempts never run on the same host at the same time.
+func Run(hosts []*models.Host, opts Options, op Operation) *models.NodeResults {
+	results := models.NodeResults{}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 || concurrency > len(hosts) {
+		concurrency = len(hosts)
+	}
+	sem := make(chan struct{}, concurrency)
+	failed := make(chan struct{})
+	failedOnce := sync.Once{}
+	wg := sync.WaitGroup{}
+	for _, host := range hosts {
+		sem <- struct{}{}
+		select {
+		case <-failed:
+			if !opts.ContinueOnError {
+				<-sem
+				results.AddResult(host.NodeID, nil, ErrSkipped)
+				continue
+			}
+		default:
+		}
+		wg.Add(1)
+		go func(host *models.Host) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			value, err := runWithRetries(host, opts, op)
+			if err != nil {
+				failedOnce.Do(func() { close(failed) })
+			}
+			results.AddResult(host.NodeID, value, err)
+		}(host)
+	}
+	wg.Wait()
+	return &results
+}
+
+func runWithRetries(host *models.Host, opts Options, op Operation) (interface{}, error) {
+	var (
+		value interface{}
+		err   error
+	)
+	for attempt := 0; attempt <= opts.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(opts.RetryInterval)
+		}
+		value, err = runWithTimeout(host, opts.Timeout, op)
+		if err == nil || errors.Is(err, ErrTimeout) {
+			return value, err
+		}
+	}
+	if opts.Retries > 0 {
+		err = fmt.Errorf("failed after %d attempts: %w", opts.Retries+1, err)
+	}
+	return value, err
+}
+
+func runWithTimeout(host *models.Host, timeout time.Duration, op Operation) (interface{}, error) {
+	if timeout <= 0 {
+		return op(host)
+	}
+	type result struct {
+		value interface{}
+		err   error
+	}
+	// buffered so that the operation can finish after a timeout
+	done := make(chan result, 1)
+	go func() {
+		value, err := op(host)
+		done <- result{value, err}
+	}()
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-time.After(timeout):
+		_ = host.Disconnect()
+		return nil, fmt.Errorf("%w after %s", ErrTimeout, timeout)
+	}
+}
+
+// GetStatus returns the status shown in the summary for a host that returned [err]
+func GetStatus(err error) string {
+	switch {
+	case err == nil:
+		return StatusSuccess
+	case errors.Is(err, ErrSkipped):
+		return StatusSkipped
+	default:
+		return StatusFailed
+	}
+}
+
+// PrintSummary shows the status of each host of [results], ordered by node ID
+func PrintSummary(results *models.NodeResults) {
+	nodeResults := append([]models.NodeResult{}, results.GetResults()...)
+	sort.Slice(nodeResults, func(i, j int) bool {
+		return nodeResults[i].NodeID < nodeResults[j].NodeID
+	})
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Node", "Status", "Error"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, result := range nodeResults {
+		errMsg := ""
+		if result.Err != nil {
+			errMsg = result.Err.Error()
+		}
+		table.Append([]string{result.NodeID, GetStatus(result.Err), errMsg})
+	}
+	table.Render()
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test error")
+
+func newTestHosts(n int) []*models.Host {
+	hosts := []*models.Host{}
+	for i := 0; i < n; i++ {
+		hosts = append(hosts, &models.Host{NodeID: fmt.Sprintf("aws_node_%d", i)})
+	}
+	return hosts
+}
+
+func TestRunConcurrency(t *testing.T) {
+	require := require.New(t)
+	var running, maxRunning int32
+	results := Run(newTestHosts(10), Options{Concurrency: 3}, func(host *models.Host) (interface{}, error) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return host.NodeID, nil
+	})
+	require.False(results.HasErrors())
+	require.Equal(10, results.Len())
+	require.Equal(int32(3), maxRunning)
+	require.Equal("aws_node_4", results.GetResultMap()["aws_node_4"])
+}
+
+func TestRunRetries(t *testing.T) {
+	require := require.New(t)
+	var attempts int32
+	results := Run(newTestHosts(1), Options{Retries: 2}, func(*models.Host) (interface{}, error) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return nil, errTest
+		}
+		return true, nil
+	})
+	require.False(results.HasErrors())
+	require.Equal(int32(3), attempts)
+
+	attempts = 0
+	results = Run(newTestHosts(1), Options{Retries: 1}, func(*models.Host) (interface{}, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errTest
+	})
+	require.Equal(int32(2), attempts)
+	require.ErrorIs(results.GetErrorHostMap()["aws_node_0"], errTest)
+}
+
+func TestRunTimeout(t *testing.T) {
+	require := require.New(t)
+	results := Run(newTestHosts(2), Options{Timeout: 10 * time.Millisecond}, func(host *models.Host) (interface{}, error) {
+		if host.NodeID == "aws_node_0" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		return nil, nil
+	})
+	errs := results.GetErrorHostMap()
+	require.Len(errs, 1)
+	require.ErrorIs(errs["aws_node_0"], ErrTimeout)
+}
+
+func TestRunContinueOnError(t *testing.T) {
+	require := require.New(t)
+	op := func(host *models.Host) (interface{}, error) {
+		if host.NodeID == "aws_node_1" {
+			return nil, errTest
+		}
+		return nil, nil
+	}
+
+	results := Run(newTestHosts(4), Options{Concurrency: 1}, op)
+	errs := results.GetErrorHostMap()
+	require.Len(errs, 3)
+	require.ErrorIs(errs["aws_node_1"], errTest)
+	require.ErrorIs(errs["aws_node_2"], ErrSkipped)
+	require.ErrorIs(errs["aws_node_3"], ErrSkipped)
+	require.Equal(StatusSkipped, GetStatus(errs["aws_node_3"]))
+
+	results = Run(newTestHosts(4), Options{Concurrency: 1, ContinueOnError: true}, op)
+	errs = results.GetErrorHostMap()
+	require.Len(errs, 1)
+	require.Equal(StatusFailed, GetStatus(errs["aws_node_1"]))
+	require.Equal(4, results.Len())
+}
+
+func TestRunTimeoutIsNotRetried(t *testing.T) {
+	require := require.New(t)
+	var attempts int32
+	release := make(chan struct{})
+	defer close(release)
+	// the attempt is stuck until the end of the test
+	results := Run(newTestHosts(1), Options{Timeout: 10 * time.Millisecond, Retries: 2}, func(*models.Host) (interface{}, error) {
+		atomic.AddInt32(&attempts, 1)
+		<-release
+		return nil, nil
+	})
+	require.ErrorIs(results.GetErrorHostMap()["aws_node_0"], ErrTimeout)
+	require.Equal(int32(1), atomic.LoadInt32(&attempts))
+}
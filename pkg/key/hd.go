@@ -0,0 +1,219 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package key
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultDerivationPath is the BIP-44 path of the first address used by
+	// Odyssey wallets and ledger devices
+	DefaultDerivationPath = "m/44'/9000'/0'/0/0"
+
+	// hdRootPath is the BIP-44 path prefix used to derive HD key indices,
+	// the same one used by ledger devices
+	hdRootPath = "m/44'/9000'/0'/0"
+
+	hardenedKeyStart = 0x80000000
+	mnemonicEntropy  = 256
+)
+
+var (
+	ErrNotHDKey              = errors.New("key is not an HD key")
+	ErrInvalidMnemonic       = errors.New("invalid mnemonic")
+	ErrInvalidDerivationPath = errors.New("invalid derivation path (expected absolute path such as m/44'/9000'/0'/0/0)")
+	errInvalidDerivedKey     = errors.New("derived key is invalid, try with a different index")
+
+	masterKeyHMACKey = []byte("Bitcoin seed")
+)
+
+// NewMnemonic generates a new random 24 words BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropy)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// IsMnemonic returns true if [mnemonic] is a valid BIP-39 mnemonic.
+func IsMnemonic(mnemonic string) bool {
+	return bip39.IsMnemonicValid(normalizeMnemonic(mnemonic))
+}
+
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+func hdDerivationPath(index uint32) string {
+	return fmt.Sprintf("%s/%d", hdRootPath, index)
+}
+
+// deriveIndexKey derives the private key at [index] of the HD key [seed]
+func deriveIndexKey(seed []byte, index uint32) (*secp256k1.PrivateKey, error) {
+	derivationPath, err := accounts.ParseDerivationPath(hdDerivationPath(index))
+	if err != nil {
+		return nil, err
+	}
+	return PrivateKeyFromSeed(seed, derivationPath)
+}
+
+// PrivateKeyFromMnemonic derives the private key found at the BIP-32 [path]
+// of the BIP-39 [mnemonic].
+func PrivateKeyFromMnemonic(mnemonic string, path string) (*secp256k1.PrivateKey, error) {
+	mnemonic = normalizeMnemonic(mnemonic)
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	if !strings.HasPrefix(path, "m/") {
+		return nil, ErrInvalidDerivationPath
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDerivationPath, err)
+	}
+	seed := bip39.NewSeed(mnemonic, "")
+	return PrivateKeyFromSeed(seed, derivationPath)
+}
+
+// PrivateKeyFromSeed derives the private key found at the BIP-32 [path] of [seed].
+func PrivateKeyFromSeed(seed []byte, path []uint32) (*secp256k1.PrivateKey, error) {
+	mac := hmac.New(sha512.New, masterKeyHMACKey)
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+	keyBytes, chainCode := sum[:32], sum[32:]
+	var k dcrd_secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(keyBytes); overflow || k.IsZero() {
+		return nil, errInvalidDerivedKey
+	}
+	for _, index := range path {
+		var err error
+		keyBytes, chainCode, err = deriveChild(keyBytes, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	f := secp256k1.Factory{}
+	return f.ToPrivateKey(keyBytes)
+}
+
+// deriveChild implements BIP-32 private parent key to private child key derivation
+func deriveChild(keyBytes []byte, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	data := make([]byte, 0, 37)
+	if index >= hardenedKeyStart {
+		data = append(data, 0)
+		data = append(data, keyBytes...)
+	} else {
+		pubKey := dcrd_secp256k1.PrivKeyFromBytes(keyBytes).PubKey()
+		data = append(data, pubKey.SerializeCompressed()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+	mac := hmac.New(sha512.New, chainCode)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+	var childKey, parentKey dcrd_secp256k1.ModNScalar
+	if overflow := childKey.SetByteSlice(sum[:32]); overflow {
+		return nil, nil, errInvalidDerivedKey
+	}
+	parentKey.SetByteSlice(keyBytes)
+	childKey.Add(&parentKey)
+	if childKey.IsZero() {
+		return nil, nil, errInvalidDerivedKey
+	}
+	childKeyBytes := childKey.Bytes()
+	return childKeyBytes[:], sum[32:], nil
+}
+
+// IsHD returns true if the key is backed by a mnemonic, so that
+// more addresses can be derived from it.
+func (m *SoftKey) IsHD() bool {
+	return m.mnemonic != ""
+}
+
+// Returns the mnemonic of an HD key.
+func (m *SoftKey) Mnemonic() string {
+	return m.mnemonic
+}
+
+// Returns the indices added to an HD key. Index 0 is always present.
+func (m *SoftKey) Indices() []uint32 {
+	return m.indices
+}
+
+// DeriveIndex returns a non HD key with the private key at [index] of an HD key.
+func (m *SoftKey) DeriveIndex(networkID uint32, index uint32) (*SoftKey, error) {
+	if !m.IsHD() {
+		return nil, ErrNotHDKey
+	}
+	privKey, err := deriveIndexKey(m.seed, index)
+	if err != nil {
+		return nil, err
+	}
+	return NewSoft(networkID, WithPrivateKey(privKey))
+}
+
+// AddIndices derives the private keys at [indices] of an HD key and adds them
+// to the key, so that their addresses can be used to spend and sign.
+func (m *SoftKey) AddIndices(indices ...uint32) error {
+	if !m.IsHD() {
+		return ErrNotHDKey
+	}
+	for _, index := range indices {
+		if _, ok := m.indexAddrs[index]; ok {
+			continue
+		}
+		privKey, err := deriveIndexKey(m.seed, index)
+		if err != nil {
+			return err
+		}
+		m.keyChain.Add(privKey)
+		m.indexAddrs[index] = privKey.PublicKey().Address()
+		m.indices = append(m.indices, index)
+	}
+	sort.Slice(m.indices, func(i, j int) bool { return m.indices[i] < m.indices[j] })
+	return nil
+}
+
+// IndexAddress returns the address at [index] of an HD key, without adding it to the key.
+func (m *SoftKey) IndexAddress(index uint32) (ids.ShortID, error) {
+	if !m.IsHD() {
+		return ids.ShortEmpty, ErrNotHDKey
+	}
+	if addr, ok := m.indexAddrs[index]; ok {
+		return addr, nil
+	}
+	privKey, err := deriveIndexKey(m.seed, index)
+	if err != nil {
+		return ids.ShortEmpty, err
+	}
+	return privKey.PublicKey().Address(), nil
+}
+
+func (m *SoftKey) formatIndexAddrs(chain string) []string {
+	addrs := []string{}
+	for _, index := range m.indices {
+		addr := m.indexAddrs[index]
+		addrStr, err := address.Format(chain, m.hrp, addr[:])
+		if err != nil {
+			// should never happen, as the hrp was already validated on creation
+			continue
+		}
+		addrs = append(addrs, addrStr)
+	}
+	return addrs
+}
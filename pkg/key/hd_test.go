@@ -0,0 +1,179 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package key
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrivateKeyFromSeed(t *testing.T) {
+	t.Parallel()
+
+	// BIP-32 test vector 1
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name   string
+		path   []uint32
+		expKey string
+	}{
+		{
+			name:   "m",
+			path:   []uint32{},
+			expKey: "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		},
+		{
+			name:   "m/0'",
+			path:   []uint32{hardenedKeyStart},
+			expKey: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		},
+		{
+			name:   "m/0'/1",
+			path:   []uint32{hardenedKeyStart, 1},
+			expKey: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		},
+	}
+	for _, tv := range tt {
+		privKey, err := PrivateKeyFromSeed(seed, tv.path)
+		if err != nil {
+			t.Fatalf("%s: %s", tv.name, err)
+		}
+		if keyStr := hex.EncodeToString(privKey.Bytes()); keyStr != tv.expKey {
+			t.Fatalf("%s: unexpected key %s, expected %s", tv.name, keyStr, tv.expKey)
+		}
+	}
+}
+
+func TestPrivateKeyFromMnemonic(t *testing.T) {
+	t.Parallel()
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	privKey1, err := PrivateKeyFromMnemonic(mnemonic, DefaultDerivationPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey2, err := PrivateKeyFromMnemonic(mnemonic, "m/44'/9000'/0'/0/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if privKey1.Address() == privKey2.Address() {
+		t.Fatal("expected different keys for different derivation paths")
+	}
+
+	// well known ethereum address for the mnemonic
+	ethPrivKey, err := PrivateKeyFromMnemonic(mnemonic, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := NewSoft(fallbackNetworkID, WithPrivateKey(ethPrivKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expDAddr := "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+	if k.D() != expDAddr {
+		t.Fatalf("unexpected D-Chain address %s, expected %s", k.D(), expDAddr)
+	}
+
+	if _, err := PrivateKeyFromMnemonic("abandon abandon", DefaultDerivationPath); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrInvalidMnemonic)
+	}
+	if _, err := PrivateKeyFromMnemonic(mnemonic, "0/1"); !errors.Is(err, ErrInvalidDerivationPath) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrInvalidDerivationPath)
+	}
+}
+
+func TestNewKeyHD(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewSoft(fallbackNetworkID, WithMnemonic(mnemonic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.IsHD() {
+		t.Fatal("expected HD key")
+	}
+	if len(m.O()) != 1 {
+		t.Fatalf("unexpected number of O-Chain addresses %d, expected 1", len(m.O()))
+	}
+
+	keyPath := filepath.Join(t.TempDir(), "key.pk")
+	if err := m.Save(keyPath); err != nil {
+		t.Fatal(err)
+	}
+	m2, err := LoadSoft(fallbackNetworkID, keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m2.IsHD() || !bytes.Equal(m.Raw(), m2.Raw()) {
+		t.Fatal("loaded key differs from saved HD key")
+	}
+
+	if err := m2.AddIndices(2, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if len(m2.O()) != 3 || len(m2.Addresses()) != 3 || m2.KeyChain().Addresses().Len() != 3 {
+		t.Fatalf("unexpected number of addresses %d, expected 3", len(m2.O()))
+	}
+	derived, err := m2.DeriveIndex(fallbackNetworkID, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m2.Addresses()[2] != derived.Addresses()[0] {
+		t.Fatal("unexpected address for index 2")
+	}
+
+	nonHD, err := NewSoft(fallbackNetworkID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := nonHD.AddIndices(1); !errors.Is(err, ErrNotHDKey) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrNotHDKey)
+	}
+}
+
+func TestNewKeyHDEncrypted(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewSoft(fallbackNetworkID, WithMnemonic(mnemonic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "key.pk")
+	if err := m.SaveEncrypted(keyPath, "passphrase"); err != nil {
+		t.Fatal(err)
+	}
+	kb, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(kb, []byte(mnemonic)) {
+		t.Fatal("mnemonic stored in plaintext")
+	}
+	if _, err := LoadSoft(fallbackNetworkID, keyPath); !errors.Is(err, ErrEncryptedKey) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrEncryptedKey)
+	}
+	m2, err := LoadSoftWithPassphrase(fallbackNetworkID, keyPath, func() (string, error) { return "passphrase", nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m2.IsHD() || m2.Mnemonic() != mnemonic {
+		t.Fatal("loaded key differs from saved HD key")
+	}
+}
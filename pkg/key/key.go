@@ -9,6 +9,7 @@ import (
 	"errors"
 	"sort"
 
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -89,6 +90,9 @@ func GetHRP(networkID uint32) string {
 	case constants.MainnetID:
 		return constants.MainnetHRP
 	default:
+		if customNetwork, ok := models.GetCustomNetworkByID(networkID); ok && customNetwork.HRP != "" {
+			return customNetwork.HRP
+		}
 		return constants.FallbackHRP
 	}
 }
@@ -114,3 +114,53 @@ func TestNewKey(t *testing.T) {
 		}
 	}
 }
+
+func TestNewKeyEwoqEncrypted(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewSoft(
+		fallbackNetworkID,
+		WithPrivateKeyEncoded(EwoqPrivateKey),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyPath := filepath.Join(t.TempDir(), "key.pk")
+	if err := m.SaveEncrypted(keyPath, "passphrase"); err != nil {
+		t.Fatal(err)
+	}
+
+	isEncrypted, err := IsEncryptedFile(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEncrypted {
+		t.Fatal("expected key file to be encrypted")
+	}
+
+	if _, err := LoadSoft(fallbackNetworkID, keyPath); !errors.Is(err, ErrEncryptedKey) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrEncryptedKey)
+	}
+
+	_, err = LoadSoftWithPassphrase(fallbackNetworkID, keyPath, func() (string, error) {
+		return "wrong", nil
+	})
+	if !errors.Is(err, ErrInvalidPassphrase) {
+		t.Fatalf("unexpected error %v, expected %v", err, ErrInvalidPassphrase)
+	}
+
+	m2, err := LoadSoftWithPassphrase(fallbackNetworkID, keyPath, func() (string, error) {
+		return "passphrase", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(m.Raw(), m2.Raw()) {
+		t.Fatalf("loaded key unexpected %v, expected %v", m2.Raw(), m.Raw())
+	}
+	if m2.O()[0] != ewoqOChainAddr {
+		t.Fatalf("unexpected O-Chain address %q, expected %q", m2.O(), ewoqOChainAddr)
+	}
+}
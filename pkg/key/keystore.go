@@ -0,0 +1,144 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package key
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEncryptedKey      = errors.New("key is encrypted and requires a passphrase")
+	ErrEmptyPassphrase   = errors.New("passphrase can not be empty")
+	ErrInvalidPassphrase = errors.New("could not decrypt key with the given passphrase")
+)
+
+// keystoreHeader holds the fields used to identify an Ethereum keystore v3 file.
+type keystoreHeader struct {
+	Version int             `json:"version"`
+	Crypto  json.RawMessage `json:"crypto"`
+	// HD is a CLI extension to the format, used to store an encrypted mnemonic
+	// instead of an encrypted private key
+	HD bool `json:"hd,omitempty"`
+}
+
+// hdKeystore is the keystore v3 like format used to store encrypted HD keys
+type hdKeystore struct {
+	Version int                 `json:"version"`
+	ID      string              `json:"id"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+	HD      bool                `json:"hd"`
+}
+
+// IsEncrypted returns true if [kb] is an Ethereum keystore v3 encoded key.
+func IsEncrypted(kb []byte) bool {
+	var header keystoreHeader
+	if err := json.Unmarshal(kb, &header); err != nil {
+		return false
+	}
+	return header.Version == 3 && len(header.Crypto) > 0
+}
+
+// IsEncryptedFile returns true if the key file at [keyPath] is an Ethereum
+// keystore v3 encoded key.
+func IsEncryptedFile(keyPath string) (bool, error) {
+	kb, err := os.ReadFile(keyPath)
+	if err != nil {
+		return false, err
+	}
+	return IsEncrypted(kb), nil
+}
+
+// LoadSoftFromKeystore decrypts an Ethereum keystore v3 encoded key with
+// [passphrase] and creates the corresponding SoftKey.
+func LoadSoftFromKeystore(networkID uint32, kb []byte, passphrase string) (*SoftKey, error) {
+	var header keystoreHeader
+	if err := json.Unmarshal(kb, &header); err != nil {
+		return nil, err
+	}
+	if header.HD {
+		return loadHDSoftFromKeystore(networkID, kb, passphrase)
+	}
+	ethKey, err := keystore.DecryptKey(kb, passphrase)
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return nil, ErrInvalidPassphrase
+		}
+		return nil, err
+	}
+	f := secp256k1.Factory{}
+	privKey, err := f.ToPrivateKey(eth_crypto.FromECDSA(ethKey.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	return NewSoft(networkID, WithPrivateKey(privKey))
+}
+
+func loadHDSoftFromKeystore(networkID uint32, kb []byte, passphrase string) (*SoftKey, error) {
+	var hdKey hdKeystore
+	if err := json.Unmarshal(kb, &hdKey); err != nil {
+		return nil, err
+	}
+	mnemonic, err := keystore.DecryptDataV3(hdKey.Crypto, passphrase)
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return nil, ErrInvalidPassphrase
+		}
+		return nil, err
+	}
+	return NewSoft(networkID, WithMnemonic(string(mnemonic)))
+}
+
+// EncryptedJSON returns the private key encrypted with [passphrase], using the
+// Ethereum keystore v3 format (scrypt KDF + AES-128-CTR).
+// For HD keys, the mnemonic is encrypted instead, and the result is marked as HD.
+func (m *SoftKey) EncryptedJSON(passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	if m.IsHD() {
+		cryptoJSON, err := keystore.EncryptDataV3(
+			[]byte(m.mnemonic),
+			[]byte(passphrase),
+			keystore.StandardScryptN,
+			keystore.StandardScryptP,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(hdKeystore{
+			Version: 3,
+			ID:      id.String(),
+			Crypto:  cryptoJSON,
+			HD:      true,
+		})
+	}
+	ecdsaPrv := m.privKey.ToECDSA()
+	ethKey := &keystore.Key{
+		Id:         id,
+		Address:    eth_crypto.PubkeyToAddress(ecdsaPrv.PublicKey),
+		PrivateKey: ecdsaPrv,
+	}
+	return keystore.EncryptKey(ethKey, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// Saves the private key to disk encrypted with [passphrase].
+func (m *SoftKey) SaveEncrypted(p string, passphrase string) error {
+	kb, err := m.EncryptedJSON(passphrase)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, kb, constants.WriteReadUserOnlyPerms)
+}
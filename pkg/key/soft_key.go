@@ -23,6 +23,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +45,13 @@ type SoftKey struct {
 	aAddr string
 
 	keyChain *secp256k1fx.Keychain
+
+	// only set for HD keys
+	hrp        string
+	mnemonic   string
+	seed       []byte
+	indices    []uint32
+	indexAddrs map[uint32]ids.ShortID
 }
 
 const (
@@ -59,6 +67,8 @@ var ewoqKeyBytes = []byte("56289e99c94b6912bfc12adc093c9b51124f0dc54ac7a766b2bc5
 type SOp struct {
 	privKey        *secp256k1.PrivateKey
 	privKeyEncoded string
+	mnemonic       string
+	seed           []byte
 }
 
 type SOpOption func(*SOp)
@@ -83,10 +93,35 @@ func WithPrivateKeyEncoded(privKey string) SOpOption {
 	}
 }
 
+// To create a new HD key SoftKey backed by a BIP-39 mnemonic.
+// The primary private key is the one at index 0.
+func WithMnemonic(mnemonic string) SOpOption {
+	return func(sop *SOp) {
+		sop.mnemonic = mnemonic
+	}
+}
+
 func NewSoft(networkID uint32, opts ...SOpOption) (*SoftKey, error) {
 	ret := &SOp{}
 	ret.applyOpts(opts)
 
+	// set via "WithMnemonic"
+	if len(ret.mnemonic) > 0 {
+		if len(ret.privKeyEncoded) > 0 || ret.privKey != nil {
+			return nil, ErrInvalidPrivateKey
+		}
+		ret.mnemonic = normalizeMnemonic(ret.mnemonic)
+		if !IsMnemonic(ret.mnemonic) {
+			return nil, ErrInvalidMnemonic
+		}
+		ret.seed = bip39.NewSeed(ret.mnemonic, "")
+		privKey, err := deriveIndexKey(ret.seed, 0)
+		if err != nil {
+			return nil, err
+		}
+		ret.privKey = privKey
+	}
+
 	// set via "WithPrivateKeyEncoded"
 	if len(ret.privKeyEncoded) > 0 {
 		privKey, err := decodePrivateKey(ret.privKeyEncoded)
@@ -145,6 +180,14 @@ func NewSoft(networkID uint32, opts ...SOpOption) (*SoftKey, error) {
 		return nil, err
 	}
 
+	if ret.mnemonic != "" {
+		m.hrp = hrp
+		m.mnemonic = ret.mnemonic
+		m.seed = ret.seed
+		m.indices = []uint32{0}
+		m.indexAddrs = map[uint32]ids.ShortID{0: privKey.PublicKey().Address()}
+	}
+
 	return m, nil
 }
 
@@ -157,6 +200,23 @@ func LoadSoft(networkID uint32, keyPath string) (*SoftKey, error) {
 	return LoadSoftFromBytes(networkID, kb)
 }
 
+// LoadSoftWithPassphrase loads the private key from disk and creates the corresponding SoftKey.
+// If the key is stored encrypted, [getPassphrase] is called to obtain the passphrase to decrypt it.
+func LoadSoftWithPassphrase(networkID uint32, keyPath string, getPassphrase func() (string, error)) (*SoftKey, error) {
+	kb, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if !IsEncrypted(kb) {
+		return LoadSoftFromBytes(networkID, kb)
+	}
+	passphrase, err := getPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	return LoadSoftFromKeystore(networkID, kb, passphrase)
+}
+
 func LoadEwoq(networkID uint32) (*SoftKey, error) {
 	ux.Logger.PrintToUser("Loading EWOQ key")
 	return LoadSoftFromBytes(networkID, ewoqKeyBytes)
@@ -164,6 +224,12 @@ func LoadEwoq(networkID uint32) (*SoftKey, error) {
 
 // LoadSoftFromBytes loads the private key from bytes and creates the corresponding SoftKey.
 func LoadSoftFromBytes(networkID uint32, kb []byte) (*SoftKey, error) {
+	if IsEncrypted(kb) {
+		return nil, ErrEncryptedKey
+	}
+	if mnemonic := normalizeMnemonic(string(kb)); IsMnemonic(mnemonic) {
+		return NewSoft(networkID, WithMnemonic(mnemonic))
+	}
 	// in case, it's already encoded
 	k, err := NewSoft(networkID, WithPrivateKeyEncoded(string(kb)))
 	if err == nil {
@@ -282,16 +348,30 @@ func (m *SoftKey) Encode() string {
 }
 
 // Saves the private key to disk with hex encoding.
+// HD keys are saved as their mnemonic.
 func (m *SoftKey) Save(p string) error {
 	k := hex.EncodeToString(m.privKeyRaw)
+	if m.IsHD() {
+		k = m.mnemonic
+	}
 	return os.WriteFile(p, []byte(k), constants.WriteReadUserOnlyPerms)
 }
 
+// Returns all formatted O-Chain addresses.
+// For HD keys, those are the addresses of all added indices.
 func (m *SoftKey) O() []string {
+	if m.IsHD() {
+		return m.formatIndexAddrs("O")
+	}
 	return []string{m.oAddr}
 }
 
+// Returns all formatted A-Chain addresses.
+// For HD keys, those are the addresses of all added indices.
 func (m *SoftKey) A() []string {
+	if m.IsHD() {
+		return m.formatIndexAddrs("A")
+	}
 	return []string{m.aAddr}
 }
 
@@ -350,6 +430,13 @@ func (m *SoftKey) spend(output *dione.UTXO, time uint64) (
 }
 
 func (m *SoftKey) Addresses() []ids.ShortID {
+	if m.IsHD() {
+		addrs := make([]ids.ShortID, len(m.indices))
+		for i, index := range m.indices {
+			addrs[i] = m.indexAddrs[index]
+		}
+		return addrs
+	}
 	return []ids.ShortID{m.privKey.PublicKey().Address()}
 }
 
@@ -358,17 +445,30 @@ func (m *SoftKey) Sign(oTx *txs.Tx, signers [][]ids.ShortID) error {
 	for i, inputSigners := range signers {
 		privsigners[i] = make([]*secp256k1.PrivateKey, len(inputSigners))
 		for j, signer := range inputSigners {
-			if signer != m.privKey.PublicKey().Address() {
+			privKey, ok := m.getPrivateKey(signer)
+			if !ok {
 				// Should never happen
 				return ErrCantSpend
 			}
-			privsigners[i][j] = m.privKey
+			privsigners[i][j] = privKey
 		}
 	}
 
 	return oTx.Sign(txs.Codec, privsigners)
 }
 
+func (m *SoftKey) getPrivateKey(addr ids.ShortID) (*secp256k1.PrivateKey, bool) {
+	if addr == m.privKey.PublicKey().Address() {
+		return m.privKey, true
+	}
+	signer, ok := m.keyChain.Get(addr)
+	if !ok {
+		return nil, false
+	}
+	privKey, ok := signer.(*secp256k1.PrivateKey)
+	return privKey, ok
+}
+
 func (m *SoftKey) Match(owners *secp256k1fx.OutputOwners, time uint64) ([]uint32, []ids.ShortID, bool) {
 	indices, privs, ok := m.keyChain.Match(owners, time)
 	pks := make([]ids.ShortID, len(privs))
@@ -41,6 +41,8 @@ type Keychain struct {
 	Ledger        keychain.Ledger
 	UsesLedger    bool
 	LedgerIndices []uint32
+	// stored key the keychain was created from, if any
+	SoftKey *key.SoftKey
 }
 
 func NewKeychain(network models.Network, keychain keychain.Keychain, ledger keychain.Ledger, ledgerIndices []uint32) *Keychain {
@@ -103,6 +105,19 @@ func (kc *Keychain) AddAddresses(addresses []string) error {
 		}
 		kc.Keychain = odygoKc
 	}
+	if kc.SoftKey != nil && kc.SoftKey.IsHD() {
+		prevNumIndices := len(kc.SoftKey.Indices())
+		softKeyIndices, err := getSoftKeyIndices(kc.SoftKey, addresses)
+		if err != nil {
+			return err
+		}
+		if err := kc.SoftKey.AddIndices(softKeyIndices...); err != nil {
+			return err
+		}
+		if len(kc.SoftKey.Indices()) != prevNumIndices {
+			showSoftKeyAddresses(kc.SoftKey)
+		}
+	}
 	return nil
 }
 
@@ -151,6 +166,15 @@ func GetKeychainFromCmdLineFlags(
 			return nil, ErrStoredKeyOrEwoqOnMainnet
 		}
 		useLedger = true
+	case network.Kind == models.Custom:
+		// private networks may be funded with any key, prompt as on testnet
+		if !useLedger && !useEwoq && keyName == "" {
+			var err error
+			useLedger, keyName, err = prompts.GetTestnetKeyOrLedger(app.Prompt, keychainGoal, app.GetKeyDir())
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	network.HandlePublicNetworkSimulation()
@@ -211,12 +235,19 @@ func GetKeychain(
 		kc := sf.KeyChain()
 		return NewKeychain(network, kc, nil, nil), nil
 	}
-	sf, err := key.LoadSoft(network.ID, app.GetKeyPath(keyName))
+	sf, err := app.LoadKeyByName(network.ID, keyName)
 	if err != nil {
 		return nil, err
 	}
-	kc := sf.KeyChain()
-	return NewKeychain(network, kc, nil, nil), nil
+	if sf.IsHD() && requiredFunds > 0 {
+		if err := AddFundedSoftKeyIndices(network, sf, requiredFunds); err != nil {
+			return nil, err
+		}
+		showSoftKeyAddresses(sf)
+	}
+	kc := NewKeychain(network, sf.KeyChain(), nil, nil)
+	kc.SoftKey = sf
+	return kc, nil
 }
 
 func getLedgerIndices(ledgerDevice keychain.Ledger, addressesStr []string) ([]uint32, error) {
@@ -256,37 +287,98 @@ func getLedgerIndices(ledgerDevice keychain.Ledger, addressesStr []string) ([]ui
 	return ledgerIndices, nil
 }
 
-// search for a set of indices that pay a given amount
+// search for a set of ledger indices that pay a given amount
 func searchForFundedLedgerIndices(network models.Network, ledgerDevice keychain.Ledger, amount uint64) ([]uint32, error) {
-	ux.Logger.PrintToUser("Looking for ledger indices to pay for %.9f DIONE...", float64(amount)/float64(units.Dione))
+	return searchForFundedIndices(network, "ledger", amount, func(index uint32) ([]ids.ShortID, error) {
+		return ledgerDevice.Addresses([]uint32{index})
+	})
+}
+
+// searchForFundedIndices searches for a set of indices that pay a given amount, among the first
+// indices of the [keychainName] keychain. [indexAddresses] gives the addresses of an index
+func searchForFundedIndices(
+	network models.Network,
+	keychainName string,
+	amount uint64,
+	indexAddresses func(uint32) ([]ids.ShortID, error),
+) ([]uint32, error) {
+	ux.Logger.PrintToUser("Looking for %s indices to pay for %.9f DIONE...", keychainName, float64(amount)/float64(units.Dione))
 	oClient := omegavm.NewClient(network.Endpoint)
 	totalBalance := uint64(0)
-	ledgerIndices := []uint32{}
-	for ledgerIndex := uint32(0); ledgerIndex < numLedgerIndicesToSearchForBalance; ledgerIndex++ {
-		ledgerAddress, err := ledgerDevice.Addresses([]uint32{ledgerIndex})
+	indices := []uint32{}
+	for index := uint32(0); index < numLedgerIndicesToSearchForBalance; index++ {
+		addresses, err := indexAddresses(index)
 		if err != nil {
-			return []uint32{}, err
+			return nil, err
 		}
 		ctx, cancel := utils.GetAPIContext()
-		resp, err := oClient.GetBalance(ctx, ledgerAddress)
+		resp, err := oClient.GetBalance(ctx, addresses)
 		cancel()
 		if err != nil {
 			return nil, err
 		}
 		if resp.Balance > 0 {
-			ux.Logger.PrintToUser("  Found index %d with %.9f DIONE", ledgerIndex, float64(resp.Balance)/float64(units.Dione))
+			ux.Logger.PrintToUser("  Found index %d with %.9f DIONE", index, float64(resp.Balance)/float64(units.Dione))
 			totalBalance += uint64(resp.Balance)
-			ledgerIndices = append(ledgerIndices, ledgerIndex)
+			indices = append(indices, index)
 		}
 		if totalBalance >= amount {
 			break
 		}
 	}
 	if totalBalance < amount {
-		ux.Logger.PrintToUser(logging.Yellow.Wrap("Not enough funds in the first %d indices of Ledger"), numLedgerIndicesToSearchForBalance)
-		return nil, fmt.Errorf("not enough funds on ledger")
+		ux.Logger.PrintToUser(logging.Yellow.Wrap("Not enough funds in the first %d indices of the %s"), numLedgerIndicesToSearchForBalance, keychainName)
+		return nil, fmt.Errorf("not enough funds on %s", keychainName)
+	}
+	return indices, nil
+}
+
+func getSoftKeyIndices(sf *key.SoftKey, addressesStr []string) ([]uint32, error) {
+	addresses, err := address.ParseToIDs(addressesStr)
+	if err != nil {
+		return []uint32{}, fmt.Errorf("failure parsing addresses: %w", err)
+	}
+	// maps the addresses to search for to their string representation
+	addressesMap := map[ids.ShortID]string{}
+	for i, addr := range addresses {
+		addressesMap[addr] = addressesStr[i]
+	}
+	softKeyIndices := []uint32{}
+	for index := uint32(0); index < numLedgerIndicesToSearch && len(addressesMap) > 0; index++ {
+		addr, err := sf.IndexAddress(index)
+		if err != nil {
+			return []uint32{}, err
+		}
+		if addrStr, ok := addressesMap[addr]; ok {
+			ux.Logger.PrintToUser("  Found index %d for address %s", index, addrStr)
+			softKeyIndices = append(softKeyIndices, index)
+			delete(addressesMap, addr)
+		}
+	}
+	return softKeyIndices, nil
+}
+
+// AddFundedSoftKeyIndices searches for a set of indices of the HD key [sf] that pay a given
+// amount, and adds them to the key
+func AddFundedSoftKeyIndices(network models.Network, sf *key.SoftKey, amount uint64) error {
+	softKeyIndices, err := searchForFundedIndices(network, "key", amount, func(index uint32) ([]ids.ShortID, error) {
+		addr, err := sf.IndexAddress(index)
+		if err != nil {
+			return nil, err
+		}
+		return []ids.ShortID{addr}, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sf.AddIndices(softKeyIndices...)
+}
+
+func showSoftKeyAddresses(sf *key.SoftKey) {
+	ux.Logger.PrintToUser(logging.Yellow.Wrap("Key addresses: "))
+	for _, addrStr := range sf.O() {
+		ux.Logger.PrintToUser(logging.Yellow.Wrap(fmt.Sprintf("  %s", addrStr)))
 	}
-	return ledgerIndices, nil
 }
 
 func showLedgerAddresses(network models.Network, ledgerDevice keychain.Ledger, ledgerIndices []uint32) error {
@@ -10,7 +10,8 @@ type GCPConfig struct {
 type ClusterConfig struct {
 	Nodes              []string
 	Network            Network
-	MonitoringInstance string // instance ID of the separate monitoring instance (if any)
+	MonitoringInstance string            // instance ID of the separate monitoring instance (if any)
+	HostKeys           map[string]string // maps host ansible ID to its pinned SSH host key
 }
 
 type ClustersConfig struct {
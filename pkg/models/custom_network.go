@@ -0,0 +1,110 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/DioneProtocol/odysseygo/genesis"
+)
+
+// CustomNetwork is a user-defined Odyssey network, registered with network add
+type CustomNetwork struct {
+	Name      string `json:"name"`
+	NetworkID uint32 `json:"networkID"`
+	// Endpoint is the API endpoint of the network, used for the O-Chain and the info API
+	Endpoint string `json:"endpoint"`
+	// DChainEndpoint is the RPC endpoint of the D-Chain. If empty, it is derived from Endpoint
+	DChainEndpoint string         `json:"dChainEndpoint,omitempty"`
+	HRP            string         `json:"hrp"`
+	GenesisParams  genesis.Params `json:"genesisParams"`
+}
+
+// CustomNetworksConfig is the registry of user-defined networks, keyed by name
+type CustomNetworksConfig struct {
+	Networks map[string]CustomNetwork `json:"networks"`
+}
+
+var (
+	customNetworksLock sync.RWMutex
+	customNetworks     = map[string]CustomNetwork{}
+)
+
+// SetCustomNetworks makes [networks] known to the network lookups, replacing the
+// previously registered ones
+func SetCustomNetworks(networks map[string]CustomNetwork) {
+	customNetworksLock.Lock()
+	defer customNetworksLock.Unlock()
+	customNetworks = map[string]CustomNetwork{}
+	for name, network := range networks {
+		customNetworks[name] = network
+	}
+}
+
+// GetCustomNetwork returns the registered network named [name]
+func GetCustomNetwork(name string) (CustomNetwork, bool) {
+	customNetworksLock.RLock()
+	defer customNetworksLock.RUnlock()
+	network, ok := customNetworks[name]
+	return network, ok
+}
+
+// GetNetworkByName returns the Network of the registered network named [name], or an
+// error telling to register it if there is none
+func GetNetworkByName(name string) (Network, error) {
+	customNetwork, ok := GetCustomNetwork(name)
+	if !ok {
+		return UndefinedNetwork, fmt.Errorf("network %s not found. Use network add to register it", name)
+	}
+	return customNetwork.Network(), nil
+}
+
+// GetNetworkNames returns the names of the networks of [kinds], in order, listing each
+// registered network in place of the Custom kind
+func GetNetworkNames(kinds []NetworkKind) []string {
+	names := []string{}
+	for _, kind := range kinds {
+		if kind == Custom {
+			names = append(names, GetCustomNetworkNames()...)
+			continue
+		}
+		names = append(names, kind.String())
+	}
+	return names
+}
+
+// GetCustomNetworkByID returns the registered network with [networkID]
+func GetCustomNetworkByID(networkID uint32) (CustomNetwork, bool) {
+	customNetworksLock.RLock()
+	defer customNetworksLock.RUnlock()
+	for _, network := range customNetworks {
+		if network.NetworkID == networkID {
+			return network, true
+		}
+	}
+	return CustomNetwork{}, false
+}
+
+// GetCustomNetworkNames returns the sorted names of the registered networks
+func GetCustomNetworkNames() []string {
+	customNetworksLock.RLock()
+	defer customNetworksLock.RUnlock()
+	names := make([]string, 0, len(customNetworks))
+	for name := range customNetworks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Network returns the Network used by the commands to operate on [cn]
+func (cn CustomNetwork) Network() Network {
+	return Network{
+		Kind:       Custom,
+		ID:         cn.NetworkID,
+		Endpoint:   cn.Endpoint,
+		CustomName: cn.Name,
+	}
+}
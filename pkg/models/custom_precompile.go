@@ -0,0 +1,38 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import "encoding/json"
+
+// PrecompileDescriptor describes a stateful precompile of a Subnet-EVM fork, so that
+// it can be configured by the CLI. It is registered with subnet precompile add
+type PrecompileDescriptor struct {
+	// Name is the name of the precompile shown in the prompts
+	Name string `json:"name"`
+	// ConfigKey is the key of the precompile config in the genesis and upgrade files
+	ConfigKey string `json:"configKey"`
+	// Address is the address of the precompile contract
+	Address     string `json:"address"`
+	Description string `json:"description,omitempty"`
+	// Schema is the JSON schema of the precompile params. The blockTimestamp and
+	// disable params are common to all precompiles and are not part of it
+	Schema json.RawMessage `json:"schema,omitempty"`
+	// Prompts are the prompts that set the precompile params, in order
+	Prompts []PrecompilePrompt `json:"prompts,omitempty"`
+}
+
+// PrecompilePrompt is a prompt that sets the param Key of a precompile. Type is one
+// of string, bool, uint64, bigint, address, addresses or allowList. allowList
+// prompts set the adminAddresses and enabledAddresses params, and have no Key
+type PrecompilePrompt struct {
+	Key    string `json:"key,omitempty"`
+	Prompt string `json:"prompt,omitempty"`
+	Type   string `json:"type"`
+	// Optional params are only set if the user chooses to
+	Optional bool `json:"optional,omitempty"`
+}
+
+// CustomPrecompilesConfig is the registry of precompile descriptors, keyed by config key
+type CustomPrecompilesConfig struct {
+	Precompiles map[string]PrecompileDescriptor `json:"precompiles"`
+}
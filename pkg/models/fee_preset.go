@@ -0,0 +1,11 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import "github.com/DioneProtocol/subnet-evm/commontype"
+
+// FeePresetsConfig is the registry of the fee configs saved with subnet fee-preset save,
+// keyed by preset name
+type FeePresetsConfig struct {
+	Presets map[string]commontype.FeeConfig `json:"presets"`
+}
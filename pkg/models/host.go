@@ -6,11 +6,13 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,13 +28,29 @@ const (
 	sshConnectionRetries = 5
 )
 
+// ErrHostKeyMismatch is returned when a host presents an SSH host key different from the pinned one
+var ErrHostKeyMismatch = errors.New("SSH host key mismatch")
+
+// errHostKeyScanned aborts the SSH handshake of ScanHostKey once the host key is received
+var errHostKeyScanned = errors.New("host key scanned")
+
+// DialSSH opens the TCP connections to the SSH servers of the hosts
+var DialSSH = net.DialTimeout
+
 type Host struct {
-	NodeID            string
-	IP                string
+	NodeID string
+	IP     string
+	// SSHPort is the port of the SSH server of the host. The default SSH port is used if 0
+	SSHPort           int
 	SSHUser           string
 	SSHPrivateKeyPath string
 	SSHCommonArgs     string
-	Connection        *goph.Client
+	// HostKey is the SSH host public key of the host, in authorized_keys format.
+	// If empty, the key presented on the first connection is trusted and pinned.
+	HostKey string
+	// PinHostKey, if set, is called to persist the host key trusted on first connection
+	PinHostKey func(hostKey string) error
+	Connection *goph.Client
 }
 
 func NewHostConnection(h *Host) (*goph.Client, error) {
@@ -48,19 +66,104 @@ func NewHostConnection(h *Host) (*goph.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	cl, err := goph.NewConn(&goph.Config{
-		User:    h.SSHUser,
-		Addr:    h.IP,
-		Port:    constants.SSHTCPPort,
-		Auth:    auth,
-		Timeout: sshConnectionTimeout,
-		// #nosec G106
-		Callback: ssh.InsecureIgnoreHostKey(), // we don't verify host key ( similar to ansible)
+	config := &goph.Config{
+		User:     h.SSHUser,
+		Addr:     h.IP,
+		Port:     uint(h.GetSSHPort()),
+		Auth:     auth,
+		Timeout:  sshConnectionTimeout,
+		Callback: h.verifyHostKey,
+	}
+	cl, err := dialSSH(net.JoinHostPort(h.IP, strconv.Itoa(h.GetSSHPort())), &ssh.ClientConfig{
+		User:            config.User,
+		Auth:            config.Auth,
+		Timeout:         config.Timeout,
+		HostKeyCallback: config.Callback,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return cl, nil
+	return &goph.Client{Client: cl, Config: config}, nil
+}
+
+// dialSSH starts an SSH client connection to [addr] with [config], over a connection opened by DialSSH
+func dialSSH(addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	conn, err := DialSSH("tcp", addr, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// verifyHostKey checks that [key] is the pinned host key of the host, pinning it
+// if the host has none yet (trust on first use)
+func (h *Host) verifyHostKey(_ string, _ net.Addr, key ssh.PublicKey) error {
+	hostKey := MarshalHostKey(key)
+	if h.HostKey == "" {
+		if h.PinHostKey != nil {
+			if err := h.PinHostKey(hostKey); err != nil {
+				return fmt.Errorf("failed to pin host key of host %s: %w", h.IP, err)
+			}
+		}
+		h.HostKey = hostKey
+		return nil
+	}
+	if h.HostKey != hostKey {
+		return fmt.Errorf(
+			"%w for host %s: expected %s, got %s. If the host key was legitimately changed, run odyssey node ssh --rotate-host-key",
+			ErrHostKeyMismatch,
+			h.IP,
+			HostKeyFingerprint(h.HostKey),
+			ssh.FingerprintSHA256(key),
+		)
+	}
+	return nil
+}
+
+// ScanHostKey returns the SSH host key presented by the host, in authorized_keys format.
+// The handshake is aborted once the key is received, so the key is neither verified nor
+// pinned, and no credentials are sent to the host.
+func (h *Host) ScanHostKey() (string, error) {
+	var hostKey string
+	_, err := dialSSH(net.JoinHostPort(h.IP, strconv.Itoa(h.GetSSHPort())), &ssh.ClientConfig{
+		User: h.SSHUser,
+		HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			hostKey = MarshalHostKey(key)
+			return errHostKeyScanned
+		},
+		Timeout: sshConnectionTimeout,
+	})
+	if hostKey == "" {
+		return "", fmt.Errorf("failed to get host key of host %s: %w", h.IP, err)
+	}
+	return hostKey, nil
+}
+
+// MarshalHostKey returns [key] in authorized_keys format
+func MarshalHostKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+// HostKeyFingerprint returns the SHA256 fingerprint of [hostKey], given in authorized_keys format
+func HostKeyFingerprint(hostKey string) string {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return hostKey
+	}
+	return ssh.FingerprintSHA256(key)
+}
+
+// GetSSHPort returns the port of the SSH server of the host
+func (h *Host) GetSSHPort() int {
+	if h.SSHPort == 0 {
+		return constants.SSHTCPPort
+	}
+	return h.SSHPort
 }
 
 // GetCloudID returns the node ID of the host.
@@ -77,6 +180,9 @@ func (h *Host) Connect() error {
 	var err error
 	for i := 0; h.Connection == nil && i < sshConnectionRetries; i++ {
 		h.Connection, err = NewHostConnection(h)
+		if errors.Is(err, ErrHostKeyMismatch) {
+			break
+		}
 	}
 	if err != nil {
 		return fmt.Errorf("failed to connect to host %s: %w", h.IP, err)
@@ -259,13 +365,18 @@ func (h *Host) UntimedForward(httpRequest string) ([]byte, error) {
 }
 
 func (h *Host) GetAnsibleInventoryRecord() string {
-	return strings.Join([]string{
+	fields := []string{
 		h.NodeID,
 		fmt.Sprintf("ansible_host=%s", h.IP),
+	}
+	if h.SSHPort != 0 {
+		fields = append(fields, fmt.Sprintf("ansible_port=%d", h.SSHPort))
+	}
+	return strings.Join(append(fields,
 		fmt.Sprintf("ansible_user=%s", h.SSHUser),
 		fmt.Sprintf("ansible_ssh_private_key_file=%s", h.SSHPrivateKeyPath),
 		fmt.Sprintf("ansible_ssh_common_args='%s'", h.SSHCommonArgs),
-	}, " ")
+	), " ")
 }
 
 func HostCloudIDToAnsibleID(cloudService string, hostCloudID string) (string, error) {
@@ -274,6 +385,8 @@ func HostCloudIDToAnsibleID(cloudService string, hostCloudID string) (string, er
 		return fmt.Sprintf("%s_%s", constants.GCPNodeAnsiblePrefix, hostCloudID), nil
 	case constants.AWSCloudService:
 		return fmt.Sprintf("%s_%s", constants.AWSNodeAnsiblePrefix, hostCloudID), nil
+	case constants.ExistingHostsService:
+		return fmt.Sprintf("%s_%s", constants.ExistingNodeAnsiblePrefix, hostCloudID), nil
 	}
 	return "", fmt.Errorf("unknown cloud service %s", cloudService)
 }
@@ -283,6 +396,8 @@ func HostAnsibleIDToCloudID(hostAnsibleID string) (string, string, error) {
 		return constants.AWSCloudService, strings.TrimPrefix(hostAnsibleID, constants.AWSNodeAnsiblePrefix+"_"), nil
 	} else if strings.HasPrefix(hostAnsibleID, constants.GCPNodeAnsiblePrefix) {
 		return constants.GCPCloudService, strings.TrimPrefix(hostAnsibleID, constants.GCPNodeAnsiblePrefix+"_"), nil
+	} else if strings.HasPrefix(hostAnsibleID, constants.ExistingNodeAnsiblePrefix) {
+		return constants.ExistingHostsService, strings.TrimPrefix(hostAnsibleID, constants.ExistingNodeAnsiblePrefix+"_"), nil
 	}
 	return "", "", fmt.Errorf("unknown cloud service prefix in %s", hostAnsibleID)
 }
@@ -293,9 +408,10 @@ func (h *Host) WaitForSSHPort(timeout time.Duration) error {
 	deadline := start.Add(timeout)
 	for {
 		if time.Now().After(deadline) {
-			return fmt.Errorf("timeout: SSH port %d on host %s is not available after %vs", constants.SSHTCPPort, h.IP, timeout.Seconds())
+			return fmt.Errorf("timeout: SSH port %d on host %s is not available after %vs", h.GetSSHPort(), h.IP, timeout.Seconds())
 		}
-		if _, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", h.IP, constants.SSHTCPPort), time.Second); err == nil {
+		if conn, err := DialSSH("tcp", net.JoinHostPort(h.IP, strconv.Itoa(h.GetSSHPort())), time.Second); err == nil {
+			_ = conn.Close()
 			return nil
 		}
 		time.Sleep(constants.SSHSleepBetweenChecks)
@@ -314,6 +430,9 @@ func (h *Host) WaitForSSHShell(timeout time.Duration) error {
 			return fmt.Errorf("timeout: SSH shell on host %s is not available after %ds", h.IP, int(timeout.Seconds()))
 		}
 		if err := h.Connect(); err != nil {
+			if errors.Is(err, ErrHostKeyMismatch) {
+				return err
+			}
 			time.Sleep(constants.SSHSleepBetweenChecks)
 			continue
 		}
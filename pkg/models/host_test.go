@@ -0,0 +1,51 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package models
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func newTestHostKey(t *testing.T) ssh.PublicKey {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return key
+}
+
+func TestVerifyHostKey(t *testing.T) {
+	require := require.New(t)
+
+	key := newTestHostKey(t)
+	otherKey := newTestHostKey(t)
+
+	pinned := ""
+	host := &Host{
+		IP: "127.0.0.1",
+		PinHostKey: func(hostKey string) error {
+			pinned = hostKey
+			return nil
+		},
+	}
+
+	// trust on first use
+	require.NoError(host.verifyHostKey("", nil, key))
+	require.Equal(MarshalHostKey(key), pinned)
+	require.Equal(pinned, host.HostKey)
+
+	// the pinned key is verified on later connections
+	pinned = ""
+	require.NoError(host.verifyHostKey("", nil, key))
+	require.Empty(pinned)
+	err := host.verifyHostKey("", nil, otherKey)
+	require.ErrorIs(err, ErrHostKeyMismatch)
+	require.ErrorContains(err, ssh.FingerprintSHA256(key))
+	require.ErrorContains(err, ssh.FingerprintSHA256(otherKey))
+	require.Equal(MarshalHostKey(key), host.HostKey)
+}
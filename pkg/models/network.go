@@ -19,6 +19,8 @@ const (
 	Testnet
 	Local
 	Devnet
+	// Custom is a user-defined network, registered with network add
+	Custom
 )
 
 func (nk NetworkKind) String() string {
@@ -31,6 +33,8 @@ func (nk NetworkKind) String() string {
 		return "Local Network"
 	case Devnet:
 		return "Devnet"
+	case Custom:
+		return "Custom Network"
 	}
 	return "invalid network"
 }
@@ -39,6 +43,8 @@ type Network struct {
 	Kind     NetworkKind
 	ID       uint32
 	Endpoint string
+	// CustomName is the name of the network if it is a Custom one
+	CustomName string `json:",omitempty"`
 }
 
 var (
@@ -73,6 +79,9 @@ func NetworkFromString(s string) Network {
 	case Devnet.String():
 		return DevnetNetwork
 	}
+	if customNetwork, ok := GetCustomNetwork(s); ok {
+		return customNetwork.Network()
+	}
 	return UndefinedNetwork
 }
 
@@ -87,14 +96,25 @@ func NetworkFromNetworkID(networkID uint32) Network {
 	case constants.DevnetNetworkID:
 		return DevnetNetwork
 	}
+	if customNetwork, ok := GetCustomNetworkByID(networkID); ok {
+		return customNetwork.Network()
+	}
 	return UndefinedNetwork
 }
 
 func (n Network) Name() string {
+	if n.Kind == Custom {
+		return n.CustomName
+	}
 	return n.Kind.String()
 }
 
 func (n Network) DChainEndpoint() string {
+	if n.Kind == Custom {
+		if customNetwork, ok := GetCustomNetwork(n.CustomName); ok && customNetwork.DChainEndpoint != "" {
+			return customNetwork.DChainEndpoint
+		}
+	}
 	return fmt.Sprintf("%s/ext/bc/%s/rpc", n.Endpoint, "D")
 }
 
@@ -102,7 +122,7 @@ func (n Network) NetworkIDFlagValue() string {
 	switch n.Kind {
 	case Local:
 		return fmt.Sprintf("network-%d", n.ID)
-	case Devnet:
+	case Devnet, Custom:
 		return fmt.Sprintf("network-%d", n.ID)
 	case Testnet:
 		return "testnet"
@@ -122,6 +142,10 @@ func (n Network) GenesisParams() *genesis.Params {
 		return &genesis.TestnetParams
 	case Mainnet:
 		return &genesis.MainnetParams
+	case Custom:
+		if customNetwork, ok := GetCustomNetwork(n.CustomName); ok {
+			return &customNetwork.GenesisParams
+		}
 	}
 	return nil
 }
@@ -2,6 +2,8 @@
 // See the file LICENSE for licensing terms.
 package models
 
+import "time"
+
 type NodeConfig struct {
 	NodeID        string // instance id on cloud server
 	Region        string // region where cloud server instance is deployed
@@ -10,6 +12,15 @@ type NodeConfig struct {
 	CertPath      string // where the cert is stored in user's local machine ssh directory
 	SecurityGroup string // security group used on cloud server
 	ElasticIP     string // public IP address of the cloud server
-	CloudService  string // which cloud service node is hosted on (AWS / GCP)
+	CloudService  string // which cloud service node is hosted on (AWS / GCP / Existing Hosts)
 	UseStaticIP   bool   // node has a static IP association
+	// versions replaced by each upgrade of the node, oldest first. their binaries are kept on the cloud server
+	VersionHistory []NodeVersions `json:",omitempty"`
+}
+
+// NodeVersions are the odysseygo and VM versions a node ran before an upgrade
+type NodeVersions struct {
+	OdysseyGoVersion string            // empty if odysseygo was not upgraded
+	VMVersions       map[string]string // maps VM ID to version, for upgraded VMs
+	UpgradedAt       time.Time
 }
@@ -0,0 +1,811 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package prompts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// unkeyedAnswersKey is the answers file section holding the answers of prompts
+// without a stable key, indexed by the prompt text
+const unkeyedAnswersKey = "prompts"
+
+// AnswerKeys maps prompt texts to the stable keys used to store their answers
+// in an answers file, e.g. "ChainId" to "evm.chainId". Prompts built at runtime
+// are matched by their longest registered prefix.
+type AnswerKeys map[string]string
+
+// listDecisionAnswerer is implemented by prompters that provide a whole
+// CaptureListDecision list at once instead of answering each of its prompts
+type listDecisionAnswerer interface {
+	startListDecision(prompt string, capturePrompt string)
+	recordListDecision(prompt string, items []string)
+	endListDecision(prompt string, capturePrompt string)
+}
+
+type answer struct {
+	// values are either strings, or string slices for list decisions
+	values []interface{}
+	// list is set if each value answers one occurrence of the prompt
+	list bool
+	next int
+}
+
+// AnswersPrompter answers prompts from an answers file, falling back to the wrapped
+// prompter for the prompts not found in it. It can also record all answers given,
+// so they can be saved into a new answers file.
+//
+// A prompt answered with a scalar gets that answer every time it is asked, while
+// a prompt answered with a list gets the next list element each time, which allows
+// to replay wizard loops. A list decision prompt gets the whole list of items.
+// Passwords are never recorded.
+type AnswersPrompter struct {
+	prompter  Prompter
+	keys      AnswerKeys
+	answers   map[string]*answer
+	recording bool
+	recorded  map[string]*answer
+	// pending holds the answers generated for the prompts of ongoing list decisions
+	pending map[string][]string
+	// unrecorded holds the prompts of ongoing list decisions, which are recorded as a single list
+	unrecorded map[string]bool
+}
+
+// NewAnswersPrompter creates a prompter that wraps [prompter], using [keys] to
+// identify the prompts in answers files
+func NewAnswersPrompter(prompter Prompter, keys ...AnswerKeys) *AnswersPrompter {
+	allKeys := AnswerKeys{}
+	for _, k := range keys {
+		for promptStr, key := range k {
+			allKeys[promptStr] = key
+		}
+	}
+	return &AnswersPrompter{
+		prompter:   prompter,
+		keys:       allKeys,
+		answers:    map[string]*answer{},
+		recorded:   map[string]*answer{},
+		pending:    map[string][]string{},
+		unrecorded: map[string]bool{},
+	}
+}
+
+// LoadAnswers reads the YAML answers file at [path]. Nested sections are joined
+// into dotted keys, so that `evm: {chainId: 1}` answers the key evm.chainId.
+// Answers are taken verbatim, so that e.g. hex addresses are not read as numbers.
+func (p *AnswersPrompter) LoadAnswers(path string) error {
+	answersBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read answers file: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(answersBytes, &doc); err != nil {
+		return fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	if err := flattenAnswers("", doc.Content[0], p.answers); err != nil {
+		return fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+	return nil
+}
+
+// StartRecording makes the prompter keep all answers given from now on
+func (p *AnswersPrompter) StartRecording() {
+	p.recording = true
+}
+
+// SaveRecording writes all recorded answers into the YAML answers file at [path]
+func (p *AnswersPrompter) SaveRecording(path string) error {
+	doc := map[string]interface{}{}
+	for key, a := range p.recorded {
+		keyPath := strings.Split(key, ".")
+		if raw, ok := strings.CutPrefix(key, unkeyedAnswersKey+"."); ok {
+			keyPath = []string{unkeyedAnswersKey, raw}
+		}
+		if err := setAnswer(doc, keyPath, recordedValue(a)); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), constants.WriteReadReadPerms)
+}
+
+func flattenAnswers(prefix string, section *yaml.Node, answers map[string]*answer) error {
+	if section.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s must be a map", strings.TrimSuffix(prefix, "."))
+	}
+	for i := 0; i+1 < len(section.Content); i += 2 {
+		k, v := section.Content[i].Value, resolveAlias(section.Content[i+1])
+		key := prefix + k
+		if prefix == "" && k == unkeyedAnswersKey {
+			if v.Kind != yaml.MappingNode {
+				return fmt.Errorf("%q must be a map from prompt texts to answers", unkeyedAnswersKey)
+			}
+			for j := 0; j+1 < len(v.Content); j += 2 {
+				promptStr := v.Content[j].Value
+				a, err := toAnswer(resolveAlias(v.Content[j+1]))
+				if err != nil {
+					return fmt.Errorf("%s: %w", promptStr, err)
+				}
+				answers[unkeyedAnswersKey+"."+promptStr] = a
+			}
+			continue
+		}
+		if v.Kind == yaml.MappingNode {
+			if err := flattenAnswers(key+".", v, answers); err != nil {
+				return err
+			}
+			continue
+		}
+		a, err := toAnswer(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		answers[key] = a
+	}
+	return nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		return node.Alias
+	}
+	return node
+}
+
+func toAnswer(node *yaml.Node) (*answer, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return nil, errors.New("missing answer")
+		}
+		return &answer{values: []interface{}{node.Value}}, nil
+	case yaml.SequenceNode:
+		a := &answer{list: true}
+		for _, elem := range node.Content {
+			elem = resolveAlias(elem)
+			switch elem.Kind {
+			case yaml.ScalarNode:
+				a.values = append(a.values, elem.Value)
+			case yaml.SequenceNode:
+				items := make([]string, 0, len(elem.Content))
+				for _, item := range elem.Content {
+					item = resolveAlias(item)
+					if item.Kind != yaml.ScalarNode {
+						return nil, errors.New("unexpected nested answer")
+					}
+					items = append(items, item.Value)
+				}
+				a.values = append(a.values, items)
+			default:
+				return nil, errors.New("unexpected map answer")
+			}
+		}
+		return a, nil
+	default:
+		return nil, errors.New("unexpected map answer")
+	}
+}
+
+// recordedValue returns the value to be saved for [a], as a scalar if the prompt was
+// answered once, or as a list otherwise
+func recordedValue(a *answer) interface{} {
+	values := make([]interface{}, len(a.values))
+	for i, v := range a.values {
+		switch v := v.(type) {
+		case []string:
+			items := make([]interface{}, len(v))
+			for j, item := range v {
+				items[j] = toYAMLScalar(item)
+			}
+			values[i] = items
+		case string:
+			values[i] = toYAMLScalar(v)
+		}
+	}
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+// toYAMLScalar keeps booleans and integers unquoted in the answers file
+func toYAMLScalar(s string) interface{} {
+	if b, err := strconv.ParseBool(s); err == nil && strconv.FormatBool(b) == s {
+		return b
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil && strconv.FormatInt(i, 10) == s {
+		return i
+	}
+	return s
+}
+
+func setAnswer(doc map[string]interface{}, path []string, value interface{}) error {
+	for i, k := range path[:len(path)-1] {
+		section, ok := doc[k]
+		if !ok {
+			section = map[string]interface{}{}
+			doc[k] = section
+		}
+		sectionMap, ok := section.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("answer key %s is both a value and a section", strings.Join(path[:i+1], "."))
+		}
+		doc = sectionMap
+	}
+	last := path[len(path)-1]
+	if _, ok := doc[last]; ok {
+		return fmt.Errorf("answer key %s is both a value and a section", strings.Join(path, "."))
+	}
+	doc[last] = value
+	return nil
+}
+
+// key returns the answers file key of [promptStr]
+func (p *AnswersPrompter) key(promptStr string) string {
+	longestPrefix := ""
+	key := ""
+	for prefix, prefixKey := range p.keys {
+		if strings.HasPrefix(promptStr, prefix) && len(prefix) > len(longestPrefix) {
+			longestPrefix = prefix
+			key = prefixKey
+		}
+	}
+	if key == "" {
+		return unkeyedAnswersKey + "." + promptStr
+	}
+	return key
+}
+
+// next returns the answer for the current occurrence of [promptStr], if any
+func (p *AnswersPrompter) next(promptStr string) (string, bool) {
+	if pending := p.pending[promptStr]; len(pending) > 0 {
+		p.pending[promptStr] = pending[1:]
+		return pending[0], true
+	}
+	a, ok := p.answers[p.key(promptStr)]
+	if !ok || len(a.values) == 0 {
+		return "", false
+	}
+	if !a.list {
+		s, ok := a.values[0].(string)
+		return s, ok
+	}
+	if a.next >= len(a.values) {
+		return "", false
+	}
+	s, ok := a.values[a.next].(string)
+	if !ok {
+		return "", false
+	}
+	a.next++
+	return s, true
+}
+
+// nextList returns the items for the current occurrence of list decision [promptStr], if any.
+// A list of lists answers successive occurrences, while a flat list answers a single one.
+func (p *AnswersPrompter) nextList(promptStr string) ([]string, bool) {
+	a, ok := p.answers[p.key(promptStr)]
+	if !ok {
+		return nil, false
+	}
+	if a.next < len(a.values) {
+		if items, ok := a.values[a.next].([]string); ok {
+			a.next++
+			return items, true
+		}
+	}
+	if a.next > 0 {
+		return nil, false
+	}
+	items := make([]string, 0, len(a.values))
+	for _, v := range a.values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		items = append(items, s)
+	}
+	a.next = len(a.values)
+	return items, true
+}
+
+func (p *AnswersPrompter) record(promptStr string, value interface{}) {
+	if !p.recording || p.unrecorded[promptStr] {
+		return
+	}
+	key := p.key(promptStr)
+	a, ok := p.recorded[key]
+	if !ok {
+		a = &answer{}
+		p.recorded[key] = a
+	}
+	a.values = append(a.values, value)
+}
+
+func (p *AnswersPrompter) startListDecision(prompt string, capturePrompt string) {
+	p.unrecorded[prompt] = true
+	p.unrecorded[capturePrompt] = true
+	p.unrecorded[removeElementPrompt] = true
+	items, ok := p.nextList(prompt)
+	if !ok {
+		return
+	}
+	decisions := make([]string, 0, len(items)+1)
+	for range items {
+		decisions = append(decisions, Add)
+	}
+	p.pending[prompt] = append(decisions, Done)
+	p.pending[capturePrompt] = items
+}
+
+func (p *AnswersPrompter) recordListDecision(prompt string, items []string) {
+	delete(p.unrecorded, prompt)
+	p.record(prompt, items)
+}
+
+func (p *AnswersPrompter) endListDecision(prompt string, capturePrompt string) {
+	delete(p.unrecorded, prompt)
+	delete(p.unrecorded, capturePrompt)
+	delete(p.unrecorded, removeElementPrompt)
+	delete(p.pending, prompt)
+	delete(p.pending, capturePrompt)
+}
+
+// answerOrPrompt gets the value for [promptStr] from the answers, parsing it with [parse],
+// or otherwise by calling [prompt]. The value is recorded formatted with [format], if given.
+func answerOrPrompt[T any](
+	p *AnswersPrompter,
+	promptStr string,
+	parse func(string) (T, error),
+	prompt func() (T, error),
+	format func(T) string,
+) (T, error) {
+	var (
+		value T
+		err   error
+	)
+	if s, ok := p.next(promptStr); ok {
+		value, err = parse(s)
+		if err != nil {
+			return value, fmt.Errorf("invalid answer %q for %s: %w", s, p.key(promptStr), err)
+		}
+	} else {
+		value, err = prompt()
+		if err != nil {
+			return value, err
+		}
+	}
+	if format != nil {
+		p.record(promptStr, format(value))
+	}
+	return value, nil
+}
+
+// validated returns a parser that checks its input with [validate] before converting it with [convert]
+func validated[T any](validate func(string) error, convert func(string) (T, error)) func(string) (T, error) {
+	return func(s string) (T, error) {
+		if err := validate(s); err != nil {
+			var zero T
+			return zero, err
+		}
+		return convert(s)
+	}
+}
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func formatString(s string) string {
+	return s
+}
+
+func parseYesNo(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "y", "true":
+		return true, nil
+	case "no", "n", "false":
+		return false, nil
+	}
+	return false, errors.New("expected yes or no")
+}
+
+// matchOption returns the option given by [s], which is either the option itself
+// or a prefix that identifies a single option, e.g. an instance type without
+// its "(recommended)" mark
+func matchOption(s string, options []string) (string, error) {
+	var matches []string
+	for _, option := range options {
+		if option == s {
+			return option, nil
+		}
+		if strings.HasPrefix(option, s) {
+			matches = append(matches, option)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	return "", fmt.Errorf("expected one of %q", options)
+}
+
+func parseUint64Compare(comparators []Comparator) func(string) (uint64, error) {
+	return func(s string) (uint64, error) {
+		val, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return 0, err
+		}
+		for _, comparator := range comparators {
+			if err := comparator.Validate(val); err != nil {
+				return 0, err
+			}
+		}
+		return val, nil
+	}
+}
+
+func formatUint64(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func (p *AnswersPrompter) CapturePositiveBigInt(promptStr string) (*big.Int, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validatePositiveBigInt, func(s string) (*big.Int, error) {
+			amount, ok := new(big.Int).SetString(s, 10)
+			if !ok {
+				return nil, errors.New("SetString: error")
+			}
+			return amount, nil
+		}),
+		func() (*big.Int, error) { return p.prompter.CapturePositiveBigInt(promptStr) },
+		(*big.Int).String,
+	)
+}
+
+func (p *AnswersPrompter) CaptureAddress(promptStr string) (common.Address, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateAddress, func(s string) (common.Address, error) { return common.HexToAddress(s), nil }),
+		func() (common.Address, error) { return p.prompter.CaptureAddress(promptStr) },
+		common.Address.Hex,
+	)
+}
+
+func (p *AnswersPrompter) CaptureNewFilepath(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateNewFilepath, parseString),
+		func() (string, error) { return p.prompter.CaptureNewFilepath(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureExistingFilepath(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateExistingFilepath, parseString),
+		func() (string, error) { return p.prompter.CaptureExistingFilepath(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureYesNo(promptStr string) (bool, error) {
+	return answerOrPrompt(p, promptStr,
+		parseYesNo,
+		func() (bool, error) { return p.prompter.CaptureYesNo(promptStr) },
+		strconv.FormatBool,
+	)
+}
+
+func (p *AnswersPrompter) CaptureNoYes(promptStr string) (bool, error) {
+	return answerOrPrompt(p, promptStr,
+		parseYesNo,
+		func() (bool, error) { return p.prompter.CaptureNoYes(promptStr) },
+		strconv.FormatBool,
+	)
+}
+
+func (p *AnswersPrompter) CaptureList(promptStr string, options []string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		func(s string) (string, error) { return matchOption(s, options) },
+		func() (string, error) { return p.prompter.CaptureList(promptStr, options) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureString(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateNonEmpty, parseString),
+		func() (string, error) { return p.prompter.CaptureString(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CapturePassword(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateNonEmpty, parseString),
+		func() (string, error) { return p.prompter.CapturePassword(promptStr) },
+		nil,
+	)
+}
+
+func (p *AnswersPrompter) CaptureValidatedString(promptStr string, validator func(string) error) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validator, parseString),
+		func() (string, error) { return p.prompter.CaptureValidatedString(promptStr, validator) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureURL(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateURLFormat, parseString),
+		func() (string, error) { return p.prompter.CaptureURL(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureRepoBranch(promptStr string, repo string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateNonEmpty, parseString),
+		func() (string, error) { return p.prompter.CaptureRepoBranch(promptStr, repo) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureRepoFile(promptStr string, repo string, branch string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateNonEmpty, parseString),
+		func() (string, error) { return p.prompter.CaptureRepoFile(promptStr, repo, branch) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureGitURL(promptStr string) (*url.URL, error) {
+	return answerOrPrompt(p, promptStr,
+		url.ParseRequestURI,
+		func() (*url.URL, error) { return p.prompter.CaptureGitURL(promptStr) },
+		(*url.URL).String,
+	)
+}
+
+func (p *AnswersPrompter) CaptureStringAllowEmpty(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		parseString,
+		func() (string, error) { return p.prompter.CaptureStringAllowEmpty(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureEmail(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateEmail, parseString),
+		func() (string, error) { return p.prompter.CaptureEmail(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureIndex(promptStr string, options []any) (int, error) {
+	optionStrs := make([]string, len(options))
+	for i, option := range options {
+		optionStrs[i] = fmt.Sprint(option)
+	}
+	return answerOrPrompt(p, promptStr,
+		func(s string) (int, error) {
+			option, err := matchOption(s, optionStrs)
+			if err != nil {
+				return 0, err
+			}
+			return getIndexInSlice(optionStrs, option)
+		},
+		func() (int, error) { return p.prompter.CaptureIndex(promptStr, options) },
+		func(index int) string { return optionStrs[index] },
+	)
+}
+
+func (p *AnswersPrompter) CaptureVersion(promptStr string) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(func(s string) error {
+			if !semver.IsValid(s) {
+				return errors.New("version must be a legal semantic version (ex: v1.1.1)")
+			}
+			return nil
+		}, parseString),
+		func() (string, error) { return p.prompter.CaptureVersion(promptStr) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureTestnetDuration(promptStr string) (time.Duration, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateTestnetValidatorStakingDuration, time.ParseDuration),
+		func() (time.Duration, error) { return p.prompter.CaptureTestnetDuration(promptStr) },
+		time.Duration.String,
+	)
+}
+
+func (p *AnswersPrompter) CaptureMainnetDuration(promptStr string) (time.Duration, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateMainnetValidatorStakingDuration, time.ParseDuration),
+		func() (time.Duration, error) { return p.prompter.CaptureMainnetDuration(promptStr) },
+		time.Duration.String,
+	)
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(constants.TimeParseLayout)
+}
+
+func (p *AnswersPrompter) CaptureDate(promptStr string) (time.Time, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateTime, func(s string) (time.Time, error) { return time.Parse(constants.TimeParseLayout, s) }),
+		func() (time.Time, error) { return p.prompter.CaptureDate(promptStr) },
+		formatTime,
+	)
+}
+
+func (p *AnswersPrompter) CaptureNodeID(promptStr string) (ids.NodeID, error) {
+	return answerOrPrompt(p, promptStr,
+		ids.NodeIDFromString,
+		func() (ids.NodeID, error) { return p.prompter.CaptureNodeID(promptStr) },
+		ids.NodeID.String,
+	)
+}
+
+func (p *AnswersPrompter) CaptureID(promptStr string) (ids.ID, error) {
+	return answerOrPrompt(p, promptStr,
+		ids.FromString,
+		func() (ids.ID, error) { return p.prompter.CaptureID(promptStr) },
+		ids.ID.String,
+	)
+}
+
+func (p *AnswersPrompter) CaptureWeight(promptStr string) (uint64, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateWeight, func(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) }),
+		func() (uint64, error) { return p.prompter.CaptureWeight(promptStr) },
+		formatUint64,
+	)
+}
+
+func (p *AnswersPrompter) CapturePositiveInt(promptStr string, comparators []Comparator) (int, error) {
+	return answerOrPrompt(p, promptStr,
+		func(s string) (int, error) {
+			val, err := strconv.Atoi(s)
+			if err != nil {
+				return 0, err
+			}
+			if val < 0 {
+				return 0, errors.New("input is less than 0")
+			}
+			if _, err := parseUint64Compare(comparators)(s); err != nil {
+				return 0, err
+			}
+			return val, nil
+		},
+		func() (int, error) { return p.prompter.CapturePositiveInt(promptStr, comparators) },
+		strconv.Itoa,
+	)
+}
+
+func (p *AnswersPrompter) CaptureInt(promptStr string) (int, error) {
+	return answerOrPrompt(p, promptStr,
+		strconv.Atoi,
+		func() (int, error) { return p.prompter.CaptureInt(promptStr) },
+		strconv.Itoa,
+	)
+}
+
+func (p *AnswersPrompter) CaptureUint32(promptStr string) (uint32, error) {
+	return answerOrPrompt(p, promptStr,
+		func(s string) (uint32, error) {
+			val, err := strconv.ParseUint(s, 0, 32)
+			return uint32(val), err
+		},
+		func() (uint32, error) { return p.prompter.CaptureUint32(promptStr) },
+		func(v uint32) string { return formatUint64(uint64(v)) },
+	)
+}
+
+func (p *AnswersPrompter) CaptureUint64(promptStr string) (uint64, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(validateBiggerThanZero, func(s string) (uint64, error) { return strconv.ParseUint(s, 0, 64) }),
+		func() (uint64, error) { return p.prompter.CaptureUint64(promptStr) },
+		formatUint64,
+	)
+}
+
+func (p *AnswersPrompter) CaptureFloat(promptStr string, validator func(float64) error) (float64, error) {
+	return answerOrPrompt(p, promptStr,
+		func(s string) (float64, error) {
+			val, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, err
+			}
+			return val, validator(val)
+		},
+		func() (float64, error) { return p.prompter.CaptureFloat(promptStr, validator) },
+		func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) },
+	)
+}
+
+func (p *AnswersPrompter) CaptureUint64Compare(promptStr string, comparators []Comparator) (uint64, error) {
+	return answerOrPrompt(p, promptStr,
+		parseUint64Compare(comparators),
+		func() (uint64, error) { return p.prompter.CaptureUint64Compare(promptStr, comparators) },
+		formatUint64,
+	)
+}
+
+func (p *AnswersPrompter) CaptureOChainAddress(promptStr string, network models.Network) (string, error) {
+	return answerOrPrompt(p, promptStr,
+		validated(getOChainValidationFunc(network), parseString),
+		func() (string, error) { return p.prompter.CaptureOChainAddress(promptStr, network) },
+		formatString,
+	)
+}
+
+func (p *AnswersPrompter) CaptureFutureDate(promptStr string, minDate time.Time) (time.Time, error) {
+	return answerOrPrompt(p, promptStr,
+		func(s string) (time.Time, error) {
+			t, err := time.Parse(constants.TimeParseLayout, s)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if minDate == (time.Time{}) {
+				minDate = time.Now()
+			}
+			if t.Before(minDate.UTC()) {
+				return time.Time{}, fmt.Errorf("the provided date is before %s UTC", minDate.Format(constants.TimeParseLayout))
+			}
+			return t, nil
+		},
+		func() (time.Time, error) { return p.prompter.CaptureFutureDate(promptStr, minDate) },
+		formatTime,
+	)
+}
+
+func (p *AnswersPrompter) ChooseKeyOrLedger(goal string) (bool, error) {
+	const (
+		keyOption    = "Use stored key"
+		ledgerOption = "Use ledger"
+	)
+	promptStr := fmt.Sprintf("Which key source should be used to %s?", goal)
+	useStoredKey, err := answerOrPrompt(p, promptStr,
+		func(s string) (bool, error) {
+			option, err := matchOption(s, []string{keyOption, ledgerOption})
+			return option == keyOption, err
+		},
+		func() (bool, error) { return p.prompter.ChooseKeyOrLedger(goal) },
+		func(useStoredKey bool) string {
+			if useStoredKey {
+				return keyOption
+			}
+			return ledgerOption
+		},
+	)
+	return useStoredKey, err
+}
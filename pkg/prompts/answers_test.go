@@ -0,0 +1,169 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package prompts
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAdminAddr   = "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"
+	testEnabledAddr = "0x0000000000000000000000000000000000000001"
+)
+
+var testAnswerKeys = AnswerKeys{
+	"ChainId":                                 "evm.chainId",
+	"Token symbol":                            "evm.tokenSymbol",
+	"How would you like to set fees":          "fees.preset",
+	"Configure native minting allow list":     "precompiles.nativeMinter.admins",
+	"Would you like to airdrop more tokens?":  "airdrop.more",
+	"How many nodes do you want to set up in": "node.numNodes",
+}
+
+func writeAnswers(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "answers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestAnswersPrompterReplay(t *testing.T) {
+	require := require.New(t)
+
+	path := writeAnswers(t, `
+evm:
+  chainId: 12345
+  tokenSymbol: TST
+fees:
+  preset: Low disk use
+precompiles:
+  nativeMinter:
+    admins:
+      - `+testAdminAddr+`
+airdrop:
+  more: [yes, no]
+node:
+  numNodes: [2, 3]
+prompts:
+  "Enter the subnet name": mysubnet
+`)
+	prompter := NewAnswersPrompter(NewNonInteractivePrompter("odyssey subnet create", nil), testAnswerKeys)
+	require.NoError(prompter.LoadAnswers(path))
+
+	chainID, err := prompter.CapturePositiveBigInt("ChainId")
+	require.NoError(err)
+	require.Equal(big.NewInt(12345), chainID)
+
+	symbol, err := prompter.CaptureString("Token symbol")
+	require.NoError(err)
+	require.Equal("TST", symbol)
+
+	// options can be identified by a unique prefix
+	preset, err := prompter.CaptureList("How would you like to set fees", []string{"Low disk use / Low Throughput", "Customize fee config"})
+	require.NoError(err)
+	require.Equal("Low disk use / Low Throughput", preset)
+
+	_, err = prompter.CaptureList("How would you like to set fees", []string{"Customize fee config"})
+	require.ErrorContains(err, "invalid answer")
+
+	admins, cancelled, err := CaptureListDecision[common.Address](
+		prompter, "Configure native minting allow list", prompter.CaptureAddress, "Enter Address ", "Address", "",
+	)
+	require.NoError(err)
+	require.False(cancelled)
+	require.Equal([]common.Address{common.HexToAddress(testAdminAddr)}, admins)
+
+	// list answers are used in order, and then the prompter falls back to the wrapped one
+	for _, expected := range []bool{true, false} {
+		more, err := prompter.CaptureNoYes("Would you like to airdrop more tokens?")
+		require.NoError(err)
+		require.Equal(expected, more)
+	}
+	_, err = prompter.CaptureNoYes("Would you like to airdrop more tokens?")
+	require.ErrorIs(err, ErrNonInteractive)
+
+	// prompts built at runtime are matched by prefix
+	numNodes, err := prompter.CaptureUint32("How many nodes do you want to set up in us-east-1 AWS Region?")
+	require.NoError(err)
+	require.Equal(uint32(2), numNodes)
+	numNodes, err = prompter.CaptureUint32("How many nodes do you want to set up in us-west-1 AWS Region?")
+	require.NoError(err)
+	require.Equal(uint32(3), numNodes)
+
+	name, err := prompter.CaptureString("Enter the subnet name")
+	require.NoError(err)
+	require.Equal("mysubnet", name)
+}
+
+func TestAnswersPrompterRecord(t *testing.T) {
+	require := require.New(t)
+
+	path := writeAnswers(t, `
+evm:
+  chainId: 12345
+precompiles:
+  nativeMinter:
+    admins: [`+testAdminAddr+`, `+testEnabledAddr+`]
+airdrop:
+  more: [yes, no]
+prompts:
+  "Choose your VM": Subnet-EVM
+`)
+	prompter := NewAnswersPrompter(NewNonInteractivePrompter("odyssey subnet create", nil), testAnswerKeys)
+	require.NoError(prompter.LoadAnswers(path))
+	prompter.StartRecording()
+
+	_, err := prompter.CapturePositiveBigInt("ChainId")
+	require.NoError(err)
+	_, _, err = CaptureListDecision[common.Address](
+		prompter, "Configure native minting allow list", prompter.CaptureAddress, "Enter Address ", "Address", "",
+	)
+	require.NoError(err)
+	for i := 0; i < 2; i++ {
+		_, err = prompter.CaptureNoYes("Would you like to airdrop more tokens?")
+		require.NoError(err)
+	}
+	_, err = prompter.CaptureList("Choose your VM", []string{"Subnet-EVM", "Custom"})
+	require.NoError(err)
+
+	recordPath := filepath.Join(t.TempDir(), "recorded.yaml")
+	require.NoError(prompter.SaveRecording(recordPath))
+	recorded, err := os.ReadFile(recordPath)
+	require.NoError(err)
+	require.Equal(`airdrop:
+  more:
+    - true
+    - false
+evm:
+  chainId: 12345
+precompiles:
+  nativeMinter:
+    admins:
+      - `+testAdminAddr+`
+      - "`+common.HexToAddress(testEnabledAddr).Hex()+`"
+prompts:
+  Choose your VM: Subnet-EVM
+`, string(recorded))
+
+	// the recorded file replays the same answers
+	replay := NewAnswersPrompter(NewNonInteractivePrompter("odyssey subnet create", nil), testAnswerKeys)
+	require.NoError(replay.LoadAnswers(recordPath))
+	admins, _, err := CaptureListDecision[common.Address](
+		replay, "Configure native minting allow list", replay.CaptureAddress, "Enter Address ", "Address", "",
+	)
+	require.NoError(err)
+	require.Len(admins, 2)
+}
+
+func TestAnswersPrompterInvalidFile(t *testing.T) {
+	require := require.New(t)
+
+	prompter := NewAnswersPrompter(NewNonInteractivePrompter("", nil), testAnswerKeys)
+	require.ErrorContains(prompter.LoadAnswers(writeAnswers(t, "prompts: [a, b]")), "must be a map")
+	require.ErrorContains(prompter.LoadAnswers(writeAnswers(t, "evm:\n  chainId:\n")), "evm.chainId: missing answer")
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package prompts
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var ErrNonInteractive = errors.New("user input is required, but prompting is disabled by non-interactive mode")
+
+// flagHintAnnotationPrefix prefixes the command annotations that hold flag hints
+const flagHintAnnotationPrefix = "prompts.flagHint:"
+
+// FlagHints maps prompt prefixes to the flags that provide the same value
+type FlagHints map[string][]string
+
+// RegisterFlagHint records on [cmd] that the value asked by its prompts starting with
+// [promptPrefix] can be given with [flags] instead, so that non-interactive errors of [cmd]
+// can point to them. Flags are given as they should be shown to the user, e.g. "--testnet".
+func RegisterFlagHint(cmd *cobra.Command, promptPrefix string, flags ...string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[flagHintAnnotationPrefix+promptPrefix] = strings.Join(flags, " ")
+}
+
+// GetFlagHints returns the flag hints registered on [cmd] with RegisterFlagHint
+func GetFlagHints(cmd *cobra.Command) FlagHints {
+	flagHints := FlagHints{}
+	for annotation, flags := range cmd.Annotations {
+		if promptPrefix, ok := strings.CutPrefix(annotation, flagHintAnnotationPrefix); ok {
+			flagHints[promptPrefix] = strings.Fields(flags)
+		}
+	}
+	return flagHints
+}
+
+// get returns the flags registered for the longest prefix of [promptStr]
+func (h FlagHints) get(promptStr string) []string {
+	longestPrefix := ""
+	var flags []string
+	for prefix, prefixFlags := range h {
+		if strings.HasPrefix(promptStr, prefix) && len(prefix) > len(longestPrefix) {
+			longestPrefix = prefix
+			flags = prefixFlags
+		}
+	}
+	return flags
+}
+
+// WithFlagHint points the non-interactive error [err] of a prompt to [flags], if no
+// flag is known for it yet. It is meant for prompts shared by many commands, whose
+// value is given the same way in all of them. Other errors are returned as is.
+func WithFlagHint(err error, flags ...string) error {
+	var nonInteractiveErr *NonInteractiveError
+	if errors.As(err, &nonInteractiveErr) && len(nonInteractiveErr.Flags) == 0 {
+		nonInteractiveErr.Flags = flags
+	}
+	return err
+}
+
+// NonInteractiveError is returned by the non-interactive prompter for any attempted prompt
+type NonInteractiveError struct {
+	// Prompt is the text that would have been shown to the user
+	Prompt string
+	// Flags are the flags that can be used to provide the value instead, if known
+	Flags []string
+	// Command is the command path, used to point to its help when no flag is known
+	Command string
+}
+
+func (e *NonInteractiveError) Error() string {
+	var hint string
+	switch {
+	case len(e.Flags) == 1:
+		hint = "use " + e.Flags[0] + " to provide it"
+	case len(e.Flags) > 1:
+		hint = "use " + strings.Join(e.Flags[:len(e.Flags)-1], ", ") + " or " + e.Flags[len(e.Flags)-1] + " to provide it"
+	case e.Command != "":
+		hint = fmt.Sprintf("see %q for the flags that provide it", e.Command+" --help")
+	default:
+		hint = "use the command flags to provide it"
+	}
+	return fmt.Sprintf("%s: %q (%s)", ErrNonInteractive, e.Prompt, hint)
+}
+
+func (*NonInteractiveError) Unwrap() error {
+	return ErrNonInteractive
+}
+
+type nonInteractivePrompter struct {
+	command   string
+	flagHints FlagHints
+}
+
+// NewNonInteractivePrompter creates a prompter that fails on every prompt with a NonInteractiveError,
+// naming the flags given by [flagHints], or the help of [command] if there are none.
+func NewNonInteractivePrompter(command string, flagHints FlagHints) Prompter {
+	return &nonInteractivePrompter{command: command, flagHints: flagHints}
+}
+
+func (p *nonInteractivePrompter) fail(promptStr string) error {
+	return &NonInteractiveError{
+		Prompt:  promptStr,
+		Flags:   p.flagHints.get(promptStr),
+		Command: p.command,
+	}
+}
+
+func (p *nonInteractivePrompter) CapturePositiveBigInt(promptStr string) (*big.Int, error) {
+	return nil, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureAddress(promptStr string) (common.Address, error) {
+	return common.Address{}, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureNewFilepath(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureExistingFilepath(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureYesNo(promptStr string) (bool, error) {
+	return false, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureNoYes(promptStr string) (bool, error) {
+	return false, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureList(promptStr string, _ []string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureString(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CapturePassword(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureValidatedString(promptStr string, _ func(string) error) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureURL(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureRepoBranch(promptStr string, _ string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureRepoFile(promptStr string, _ string, _ string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureGitURL(promptStr string) (*url.URL, error) {
+	return nil, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureStringAllowEmpty(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureEmail(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureIndex(promptStr string, _ []any) (int, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureVersion(promptStr string) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureTestnetDuration(promptStr string) (time.Duration, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureMainnetDuration(promptStr string) (time.Duration, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureDate(promptStr string) (time.Time, error) {
+	return time.Time{}, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureNodeID(promptStr string) (ids.NodeID, error) {
+	return ids.EmptyNodeID, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureID(promptStr string) (ids.ID, error) {
+	return ids.Empty, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureWeight(promptStr string) (uint64, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CapturePositiveInt(promptStr string, _ []Comparator) (int, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureInt(promptStr string) (int, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureUint32(promptStr string) (uint32, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureUint64(promptStr string) (uint64, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureFloat(promptStr string, _ func(float64) error) (float64, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureUint64Compare(promptStr string, _ []Comparator) (uint64, error) {
+	return 0, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureOChainAddress(promptStr string, _ models.Network) (string, error) {
+	return "", p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) CaptureFutureDate(promptStr string, _ time.Time) (time.Time, error) {
+	return time.Time{}, p.fail(promptStr)
+}
+
+func (p *nonInteractivePrompter) ChooseKeyOrLedger(goal string) (bool, error) {
+	return false, p.fail(fmt.Sprintf("Which key source should be used to %s?", goal))
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package prompts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonInteractivePrompter(t *testing.T) {
+	require := require.New(t)
+
+	cmd := &cobra.Command{Use: "stats"}
+	RegisterFlagHint(cmd, "Choose a network", "--testnet", "--mainnet")
+	RegisterFlagHint(cmd, "Choose a network to deploy", "--local")
+	RegisterFlagHint(cmd, "Amount to send", "--amount")
+
+	prompter := NewNonInteractivePrompter("odyssey subnet stats", GetFlagHints(cmd))
+
+	_, err := prompter.CaptureList("Choose a network for the operation", []string{"Testnet", "Mainnet"})
+	require.ErrorIs(err, ErrNonInteractive)
+	var nonInteractiveErr *NonInteractiveError
+	require.True(errors.As(err, &nonInteractiveErr))
+	require.Equal([]string{"--testnet", "--mainnet"}, nonInteractiveErr.Flags)
+	require.ErrorContains(err, "use --testnet or --mainnet to provide it")
+
+	// longest registered prefix wins
+	_, err = prompter.CaptureList("Choose a network to deploy on", []string{"Local Network"})
+	require.ErrorContains(err, "use --local to provide it")
+
+	_, err = prompter.CaptureFloat("Amount to send (DIONE units)", nil)
+	require.ErrorContains(err, "use --amount to provide it")
+
+	_, err = prompter.CaptureYesNo("Do you want to continue?")
+	require.ErrorIs(err, ErrNonInteractive)
+	require.ErrorContains(err, `"odyssey subnet stats --help"`)
+
+	_, err = prompter.ChooseKeyOrLedger("pay fees")
+	require.ErrorContains(err, "Which key source should be used to pay fees?")
+
+	_, err = prompter.CapturePassword("Enter passphrase for key ewoq")
+	require.ErrorContains(WithFlagHint(err, "ODYSSEY_CLI_KEY_PASSPHRASE"), "use ODYSSEY_CLI_KEY_PASSPHRASE to provide it")
+	// the flags of the command take precedence
+	_, err = prompter.CaptureList("Choose a network for the operation", nil)
+	require.ErrorContains(WithFlagHint(err, "--local"), "use --testnet or --mainnet to provide it")
+}
+
+func TestNonInteractivePrompterHintsScopedToCommand(t *testing.T) {
+	require := require.New(t)
+
+	transferCmd := &cobra.Command{Use: "transfer"}
+	RegisterFlagHint(transferCmd, "Amount to", "--amount")
+	otherCmd := &cobra.Command{Use: "other"}
+
+	_, err := NewNonInteractivePrompter("odyssey key transfer", GetFlagHints(transferCmd)).CaptureString("Amount to send")
+	require.ErrorContains(err, "use --amount to provide it")
+	_, err = NewNonInteractivePrompter("odyssey other", GetFlagHints(otherCmd)).CaptureString("Amount to stake")
+	require.ErrorContains(err, `see "odyssey other --help" for the flags that provide it`)
+}
@@ -38,6 +38,9 @@ const (
 	NotEq      = "Not Eq"
 )
 
+// removeElementPrompt is used by CaptureListDecision to delete an item
+const removeElementPrompt = "Choose element to remove:"
+
 var errNoKeys = errors.New("no keys")
 
 type Comparator struct {
@@ -77,6 +80,7 @@ type Prompter interface {
 	CaptureNoYes(promptStr string) (bool, error)
 	CaptureList(promptStr string, options []string) (string, error)
 	CaptureString(promptStr string) (string, error)
+	CapturePassword(promptStr string) (string, error)
 	CaptureValidatedString(promptStr string, validator func(string) error) (string, error)
 	CaptureURL(promptStr string) (string, error)
 	CaptureRepoBranch(promptStr string, repo string) (string, error)
@@ -130,6 +134,12 @@ func CaptureListDecision[T comparable](
 	info string,
 ) ([]T, bool, error) {
 	finalList := []T{}
+	// prompters with answers provide the whole list instead of each prompt
+	answerer, withAnswers := prompter.(listDecisionAnswerer)
+	if withAnswers {
+		answerer.startListDecision(prompt, capturePrompt)
+		defer answerer.endListDecision(prompt, capturePrompt)
+	}
 	for {
 		listDecision, err := prompter.CaptureList(
 			prompt, []string{Add, Del, Preview, MoreInfo, Done, Cancel},
@@ -157,7 +167,7 @@ func CaptureListDecision[T comparable](
 			for _, v := range finalList {
 				finalListAnyT = append(finalListAnyT, v)
 			}
-			index, err := prompter.CaptureIndex("Choose element to remove:", finalListAnyT)
+			index, err := prompter.CaptureIndex(removeElementPrompt, finalListAnyT)
 			if err != nil {
 				return nil, false, err
 			}
@@ -175,6 +185,13 @@ func CaptureListDecision[T comparable](
 				fmt.Println(info)
 			}
 		case Done:
+			if withAnswers {
+				items := make([]string, len(finalList))
+				for i, v := range finalList {
+					items[i] = fmt.Sprint(v)
+				}
+				answerer.recordListDecision(prompt, items)
+			}
 			return finalList, false, nil
 		case Cancel:
 			return nil, true, nil
@@ -593,6 +610,21 @@ func (*realPrompter) CaptureString(promptStr string) (string, error) {
 	return str, nil
 }
 
+func (*realPrompter) CapturePassword(promptStr string) (string, error) {
+	prompt := promptui.Prompt{
+		Label:    promptStr,
+		Mask:     '*',
+		Validate: validateNonEmpty,
+	}
+
+	str, err := prompt.Run()
+	if err != nil {
+		return "", err
+	}
+
+	return str, nil
+}
+
 func (*realPrompter) CaptureValidatedString(promptStr string, validator func(string) error) (string, error) {
 	prompt := promptui.Prompt{
 		Label:    promptStr,
@@ -0,0 +1,436 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/utils"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+)
+
+const (
+	// snapshot dirs are named by the network runner with this prefix
+	snapshotPrefix    = "onr-snapshot-"
+	networkConfigFile = "network.json"
+	// file inside exported archives with the sha256 sums of all the snapshot files
+	sumsFile       = "SHA256SUMS"
+	sumFileSuffix  = ".sha256"
+	trackSubnetKey = "track-subnets"
+)
+
+var (
+	ErrSnapshotNotFound    = errors.New("snapshot not found")
+	ErrInvalidSnapshotName = errors.New("invalid snapshot name")
+	versionRegex           = regexp.MustCompile(`v\d+\.\d+\.\d+`)
+)
+
+// Description contains the information of a snapshot that can be obtained
+// without loading it into a network
+type Description struct {
+	Name string
+	Path string
+	// binary used by the network when it was saved
+	BinaryPath string
+	// odysseygo version of the binary, if it can be inferred from its path
+	OdysseyGoVersion string
+	NodeNames        []string
+	// subnets tracked by the nodes of the network
+	SubnetIDs []string
+	// blockchains with custom chain configs
+	BlockchainIDs []string
+	Size          int64
+}
+
+// Dir returns the directory where snapshot [snapshotName] is stored
+func Dir(snapshotsDir string, snapshotName string) string {
+	return filepath.Join(snapshotsDir, snapshotPrefix+snapshotName)
+}
+
+// ValidateName checks that [snapshotName] is a plain dir name, so that the snapshot
+// can't refer to a path outside of the snapshots dir
+func ValidateName(snapshotName string) error {
+	if snapshotName == "" || snapshotName == "." ||
+		strings.Contains(snapshotName, "..") ||
+		strings.ContainsAny(snapshotName, `/\`) ||
+		snapshotName != filepath.Base(snapshotName) {
+		return fmt.Errorf("%w %q: it can't be empty or ., or contain path separators or ..", ErrInvalidSnapshotName, snapshotName)
+	}
+	return nil
+}
+
+// Exists checks if snapshot [snapshotName] is stored at [snapshotsDir]
+func Exists(snapshotsDir string, snapshotName string) (bool, error) {
+	if err := ValidateName(snapshotName); err != nil {
+		return false, err
+	}
+	info, err := os.Stat(Dir(snapshotsDir, snapshotName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return info.IsDir(), nil
+}
+
+// List returns the sorted names of all snapshots stored at [snapshotsDir]
+func List(snapshotsDir string) ([]string, error) {
+	entries, err := os.ReadDir(snapshotsDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	snapshotNames := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), snapshotPrefix) {
+			snapshotNames = append(snapshotNames, strings.TrimPrefix(entry.Name(), snapshotPrefix))
+		}
+	}
+	sort.Strings(snapshotNames)
+	return snapshotNames, nil
+}
+
+// Describe reads the network config saved into snapshot [snapshotName]
+func Describe(snapshotsDir string, snapshotName string) (*Description, error) {
+	snapshotDir := Dir(snapshotsDir, snapshotName)
+	if err := checkExists(snapshotsDir, snapshotName); err != nil {
+		return nil, err
+	}
+	networkConfigBytes, err := os.ReadFile(filepath.Join(snapshotDir, networkConfigFile))
+	if err != nil {
+		return nil, fmt.Errorf("failure reading network config of snapshot %s: %w", snapshotName, err)
+	}
+	networkConfig := network.Config{}
+	if err := json.Unmarshal(networkConfigBytes, &networkConfig); err != nil {
+		return nil, fmt.Errorf("failure unmarshalling network config of snapshot %s: %w", snapshotName, err)
+	}
+	desc := &Description{
+		Name:       snapshotName,
+		Path:       snapshotDir,
+		BinaryPath: networkConfig.BinaryPath,
+	}
+	subnetIDs := map[string]struct{}{}
+	blockchainIDs := map[string]struct{}{}
+	addTrackedSubnets(subnetIDs, networkConfig.Flags)
+	for blockchainID := range networkConfig.ChainConfigFiles {
+		blockchainIDs[blockchainID] = struct{}{}
+	}
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		desc.NodeNames = append(desc.NodeNames, nodeConfig.Name)
+		if desc.BinaryPath == "" {
+			desc.BinaryPath = nodeConfig.BinaryPath
+		}
+		addTrackedSubnets(subnetIDs, nodeConfig.Flags)
+		for blockchainID := range nodeConfig.ChainConfigFiles {
+			blockchainIDs[blockchainID] = struct{}{}
+		}
+	}
+	sort.Strings(desc.NodeNames)
+	desc.SubnetIDs = sortedKeys(subnetIDs)
+	desc.BlockchainIDs = sortedKeys(blockchainIDs)
+	desc.OdysseyGoVersion = versionRegex.FindString(desc.BinaryPath)
+	desc.Size, err = dirSize(snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// Delete removes snapshot [snapshotName] from [snapshotsDir]
+func Delete(snapshotsDir string, snapshotName string) error {
+	if err := checkExists(snapshotsDir, snapshotName); err != nil {
+		return err
+	}
+	return os.RemoveAll(Dir(snapshotsDir, snapshotName))
+}
+
+// Export packages snapshot [snapshotName] as a tar.gz archive at [archivePath]
+//   - the archive contains the snapshot dir plus a SHA256SUMS file with the sums of all its files,
+//     that is verified on import
+//   - the sum of the archive itself is written to [archivePath].sha256
+//
+// returns the sha256 sum of the archive
+func Export(snapshotsDir string, snapshotName string, archivePath string) (string, error) {
+	if err := checkExists(snapshotsDir, snapshotName); err != nil {
+		return "", err
+	}
+	snapshotDir := Dir(snapshotsDir, snapshotName)
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archiveFile.Close()
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+	sums := []string{}
+	err = filepath.WalkDir(snapshotDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(snapshotsDir, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		sum, err := utils.GetSHA256FromDisk(path)
+		if err != nil {
+			return err
+		}
+		sums = append(sums, fmt.Sprintf("%s  %s", sum, header.Name))
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tarWriter, file)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failure packaging snapshot %s: %w", snapshotName, err)
+	}
+	sumsBytes := []byte(strings.Join(sums, "\n") + "\n")
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:     sumsFile,
+		Mode:     constants.WriteReadReadPerms,
+		Size:     int64(len(sumsBytes)),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return "", err
+	}
+	if _, err := tarWriter.Write(sumsBytes); err != nil {
+		return "", err
+	}
+	if err := tarWriter.Close(); err != nil {
+		return "", err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return "", err
+	}
+	if err := archiveFile.Close(); err != nil {
+		return "", err
+	}
+	archiveSum, err := utils.GetSHA256FromDisk(archivePath)
+	if err != nil {
+		return "", err
+	}
+	archiveSumLine := fmt.Sprintf("%s  %s\n", archiveSum, filepath.Base(archivePath))
+	if err := os.WriteFile(archivePath+sumFileSuffix, []byte(archiveSumLine), constants.WriteReadReadPerms); err != nil {
+		return "", err
+	}
+	return archiveSum, nil
+}
+
+// Import installs the snapshot packaged by Export at [archivePath] into [snapshotsDir]
+//   - if [archivePath].sha256 exists, the archive is verified against it
+//   - all the snapshot files are verified against the SHA256SUMS file of the archive
+//   - if [snapshotName] is empty, the name of the exported snapshot is used
+//
+// fails if a snapshot with the same name already exists. returns the name of the imported snapshot
+func Import(snapshotsDir string, archivePath string, snapshotName string) (string, error) {
+	expectedArchiveSum, err := getExpectedArchiveSum(archivePath)
+	if err != nil {
+		return "", err
+	}
+	if snapshotName != "" {
+		if err := ValidateName(snapshotName); err != nil {
+			return "", err
+		}
+	}
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archiveFile.Close()
+	if err := os.MkdirAll(snapshotsDir, constants.DefaultPerms755); err != nil {
+		return "", err
+	}
+	tmpDir, err := os.MkdirTemp(snapshotsDir, "import-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpDir)
+	// the archive is summed while it is extracted, and the extracted files are only
+	// installed after the sum is verified
+	archiveHash := sha256.New()
+	if err := binutils.InstallTarGzStream(io.TeeReader(archiveFile, archiveHash), tmpDir); err != nil {
+		return "", fmt.Errorf("failure extracting snapshot archive: %w", err)
+	}
+	if _, err := io.Copy(archiveHash, archiveFile); err != nil {
+		return "", err
+	}
+	if gotSum := hex.EncodeToString(archiveHash.Sum(nil)); expectedArchiveSum != "" && gotSum != expectedArchiveSum {
+		return "", fmt.Errorf("checksum mismatch for snapshot archive %s: expected %s, got %s", archivePath, expectedArchiveSum, gotSum)
+	}
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		return "", err
+	}
+	exportedDirName := ""
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), snapshotPrefix) {
+			if exportedDirName != "" {
+				return "", fmt.Errorf("invalid snapshot archive: it contains more than one snapshot")
+			}
+			exportedDirName = entry.Name()
+		}
+	}
+	if exportedDirName == "" {
+		return "", fmt.Errorf("invalid snapshot archive: no snapshot found")
+	}
+	if err := verifySums(tmpDir, exportedDirName); err != nil {
+		return "", err
+	}
+	if snapshotName == "" {
+		snapshotName = strings.TrimPrefix(exportedDirName, snapshotPrefix)
+		if err := ValidateName(snapshotName); err != nil {
+			return "", fmt.Errorf("invalid snapshot archive: %w", err)
+		}
+	}
+	exists, err := Exists(snapshotsDir, snapshotName)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", fmt.Errorf("snapshot %s already exists", snapshotName)
+	}
+	if err := os.Rename(filepath.Join(tmpDir, exportedDirName), Dir(snapshotsDir, snapshotName)); err != nil {
+		return "", err
+	}
+	return snapshotName, nil
+}
+
+// getExpectedArchiveSum returns the sum of [archivePath] in the sum file written by Export,
+// or an empty string if there is no sum file
+func getExpectedArchiveSum(archivePath string) (string, error) {
+	sumBytes, err := os.ReadFile(archivePath + sumFileSuffix)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	return utils.SearchSHA256File(sumBytes, filepath.Base(archivePath))
+}
+
+// verifySums checks that all files of the extracted snapshot dir [dirName] at [baseDir]
+// are listed in the archive SHA256SUMS file with the right sums, and that all files
+// listed there were extracted
+func verifySums(baseDir string, dirName string) error {
+	sumsBytes, err := os.ReadFile(filepath.Join(baseDir, sumsFile))
+	if err != nil {
+		return fmt.Errorf("invalid snapshot archive: failure reading %s: %w", sumsFile, err)
+	}
+	verified := map[string]bool{}
+	err = filepath.WalkDir(filepath.Join(baseDir, dirName), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(baseDir, path)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		expectedSum, err := utils.SearchSHA256File(sumsBytes, relPath)
+		if err != nil {
+			return fmt.Errorf("invalid snapshot archive: %w", err)
+		}
+		gotSum, err := utils.GetSHA256FromDisk(path)
+		if err != nil {
+			return err
+		}
+		if gotSum != expectedSum {
+			return fmt.Errorf("checksum mismatch for snapshot file %s: expected %s, got %s", relPath, expectedSum, gotSum)
+		}
+		verified[relPath] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(sumsBytes), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && !verified[fields[1]] {
+			return fmt.Errorf("invalid snapshot archive: file %s listed in %s is missing", fields[1], sumsFile)
+		}
+	}
+	return nil
+}
+
+func checkExists(snapshotsDir string, snapshotName string) error {
+	exists, err := Exists(snapshotsDir, snapshotName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrSnapshotNotFound, snapshotName)
+	}
+	return nil
+}
+
+func addTrackedSubnets(subnetIDs map[string]struct{}, flags map[string]interface{}) {
+	trackedSubnets, ok := flags[trackSubnetKey].(string)
+	if !ok {
+		return
+	}
+	for _, subnetID := range strings.Split(trackedSubnets, ",") {
+		if subnetID = strings.TrimSpace(subnetID); subnetID != "" {
+			subnetIDs[subnetID] = struct{}{}
+		}
+	}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/binutils"
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/stretchr/testify/require"
+)
+
+const testNetworkConfig = `{
+	"genesis": "{}",
+	"flags": {"log-level": "info"},
+	"binaryPath": "/home/user/.odyssey-cli/bin/odysseygo/odysseygo-v1.10.10/odysseygo",
+	"chainConfigFiles": {"2oqJR5EEkHdAxJ8U7yHyA8zJwwM9o5n1UDWzUMGJm6KJcBwqSk": "{}"},
+	"nodeConfigs": [
+		{"name": "node2", "flags": {"track-subnets": "p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz"}},
+		{"name": "node1", "flags": {"track-subnets": "p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz"}}
+	]
+}`
+
+func createTestSnapshot(t *testing.T, snapshotsDir string, snapshotName string) {
+	require := require.New(t)
+	snapshotDir := Dir(snapshotsDir, snapshotName)
+	dbDir := filepath.Join(snapshotDir, "db", "node1")
+	require.NoError(os.MkdirAll(dbDir, constants.DefaultPerms755))
+	require.NoError(os.WriteFile(filepath.Join(snapshotDir, networkConfigFile), []byte(testNetworkConfig), constants.WriteReadReadPerms))
+	require.NoError(os.WriteFile(filepath.Join(dbDir, "000001.log"), []byte("db contents"), constants.WriteReadReadPerms))
+}
+
+func TestDescribe(t *testing.T) {
+	require := require.New(t)
+	snapshotsDir := t.TempDir()
+	createTestSnapshot(t, snapshotsDir, "test")
+	require.NoError(os.MkdirAll(filepath.Join(snapshotsDir, "not-a-snapshot"), constants.DefaultPerms755))
+
+	snapshotNames, err := List(snapshotsDir)
+	require.NoError(err)
+	require.Equal([]string{"test"}, snapshotNames)
+
+	desc, err := Describe(snapshotsDir, "test")
+	require.NoError(err)
+	require.Equal("v1.10.10", desc.OdysseyGoVersion)
+	require.Equal([]string{"node1", "node2"}, desc.NodeNames)
+	require.Equal([]string{"p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz"}, desc.SubnetIDs)
+	require.Equal([]string{"2oqJR5EEkHdAxJ8U7yHyA8zJwwM9o5n1UDWzUMGJm6KJcBwqSk"}, desc.BlockchainIDs)
+	require.Equal(int64(len(testNetworkConfig)+len("db contents")), desc.Size)
+
+	_, err = Describe(snapshotsDir, "missing")
+	require.ErrorIs(err, ErrSnapshotNotFound)
+
+	require.NoError(Delete(snapshotsDir, "test"))
+	snapshotNames, err = List(snapshotsDir)
+	require.NoError(err)
+	require.Empty(snapshotNames)
+}
+
+func TestExportImport(t *testing.T) {
+	require := require.New(t)
+	snapshotsDir := t.TempDir()
+	createTestSnapshot(t, snapshotsDir, "test")
+	archivePath := filepath.Join(t.TempDir(), "test.tar.gz")
+
+	sum, err := Export(snapshotsDir, "test", archivePath)
+	require.NoError(err)
+	sumBytes, err := os.ReadFile(archivePath + sumFileSuffix)
+	require.NoError(err)
+	require.Equal(sum+"  test.tar.gz\n", string(sumBytes))
+
+	// the exported name is already in use
+	_, err = Import(snapshotsDir, archivePath, "")
+	require.ErrorContains(err, "snapshot test already exists")
+
+	importedName, err := Import(snapshotsDir, archivePath, "imported")
+	require.NoError(err)
+	require.Equal("imported", importedName)
+	importedLog, err := os.ReadFile(filepath.Join(Dir(snapshotsDir, "imported"), "db", "node1", "000001.log"))
+	require.NoError(err)
+	require.Equal("db contents", string(importedLog))
+
+	otherSnapshotsDir := t.TempDir()
+	importedName, err = Import(otherSnapshotsDir, archivePath, "")
+	require.NoError(err)
+	require.Equal("test", importedName)
+	snapshotNames, err := List(otherSnapshotsDir)
+	require.NoError(err)
+	require.Equal([]string{"test"}, snapshotNames)
+
+	// a modified archive is rejected, and none of its files are installed
+	require.NoError(os.WriteFile(archivePath+sumFileSuffix, []byte("0000  test.tar.gz\n"), constants.WriteReadReadPerms))
+	rejectedSnapshotsDir := t.TempDir()
+	_, err = Import(rejectedSnapshotsDir, archivePath, "")
+	require.ErrorContains(err, "checksum mismatch for snapshot archive")
+	entries, err := os.ReadDir(rejectedSnapshotsDir)
+	require.NoError(err)
+	require.Empty(entries)
+}
+
+func TestVerifySums(t *testing.T) {
+	require := require.New(t)
+	snapshotsDir := t.TempDir()
+	createTestSnapshot(t, snapshotsDir, "test")
+	archivePath := filepath.Join(t.TempDir(), "test.tar.gz")
+	_, err := Export(snapshotsDir, "test", archivePath)
+	require.NoError(err)
+	archive, err := os.Open(archivePath)
+	require.NoError(err)
+	defer archive.Close()
+	extractedDir := t.TempDir()
+	require.NoError(binutils.InstallTarGzStream(archive, extractedDir))
+	dirName := snapshotPrefix + "test"
+	require.NoError(verifySums(extractedDir, dirName))
+
+	// a modified file is rejected
+	logPath := filepath.Join(extractedDir, dirName, "db", "node1", "000001.log")
+	require.NoError(os.WriteFile(logPath, []byte("other db contents"), constants.WriteReadReadPerms))
+	require.ErrorContains(verifySums(extractedDir, dirName), "checksum mismatch for snapshot file")
+
+	// a file listed in the sums but missing from the archive is rejected
+	require.NoError(os.Remove(logPath))
+	require.ErrorContains(verifySums(extractedDir, dirName), "db/node1/000001.log listed in SHA256SUMS is missing")
+}
+
+func TestValidateName(t *testing.T) {
+	require := require.New(t)
+	require.NoError(ValidateName("test"))
+	require.NoError(ValidateName("test.v2"))
+	for _, snapshotName := range []string{"", ".", "..", "../test", "a/b", `a\b`, "test..", "/test"} {
+		require.ErrorIs(ValidateName(snapshotName), ErrInvalidSnapshotName, snapshotName)
+	}
+
+	// names outside of the snapshots dir are rejected before touching it
+	baseDir := t.TempDir()
+	snapshotsDir := filepath.Join(baseDir, "snapshots")
+	createTestSnapshot(t, snapshotsDir, "test")
+	outsideDir := filepath.Join(baseDir, snapshotPrefix+"outside")
+	require.NoError(os.MkdirAll(outsideDir, constants.DefaultPerms755))
+	require.ErrorIs(Delete(snapshotsDir, "../"+snapshotPrefix+"outside"), ErrInvalidSnapshotName)
+	require.DirExists(outsideDir)
+	_, err := Describe(snapshotsDir, "../test")
+	require.ErrorIs(err, ErrInvalidSnapshotName)
+	_, err = Import(snapshotsDir, filepath.Join(baseDir, "test.tar.gz"), "../imported")
+	require.ErrorIs(err, ErrInvalidSnapshotName)
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+set -e
+#name:TASK [keep current binary]
+mkdir -p "$(dirname {{ .BackupBinaryPath }})"
+test ! -e {{ .BackupBinaryPath }}
+cp {{ .BinaryPath }} {{ .BackupBinaryPath }}
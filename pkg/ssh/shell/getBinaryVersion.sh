@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+set -e
+if [ -f {{ .BinaryPath }} ]; then {{ .BinaryPath }} --version; fi
@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+set -e
+#name:TASK [restore kept binary]
+test -f {{ .BackupBinaryPath }}
+cp -f {{ .BackupBinaryPath }} {{ .BinaryPath }}
@@ -1,4 +1,4 @@
 #!/usr/bin/env bash
 set -e
-#name:TASK [upgrade odysseygo version]
+#name:TASK [upgrade subnet evm version]
 cp -f subnet-evm {{ .SubnetEVMBinaryPath }}
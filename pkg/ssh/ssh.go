@@ -28,6 +28,8 @@ type scriptInputs struct {
 	IsDevNet                bool
 	NetworkFlag             string
 	SubnetEVMBinaryPath     string
+	BackupBinaryPath        string
+	BinaryPath              string
 	SubnetEVMReleaseURL     string
 	SubnetEVMArchive        string
 	MonitoringDashboardPath string
@@ -52,26 +54,37 @@ func RunOverSSH(
 	scriptPath string,
 	templateVars scriptInputs,
 ) error {
+	if s, err := runOverSSHWithOutput(scriptDesc, host, timeout, scriptPath, templateVars); err != nil {
+		fmt.Println(string(s))
+		return err
+	}
+	return nil
+}
+
+// runOverSSHWithOutput runs provided script path over ssh as RunOverSSH, and returns its output
+func runOverSSHWithOutput(
+	scriptDesc string,
+	host *models.Host,
+	timeout time.Duration,
+	scriptPath string,
+	templateVars scriptInputs,
+) ([]byte, error) {
 	shellScript, err := script.ReadFile(scriptPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var script bytes.Buffer
 	t, err := template.New(scriptDesc).Parse(string(shellScript))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = t.Execute(&script, templateVars)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ux.Logger.PrintToUser(scriptLog(host.NodeID, scriptDesc))
-	if s, err := host.Command(script.String(), nil, timeout); err != nil {
-		fmt.Println(string(s))
-		return err
-	}
-	return nil
+	return host.Command(script.String(), nil, timeout)
 }
 
 func PostOverSSH(host *models.Host, path string, requestBody string) ([]byte, error) {
@@ -164,6 +177,41 @@ func RunSSHUpgradeSubnetEVM(host *models.Host, subnetEVMBinaryPath string) error
 	)
 }
 
+// RunSSHGetBinaryVersion runs script to print the version of the binary at binaryPath.
+// The output is empty if there is no binary at binaryPath
+func RunSSHGetBinaryVersion(host *models.Host, binaryPath string) ([]byte, error) {
+	return runOverSSHWithOutput(
+		"Get Binary Version",
+		host,
+		constants.SSHScriptTimeout,
+		"shell/getBinaryVersion.sh",
+		scriptInputs{BinaryPath: binaryPath},
+	)
+}
+
+// RunSSHBackupBinary runs script to keep the binary at binaryPath at backupBinaryPath,
+// failing if there is a binary at backupBinaryPath already
+func RunSSHBackupBinary(host *models.Host, binaryPath string, backupBinaryPath string) error {
+	return RunOverSSH(
+		"Backup Binary",
+		host,
+		constants.SSHScriptTimeout,
+		"shell/backupBinary.sh",
+		scriptInputs{BinaryPath: binaryPath, BackupBinaryPath: backupBinaryPath},
+	)
+}
+
+// RunSSHRestoreBinary runs script to restore the binary kept at backupBinaryPath to binaryPath
+func RunSSHRestoreBinary(host *models.Host, backupBinaryPath string, binaryPath string) error {
+	return RunOverSSH(
+		"Restore Binary",
+		host,
+		constants.SSHScriptTimeout,
+		"shell/restoreBinary.sh",
+		scriptInputs{BinaryPath: binaryPath, BackupBinaryPath: backupBinaryPath},
+	)
+}
+
 func RunSSHCopyMonitoringDashboards(host *models.Host, monitoringDashboardPath string) error {
 	if !utils.DirectoryExists(monitoringDashboardPath) {
 		return fmt.Errorf("%s does not exist", monitoringDashboardPath)
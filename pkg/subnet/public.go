@@ -33,7 +33,10 @@ import (
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
 
-var ErrNoSubnetAuthKeysInWallet = errors.New("auth wallet does not contain subnet auth keys")
+var (
+	ErrNoSubnetAuthKeysInWallet = errors.New("auth wallet does not contain subnet auth keys")
+	ErrNoSignersInWallet        = errors.New("wallet does not contain any of the missing signers of the tx")
+)
 
 type PublicDeployer struct {
 	LocalDeployer
@@ -344,6 +347,24 @@ func (d *PublicDeployer) AddPermissionlessDelegator(
 	return txID, nil
 }
 
+// creates an add permissionless delegator tx, signed by the wallet, without issuing it,
+// so that it can be saved to disk and later on signed and committed
+func (d *PublicDeployer) CreateAddPermissionlessDelegatorTx(
+	subnetID ids.ID,
+	subnetAssetID ids.ID,
+	nodeID ids.NodeID,
+	stakeAmount uint64,
+	startTime uint64,
+	endTime uint64,
+	recipientAddr ids.ShortID,
+) (*txs.Tx, error) {
+	wallet, err := d.loadWallet(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	return d.createAddPermissionlessDelegatorTx(recipientAddr, stakeAmount, subnetID, nodeID, subnetAssetID, startTime, endTime, wallet)
+}
+
 // - creates a subnet for [chain] using the given [controlKeys] and [threshold] as subnet authentication parameters
 func (d *PublicDeployer) DeploySubnet(
 	controlKeys []string,
@@ -466,6 +487,40 @@ func (d *PublicDeployer) Sign(
 	return nil
 }
 
+// signs the inputs of a given [tx] that the wallet is able to sign
+//   - used for txs without subnet auth, as export, import or permissionless delegator
+//     txs, that are signed by the owners of the consumed UTXOs
+//   - fails with ErrNoSignersInWallet if none of the missing signatures could be filled
+func (d *PublicDeployer) SignInputs(tx *txs.Tx) error {
+	wallet, err := d.loadWallet()
+	if err != nil {
+		return err
+	}
+	missingSigs, err := txutils.GetMissingSignatures(tx)
+	if err != nil {
+		return err
+	}
+	if d.kc.UsesLedger {
+		txName := txutils.GetLedgerDisplayName(tx)
+		if len(txName) == 0 {
+			showLedgerSignatureMsg(d.kc.UsesLedger, d.kc.HasOnlyOneKey(), "tx hash")
+		} else {
+			showLedgerSignatureMsg(d.kc.UsesLedger, d.kc.HasOnlyOneKey(), fmt.Sprintf("%s transaction", txName))
+		}
+	}
+	if err := d.signTx(tx, wallet); err != nil {
+		return err
+	}
+	remainingSigs, err := txutils.GetMissingSignatures(tx)
+	if err != nil {
+		return err
+	}
+	if remainingSigs == missingSigs {
+		return ErrNoSignersInWallet
+	}
+	return nil
+}
+
 func (d *PublicDeployer) loadWallet(preloadTxs ...ids.ID) (primary.Wallet, error) {
 	ctx := context.Background()
 	// filter out ids.Empty txs
@@ -693,6 +748,39 @@ func (d *PublicDeployer) issueAddPermissionlessDelegatorTX(
 	endTime uint64,
 	wallet primary.Wallet,
 ) (ids.ID, error) {
+	tx, err := d.createAddPermissionlessDelegatorTx(recipientAddr, stakeAmount, subnetID, nodeID, assetID, startTime, endTime, wallet)
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	ctx, cancel := utils.GetAPIContext()
+	defer cancel()
+	err = wallet.O().IssueTx(
+		tx,
+		common.WithContext(ctx),
+	)
+	if err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("timeout issuing/verifying tx with ID %s: %w", tx.ID(), err)
+		} else {
+			err = fmt.Errorf("error issuing tx with ID %s: %w", tx.ID(), err)
+		}
+		return ids.Empty, err
+	}
+
+	return tx.ID(), nil
+}
+
+func (d *PublicDeployer) createAddPermissionlessDelegatorTx(
+	recipientAddr ids.ShortID,
+	stakeAmount uint64,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	assetID ids.ID,
+	startTime uint64,
+	endTime uint64,
+	wallet primary.Wallet,
+) (*txs.Tx, error) {
 	options := d.getMultisigTxOptions([]ids.ShortID{})
 	owner := &secp256k1fx.OutputOwners{
 		Threshold: 1,
@@ -719,29 +807,13 @@ func (d *PublicDeployer) issueAddPermissionlessDelegatorTX(
 		options...,
 	)
 	if err != nil {
-		return ids.Empty, fmt.Errorf("error building tx: %w", err)
+		return nil, fmt.Errorf("error building tx: %w", err)
 	}
 	tx := txs.Tx{Unsigned: unsignedTx}
 	if err := wallet.O().Signer().Sign(context.Background(), &tx); err != nil {
-		return ids.Empty, fmt.Errorf("error signing tx: %w", err)
-	}
-
-	ctx, cancel := utils.GetAPIContext()
-	defer cancel()
-	err = wallet.O().IssueTx(
-		&tx,
-		common.WithContext(ctx),
-	)
-	if err != nil {
-		if ctx.Err() != nil {
-			err = fmt.Errorf("timeout issuing/verifying tx with ID %s: %w", tx.ID(), err)
-		} else {
-			err = fmt.Errorf("error issuing tx with ID %s: %w", tx.ID(), err)
-		}
-		return ids.Empty, err
+		return nil, fmt.Errorf("error signing tx: %w", err)
 	}
-
-	return tx.ID(), nil
+	return &tx, nil
 }
 
 func (*PublicDeployer) signTx(
@@ -894,22 +966,14 @@ func IssueOFromAImportTx(
 	hasOnlyOneKey bool,
 	owner *secp256k1fx.OutputOwners,
 ) (ids.ID, error) {
-	showLedgerSignatureMsg(usingLedger, hasOnlyOneKey, "A -> O Chain Import Transaction")
-	unsignedTx, err := wallet.O().Builder().NewImportTx(
-		wallet.A().BlockchainID(),
-		owner,
-	)
+	tx, err := CreateOFromAImportTx(wallet, usingLedger, hasOnlyOneKey, owner)
 	if err != nil {
-		return ids.Empty, fmt.Errorf("error building tx: %w", err)
-	}
-	tx := txs.Tx{Unsigned: unsignedTx}
-	if err := wallet.O().Signer().Sign(context.Background(), &tx); err != nil {
-		return ids.Empty, fmt.Errorf("error signing tx: %w", err)
+		return ids.Empty, err
 	}
 	ctx, cancel := utils.GetAPIContext()
 	defer cancel()
 	err = wallet.O().IssueTx(
-		&tx,
+		tx,
 		common.WithContext(ctx),
 	)
 	if err != nil {
@@ -923,6 +987,29 @@ func IssueOFromAImportTx(
 	return tx.ID(), err
 }
 
+// creates an O-Chain import tx of the UTXOs exported from the A-Chain, signed by the
+// wallet, without issuing it
+func CreateOFromAImportTx(
+	wallet primary.Wallet,
+	usingLedger bool,
+	hasOnlyOneKey bool,
+	owner *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	showLedgerSignatureMsg(usingLedger, hasOnlyOneKey, "A -> O Chain Import Transaction")
+	unsignedTx, err := wallet.O().Builder().NewImportTx(
+		wallet.A().BlockchainID(),
+		owner,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building tx: %w", err)
+	}
+	tx := txs.Tx{Unsigned: unsignedTx}
+	if err := wallet.O().Signer().Sign(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("error signing tx: %w", err)
+	}
+	return &tx, nil
+}
+
 func showLedgerSignatureMsg(
 	usingLedger bool,
 	hasOnlyOneKey bool,
@@ -12,8 +12,7 @@ import (
 )
 
 // get all subnet auth addresses that are required to sign a given tx
-//   - get subnet control keys as string slice using O-Chain API (GetOwners)
-//   - get subnet auth indices from the tx, field tx.UnsignedTx.SubnetAuth
+//   - get subnet auth indices from the tx, field tx.UnsignedTx.SubnetAuth (GetSubnetAuthIndices)
 //   - creates the string slice of required subnet auth addresses by applying
 //     the indices to the control keys slice
 //
@@ -21,9 +20,35 @@ import (
 // - txs.CreateChainTx
 // - txs.AddSubnetValidatorTx
 // - txs.RemoveSubnetValidatorTx
+// - txs.TransformSubnetTx
 //
 // controlKeys must be in the same order as in the subnet creation tx (as obtained by GetOwners)
 func GetAuthSigners(tx *txs.Tx, controlKeys []string) ([]string, error) {
+	sigIndices, err := GetSubnetAuthIndices(tx)
+	if err != nil {
+		return nil, err
+	}
+	authSigners := []string{}
+	for _, sigIndex := range sigIndices {
+		if sigIndex >= uint32(len(controlKeys)) {
+			return nil, fmt.Errorf("signer index %d exceeds number of control keys", sigIndex)
+		}
+		authSigners = append(authSigners, controlKeys[sigIndex])
+	}
+	return authSigners, nil
+}
+
+// get the indices, on the subnet control keys, of the addresses required to sign a given tx
+//   - the indices are taken from field tx.UnsignedTx.SubnetAuth, in the order of the
+//     signatures of the subnet auth cred
+//   - fails for txs without subnet auth (see HasSubnetAuth)
+//
+// expect tx.Unsigned type to be in:
+// - txs.CreateChainTx
+// - txs.AddSubnetValidatorTx
+// - txs.RemoveSubnetValidatorTx
+// - txs.TransformSubnetTx
+func GetSubnetAuthIndices(tx *txs.Tx) ([]uint32, error) {
 	unsignedTx := tx.Unsigned
 	var subnetAuth verify.Verifiable
 	switch unsignedTx := unsignedTx.(type) {
@@ -42,17 +67,20 @@ func GetAuthSigners(tx *txs.Tx, controlKeys []string) ([]string, error) {
 	if !ok {
 		return nil, fmt.Errorf("expected subnetAuth of type *secp256k1fx.Input, got %T", subnetAuth)
 	}
-	authSigners := []string{}
-	for _, sigIndex := range subnetInput.SigIndices {
-		if sigIndex >= uint32(len(controlKeys)) {
-			return nil, fmt.Errorf("signer index %d exceeds number of control keys", sigIndex)
-		}
-		authSigners = append(authSigners, controlKeys[sigIndex])
+	return subnetInput.SigIndices, nil
+}
+
+// HasSubnetAuth returns true if the given tx must be signed by subnet control keys
+func HasSubnetAuth(tx *txs.Tx) bool {
+	switch tx.Unsigned.(type) {
+	case *txs.RemoveSubnetValidatorTx, *txs.AddSubnetValidatorTx, *txs.CreateChainTx, *txs.TransformSubnetTx:
+		return true
+	default:
+		return false
 	}
-	return authSigners, nil
 }
 
-// get subnet auth addresses that did not yet signed a given tx
+// get the subnet auth addresses required to sign a given tx, and the ones that did not yet sign it
 //   - get the string slice of auth signers for the tx (GetAuthSigners)
 //   - verifies that all creds in tx.Creds, except the last one, are fully signed
 //     (a cred is fully signed if all the signatures in cred.Sigs are non-empty)
@@ -60,8 +88,15 @@ func GetAuthSigners(tx *txs.Tx, controlKeys []string) ([]string, error) {
 //   - for each sig in cred.Sig: if sig is empty, then add the associated auth signer address (obtained from
 //     authSigners by using the index) to the remaining signers list
 //
-// if the tx is fully signed, returns empty slice
-// expect tx.Unsigned type to be in [txs.AddSubnetValidatorTx, txs.CreateChainTx]
+// returns the auth signers and the remaining signers, the latter being empty if the tx is fully signed
+//
+// expect tx.Unsigned type to be in:
+// - txs.CreateChainTx
+// - txs.AddSubnetValidatorTx
+// - txs.RemoveSubnetValidatorTx
+// - txs.TransformSubnetTx
+//
+// txs without subnet auth are signed by the owners of their inputs, see GetMissingSignatures
 //
 // controlKeys must be in the same order as in the subnet creation tx (as obtained by GetOwners)
 func GetRemainingSigners(tx *txs.Tx, controlKeys []string) ([]string, []string, error) {
@@ -72,7 +107,7 @@ func GetRemainingSigners(tx *txs.Tx, controlKeys []string) ([]string, []string,
 	emptySig := [secp256k1.SignatureLen]byte{}
 	// we should have at least 1 cred for output owners and 1 cred for subnet auth
 	if len(tx.Creds) < 2 {
-		return nil, nil, fmt.Errorf("expected tx.Creds of len 2, got %d", len(tx.Creds))
+		return nil, nil, fmt.Errorf("expected tx.Creds of len at least 2, got %d", len(tx.Creds))
 	}
 	// signatures for output owners should be filled (all creds except last one)
 	for credIndex := range tx.Creds[:len(tx.Creds)-1] {
@@ -89,7 +124,7 @@ func GetRemainingSigners(tx *txs.Tx, controlKeys []string) ([]string, []string,
 	// signatures for subnet auth (last cred)
 	cred, ok := tx.Creds[len(tx.Creds)-1].(*secp256k1fx.Credential)
 	if !ok {
-		return nil, nil, fmt.Errorf("expected cred to be of type *secp256k1fx.Credential, got %T", tx.Creds[1])
+		return nil, nil, fmt.Errorf("expected cred to be of type *secp256k1fx.Credential, got %T", tx.Creds[len(tx.Creds)-1])
 	}
 	if len(cred.Sigs) != len(authSigners) {
 		return nil, nil, fmt.Errorf("expected number of cred's signatures %d to equal number of auth signers %d",
@@ -105,3 +140,24 @@ func GetRemainingSigners(tx *txs.Tx, controlKeys []string) ([]string, []string,
 	}
 	return authSigners, remainingSigners, nil
 }
+
+// get the number of signatures still missing in a given tx, on any of its creds
+//   - a signature is missing if it is empty
+//
+// used for txs without subnet auth, where the signers are the owners of the tx inputs
+func GetMissingSignatures(tx *txs.Tx) (int, error) {
+	emptySig := [secp256k1.SignatureLen]byte{}
+	missing := 0
+	for credIndex := range tx.Creds {
+		cred, ok := tx.Creds[credIndex].(*secp256k1fx.Credential)
+		if !ok {
+			return 0, fmt.Errorf("expected cred to be of type *secp256k1fx.Credential, got %T", tx.Creds[credIndex])
+		}
+		for _, sig := range cred.Sigs {
+			if sig == emptySig {
+				missing++
+			}
+		}
+	}
+	return missing, nil
+}
@@ -30,6 +30,12 @@ func GetNetwork(tx *txs.Tx) (models.Network, error) {
 		networkID = unsignedTx.NetworkID
 	case *txs.AddPermissionlessValidatorTx:
 		networkID = unsignedTx.NetworkID
+	case *txs.AddPermissionlessDelegatorTx:
+		networkID = unsignedTx.NetworkID
+	case *txs.ExportTx:
+		networkID = unsignedTx.NetworkID
+	case *txs.ImportTx:
+		networkID = unsignedTx.NetworkID
 	default:
 		return models.UndefinedNetwork, fmt.Errorf("unexpected unsigned tx type %T", unsignedTx)
 	}
@@ -41,6 +47,7 @@ func GetNetwork(tx *txs.Tx) (models.Network, error) {
 }
 
 // get subnet id associated to tx
+// returns ids.Empty for txs not associated to a subnet, as export and import txs
 func GetSubnetID(tx *txs.Tx) (ids.ID, error) {
 	unsignedTx := tx.Unsigned
 	var subnetID ids.ID
@@ -55,6 +62,10 @@ func GetSubnetID(tx *txs.Tx) (ids.ID, error) {
 		subnetID = unsignedTx.Subnet
 	case *txs.AddPermissionlessValidatorTx:
 		subnetID = unsignedTx.Subnet
+	case *txs.AddPermissionlessDelegatorTx:
+		subnetID = unsignedTx.Subnet
+	case *txs.ExportTx, *txs.ImportTx:
+		subnetID = ids.Empty
 	default:
 		return ids.Empty, fmt.Errorf("unexpected unsigned tx type %T", unsignedTx)
 	}
@@ -68,6 +79,12 @@ func GetLedgerDisplayName(tx *txs.Tx) string {
 		return "SubnetValidator"
 	case *txs.CreateChainTx:
 		return "CreateChain"
+	case *txs.AddPermissionlessDelegatorTx:
+		return "AddPermissionlessDelegator"
+	case *txs.ExportTx:
+		return "Export"
+	case *txs.ImportTx:
+		return "Import"
 	default:
 		return ""
 	}
@@ -0,0 +1,239 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package txutils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/key"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	safemath "github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// TxField is a named value of a tx, formatted for display
+type TxField struct {
+	Name  string
+	Value string
+}
+
+// TxDescription is the human readable content of a tx
+type TxDescription struct {
+	Type   string
+	Fields []TxField
+	// Inputs are the UTXOs consumed by the tx, including the imported ones, if any
+	Inputs []string
+	// Outputs are the UTXOs created by the tx, including the stake and exported outputs, if any
+	Outputs []string
+	// Burned maps each asset to the amount burned by the tx as fee
+	Burned map[ids.ID]uint64
+}
+
+// Describe decodes the type, semantic fields, and fee inputs and outputs of [tx]. It fails
+// if the outputs of an asset exceed its inputs, as such a tx burns no fee.
+//
+// expect tx.Unsigned type to be in:
+// - txs.CreateChainTx
+// - txs.AddSubnetValidatorTx
+// - txs.RemoveSubnetValidatorTx
+// - txs.TransformSubnetTx
+// - txs.AddPermissionlessValidatorTx
+// - txs.AddPermissionlessDelegatorTx
+// - txs.ExportTx
+// - txs.ImportTx
+func Describe(tx *txs.Tx) (TxDescription, error) {
+	desc := TxDescription{
+		Burned: map[ids.ID]uint64{},
+	}
+	var (
+		baseTx *txs.BaseTx
+		// extraIns and extraOuts are the inputs and outputs of the tx besides the base ones
+		extraIns  []*dione.TransferableInput
+		extraOuts []*dione.TransferableOutput
+	)
+	switch unsignedTx := tx.Unsigned.(type) {
+	case *txs.CreateChainTx:
+		desc.Type = "CreateChainTx"
+		baseTx = &unsignedTx.BaseTx
+		fxIDs := []string{}
+		for _, fxID := range unsignedTx.FxIDs {
+			fxIDs = append(fxIDs, fxID.String())
+		}
+		desc.Fields = []TxField{
+			{"Subnet ID", unsignedTx.SubnetID.String()},
+			{"Chain Name", unsignedTx.ChainName},
+			{"VM ID", unsignedTx.VMID.String()},
+			{"Fx IDs", strings.Join(fxIDs, ", ")},
+			{"Genesis Size", fmt.Sprintf("%d bytes", len(unsignedTx.GenesisData))},
+			{"Genesis Hash (SHA256)", hex.EncodeToString(hashing.ComputeHash256(unsignedTx.GenesisData))},
+		}
+	case *txs.AddSubnetValidatorTx:
+		desc.Type = "AddSubnetValidatorTx"
+		baseTx = &unsignedTx.BaseTx
+		desc.Fields = append(
+			[]TxField{{"Subnet ID", unsignedTx.SubnetValidator.Subnet.String()}},
+			validatorFields(unsignedTx.SubnetValidator.Validator)...,
+		)
+	case *txs.RemoveSubnetValidatorTx:
+		desc.Type = "RemoveSubnetValidatorTx"
+		baseTx = &unsignedTx.BaseTx
+		desc.Fields = []TxField{
+			{"Subnet ID", unsignedTx.Subnet.String()},
+			{"Node ID", unsignedTx.NodeID.String()},
+		}
+	case *txs.TransformSubnetTx:
+		desc.Type = "TransformSubnetTx"
+		baseTx = &unsignedTx.BaseTx
+		desc.Fields = []TxField{
+			{"Subnet ID", unsignedTx.Subnet.String()},
+			{"Asset ID", unsignedTx.AssetID.String()},
+			{"Initial Supply", fmt.Sprint(unsignedTx.InitialSupply)},
+			{"Maximum Supply", fmt.Sprint(unsignedTx.MaximumSupply)},
+			{"Min Consumption Rate", fmt.Sprint(unsignedTx.MinConsumptionRate)},
+			{"Max Consumption Rate", fmt.Sprint(unsignedTx.MaxConsumptionRate)},
+			{"Min Validator Stake", fmt.Sprint(unsignedTx.MinValidatorStake)},
+			{"Max Validator Stake", fmt.Sprint(unsignedTx.MaxValidatorStake)},
+			{"Min Validator Stake Duration", formatSeconds(uint64(unsignedTx.MinValidatorStakeDuration))},
+			{"Max Validator Stake Duration", formatSeconds(uint64(unsignedTx.MaxValidatorStakeDuration))},
+			{"Min Delegator Stake Duration", formatSeconds(uint64(unsignedTx.MinDelegatorStakeDuration))},
+			{"Max Delegator Stake Duration", formatSeconds(uint64(unsignedTx.MaxDelegatorStakeDuration))},
+			{"Min Delegation Fee", fmt.Sprint(unsignedTx.MinDelegationFee)},
+			{"Min Delegator Stake", fmt.Sprint(unsignedTx.MinDelegatorStake)},
+			{"Max Validator Weight Factor", fmt.Sprint(unsignedTx.MaxValidatorWeightFactor)},
+			{"Uptime Requirement", fmt.Sprint(unsignedTx.UptimeRequirement)},
+		}
+	case *txs.AddPermissionlessValidatorTx:
+		desc.Type = "AddPermissionlessValidatorTx"
+		baseTx = &unsignedTx.BaseTx
+		extraOuts = unsignedTx.StakeOuts
+		desc.Fields = append(
+			[]TxField{{"Subnet ID", unsignedTx.Subnet.String()}},
+			validatorFields(unsignedTx.Validator)...,
+		)
+		if pop, ok := unsignedTx.Signer.(*signer.ProofOfPossession); ok {
+			desc.Fields = append(desc.Fields, TxField{"BLS Public Key", "0x" + hex.EncodeToString(pop.PublicKey[:])})
+		}
+		hrp := key.GetHRP(unsignedTx.NetworkID)
+		desc.Fields = append(desc.Fields,
+			TxField{"Validation Rewards Owner", formatOwner(hrp, unsignedTx.ValidatorRewardsOwner)},
+			TxField{"Delegation Rewards Owner", formatOwner(hrp, unsignedTx.DelegatorRewardsOwner)},
+			TxField{"Delegation Shares", fmt.Sprint(unsignedTx.DelegationShares)},
+		)
+	case *txs.AddPermissionlessDelegatorTx:
+		desc.Type = "AddPermissionlessDelegatorTx"
+		baseTx = &unsignedTx.BaseTx
+		extraOuts = unsignedTx.StakeOuts
+		desc.Fields = append(
+			[]TxField{{"Subnet ID", unsignedTx.Subnet.String()}},
+			validatorFields(unsignedTx.Validator)...,
+		)
+		desc.Fields = append(desc.Fields,
+			TxField{"Delegation Rewards Owner", formatOwner(key.GetHRP(unsignedTx.NetworkID), unsignedTx.DelegationRewardsOwner)},
+		)
+	case *txs.ExportTx:
+		desc.Type = "ExportTx"
+		baseTx = &unsignedTx.BaseTx
+		extraOuts = unsignedTx.ExportedOutputs
+		desc.Fields = []TxField{
+			{"Destination Chain", unsignedTx.DestinationChain.String()},
+		}
+	case *txs.ImportTx:
+		desc.Type = "ImportTx"
+		baseTx = &unsignedTx.BaseTx
+		extraIns = unsignedTx.ImportedInputs
+		desc.Fields = []TxField{
+			{"Source Chain", unsignedTx.SourceChain.String()},
+		}
+	default:
+		return TxDescription{}, fmt.Errorf("unexpected unsigned tx type %T", tx.Unsigned)
+	}
+	desc.Fields = append([]TxField{
+		{"Network ID", fmt.Sprint(baseTx.NetworkID)},
+		{"Blockchain ID", baseTx.BlockchainID.String()},
+	}, desc.Fields...)
+	if len(baseTx.Memo) > 0 {
+		desc.Fields = append(desc.Fields, TxField{"Memo", string(baseTx.Memo)})
+	}
+	hrp := key.GetHRP(baseTx.NetworkID)
+	var err error
+	for _, ins := range [][]*dione.TransferableInput{baseTx.Ins, extraIns} {
+		for _, in := range ins {
+			desc.Inputs = append(desc.Inputs, fmt.Sprintf("%s from UTXO %s:%d", FormatAmount(in.AssetID(), in.Input().Amount()), in.TxID, in.OutputIndex))
+			desc.Burned[in.AssetID()], err = safemath.Add64(desc.Burned[in.AssetID()], in.Input().Amount())
+			if err != nil {
+				return TxDescription{}, fmt.Errorf("inputs of asset %s overflow: %w", in.AssetID(), err)
+			}
+		}
+	}
+	for _, outs := range [][]*dione.TransferableOutput{baseTx.Outs, extraOuts} {
+		for _, out := range outs {
+			desc.Outputs = append(desc.Outputs, fmt.Sprintf("%s to %s", FormatAmount(out.AssetID(), out.Output().Amount()), formatOutputOwner(hrp, out.Output())))
+			// all the inputs are summed up, so an underflow means the outputs exceed the inputs
+			desc.Burned[out.AssetID()], err = safemath.Sub(desc.Burned[out.AssetID()], out.Output().Amount())
+			if err != nil {
+				return TxDescription{}, fmt.Errorf("outputs of asset %s exceed the inputs of the tx", out.AssetID())
+			}
+		}
+	}
+	return desc, nil
+}
+
+func validatorFields(validator txs.Validator) []TxField {
+	return []TxField{
+		{"Node ID", validator.NodeID.String()},
+		{"Weight", fmt.Sprint(validator.Wght)},
+		{"Start Time", validator.StartTime().UTC().Format(time.RFC3339)},
+		{"End Time", validator.EndTime().UTC().Format(time.RFC3339)},
+		{"Duration", validator.Duration().String()},
+	}
+}
+
+func formatSeconds(seconds uint64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+// FormatAmount formats [amount] of [assetID] both in units and in nano units of the asset
+func FormatAmount(assetID ids.ID, amount uint64) string {
+	return fmt.Sprintf("%.9f (%d n) of asset %s", float64(amount)/float64(units.Dione), amount, assetID)
+}
+
+func formatOutputOwner(hrp string, out dione.TransferableOut) string {
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		return fmt.Sprintf("%s, stakeable locked until %s", formatOutputOwner(hrp, lockOut.TransferableOut), time.Unix(int64(lockOut.Locktime), 0).UTC().Format(time.RFC3339))
+	}
+	if transferOut, ok := out.(*secp256k1fx.TransferOutput); ok {
+		return formatOwner(hrp, &transferOut.OutputOwners)
+	}
+	return fmt.Sprintf("unknown owner of type %T", out)
+}
+
+func formatOwner(hrp string, owner fx.Owner) string {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return fmt.Sprintf("unknown owner of type %T", owner)
+	}
+	addrs := []string{}
+	for _, addr := range outputOwners.Addrs {
+		addrStr, err := address.Format("O", hrp, addr[:])
+		if err != nil {
+			addrStr = addr.String()
+		}
+		addrs = append(addrs, addrStr)
+	}
+	s := fmt.Sprintf("%s (threshold %d)", strings.Join(addrs, ", "), outputOwners.Threshold)
+	if outputOwners.Locktime != 0 {
+		s += fmt.Sprintf(", locked until %s", time.Unix(int64(outputOwners.Locktime), 0).UTC().Format(time.RFC3339))
+	}
+	return s
+}
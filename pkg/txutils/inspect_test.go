@@ -0,0 +1,185 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package txutils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAddSubnetValidatorTx(t *testing.T) *txs.Tx {
+	assetID := ids.GenerateTestID()
+	tx := &txs.Tx{
+		Unsigned: &txs.AddSubnetValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+				NetworkID:    12345,
+				BlockchainID: ids.Empty,
+				Ins: []*dione.TransferableInput{{
+					UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  dione.Asset{ID: assetID},
+					In: &secp256k1fx.TransferInput{
+						Amt:   1_000_000_000,
+						Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				}},
+				Outs: []*dione.TransferableOutput{{
+					Asset: dione.Asset{ID: assetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: 999_000_000,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+						},
+					},
+				}},
+			}},
+			SubnetValidator: txs.SubnetValidator{
+				Validator: txs.Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					Start:  1_700_000_000,
+					End:    1_700_086_400,
+					Wght:   20,
+				},
+				Subnet: ids.GenerateTestID(),
+			},
+			SubnetAuth: &secp256k1fx.Input{SigIndices: []uint32{0, 2}},
+		},
+		Creds: []verify.Verifiable{
+			&secp256k1fx.Credential{Sigs: [][secp256k1.SignatureLen]byte{{1}}},
+			&secp256k1fx.Credential{Sigs: [][secp256k1.SignatureLen]byte{{1}, {}}},
+		},
+	}
+	require.NoError(t, tx.Initialize(txs.Codec))
+	return tx
+}
+
+func TestDescribe(t *testing.T) {
+	require := require.New(t)
+	tx := newTestAddSubnetValidatorTx(t)
+	txPath := filepath.Join(t.TempDir(), "tx")
+	require.NoError(SaveToDisk(tx, txPath, false))
+	tx, err := LoadFromDisk(txPath)
+	require.NoError(err)
+
+	desc, err := Describe(tx)
+	require.NoError(err)
+	require.Equal("AddSubnetValidatorTx", desc.Type)
+	fields := map[string]string{}
+	for _, field := range desc.Fields {
+		fields[field.Name] = field.Value
+	}
+	unsignedTx := tx.Unsigned.(*txs.AddSubnetValidatorTx)
+	require.Equal(unsignedTx.Subnet.String(), fields["Subnet ID"])
+	require.Equal(unsignedTx.SubnetValidator.NodeID.String(), fields["Node ID"])
+	require.Equal("20", fields["Weight"])
+	require.Equal("2023-11-14T22:13:20Z", fields["Start Time"])
+	require.Equal("24h0m0s", fields["Duration"])
+	require.Len(desc.Inputs, 1)
+	require.Len(desc.Outputs, 1)
+	require.Equal(map[ids.ID]uint64{unsignedTx.Ins[0].AssetID(): 1_000_000}, desc.Burned)
+
+	require.True(HasSubnetAuth(tx))
+	authSigners, remainingSigners, err := GetRemainingSigners(tx, []string{"a", "b", "c"})
+	require.NoError(err)
+	require.Equal([]string{"a", "c"}, authSigners)
+	require.Equal([]string{"c"}, remainingSigners)
+}
+
+func TestDescribeExportTx(t *testing.T) {
+	require := require.New(t)
+	assetID := ids.GenerateTestID()
+	destinationChain := ids.GenerateTestID()
+	tx := &txs.Tx{
+		Unsigned: &txs.ExportTx{
+			BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+				NetworkID: constants.LocalNetworkID,
+				Ins: []*dione.TransferableInput{{
+					UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  dione.Asset{ID: assetID},
+					In: &secp256k1fx.TransferInput{
+						Amt:   1_000_000_000,
+						Input: secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+					},
+				}},
+			}},
+			DestinationChain: destinationChain,
+			ExportedOutputs: []*dione.TransferableOutput{{
+				Asset: dione.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 999_000_000,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+					},
+				},
+			}},
+		},
+		Creds: []verify.Verifiable{
+			&secp256k1fx.Credential{Sigs: [][secp256k1.SignatureLen]byte{{1}, {}}},
+		},
+	}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	desc, err := Describe(tx)
+	require.NoError(err)
+	require.Equal("ExportTx", desc.Type)
+	require.Contains(desc.Fields, TxField{"Destination Chain", destinationChain.String()})
+	require.Len(desc.Outputs, 1)
+	require.Equal(map[ids.ID]uint64{assetID: 1_000_000}, desc.Burned)
+
+	network, err := GetNetwork(tx)
+	require.NoError(err)
+	require.Equal(models.LocalNetwork, network)
+	subnetID, err := GetSubnetID(tx)
+	require.NoError(err)
+	require.Equal(ids.Empty, subnetID)
+	require.False(HasSubnetAuth(tx))
+	missingSigs, err := GetMissingSignatures(tx)
+	require.NoError(err)
+	require.Equal(1, missingSigs)
+}
+
+func TestDescribeOutputsExceedInputs(t *testing.T) {
+	require := require.New(t)
+	assetID := ids.GenerateTestID()
+	tx := &txs.Tx{
+		Unsigned: &txs.ExportTx{
+			BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+				NetworkID: constants.LocalNetworkID,
+				Ins: []*dione.TransferableInput{{
+					UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  dione.Asset{ID: assetID},
+					In: &secp256k1fx.TransferInput{
+						Amt:   1_000_000,
+						Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				}},
+			}},
+			DestinationChain: ids.GenerateTestID(),
+			ExportedOutputs: []*dione.TransferableOutput{{
+				Asset: dione.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 2_000_000,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+					},
+				},
+			}},
+		},
+	}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	_, err := Describe(tx)
+	require.ErrorContains(err, "exceed the inputs")
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -39,6 +41,63 @@ func SaveToDisk(tx *txs.Tx, txPath string, forceOverwrite bool) error {
 	return nil
 }
 
+// SaveNotFullySignedInputsTx saves a [tx] without subnet auth, as export, import or
+// permissionless delegator txs, to be signed by the owners of its inputs. If
+// [outputTxPath] is empty, the user is prompted for it.
+func SaveNotFullySignedInputsTx(
+	app *application.Odyssey,
+	txName string,
+	tx *txs.Tx,
+	outputTxPath string,
+	forceOverwrite bool,
+) error {
+	missingSigs, err := GetMissingSignatures(tx)
+	if err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("")
+	if missingSigs == 0 {
+		ux.Logger.PrintToUser("All %s signatures have been signed. "+
+			"Saving tx to disk to enable commit.", txName)
+	} else {
+		ux.Logger.PrintToUser("%d %s signatures are missing. "+
+			"Saving tx to disk to enable remaining signing.", missingSigs, txName)
+	}
+	if outputTxPath == "" {
+		ux.Logger.PrintToUser("")
+		if forceOverwrite {
+			outputTxPath, err = app.Prompt.CaptureString("Path to export partially signed tx to")
+		} else {
+			outputTxPath, err = app.Prompt.CaptureNewFilepath("Path to export partially signed tx to")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if forceOverwrite {
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("Overwriting %s", outputTxPath)
+	}
+	if err := SaveToDisk(tx, outputTxPath, forceOverwrite); err != nil {
+		return err
+	}
+	ux.Logger.PrintToUser("")
+	if missingSigs == 0 {
+		ux.Logger.PrintToUser("Tx is fully signed, and ready to be committed")
+		ux.Logger.PrintToUser("")
+		ux.Logger.PrintToUser("Commit command:")
+		ux.Logger.PrintToUser("  odyssey transaction commit --input-tx-filepath %s", outputTxPath)
+		return nil
+	}
+	ux.Logger.PrintToUser("Connect a ledger or choose a stored key owning the tx inputs "+
+		"and run the signing command, or send %q to another user for signing.", outputTxPath)
+	ux.Logger.PrintToUser("")
+	ux.Logger.PrintToUser("Signing command:")
+	ux.Logger.PrintToUser("  odyssey transaction sign --input-tx-filepath %s", outputTxPath)
+	ux.Logger.PrintToUser("")
+	return nil
+}
+
 // loads a tx from [txPath]
 func LoadFromDisk(txPath string) (*txs.Tx, error) {
 	txEncodedBytes, err := os.ReadFile(txPath)
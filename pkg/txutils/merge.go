@@ -0,0 +1,75 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package txutils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var errNoTxsToMerge = errors.New("no txs to merge")
+
+// merges the signatures of several copies of the same tx, signed by different keys
+//   - verifies that all [txsToMerge] share the same unsigned tx bytes and creds layout
+//   - for each signature slot of each cred, takes the signature filled by any of the copies
+//   - fails if two copies filled the same slot with different signatures
+//
+// returns a new tx, the given ones are not modified
+func Merge(txsToMerge []*txs.Tx) (*txs.Tx, error) {
+	if len(txsToMerge) == 0 {
+		return nil, errNoTxsToMerge
+	}
+	base := txsToMerge[0]
+	emptySig := [secp256k1.SignatureLen]byte{}
+	creds := make([]verify.Verifiable, len(base.Creds))
+	for credIndex, baseCred := range base.Creds {
+		cred, ok := baseCred.(*secp256k1fx.Credential)
+		if !ok {
+			return nil, fmt.Errorf("expected cred to be of type *secp256k1fx.Credential, got %T", baseCred)
+		}
+		sigs := make([][secp256k1.SignatureLen]byte, len(cred.Sigs))
+		copy(sigs, cred.Sigs)
+		creds[credIndex] = &secp256k1fx.Credential{Sigs: sigs}
+	}
+	for txIndex, tx := range txsToMerge[1:] {
+		if !bytes.Equal(tx.Unsigned.Bytes(), base.Unsigned.Bytes()) {
+			return nil, fmt.Errorf("tx %d is not a copy of tx 0: unsigned tx bytes differ", txIndex+1)
+		}
+		if len(tx.Creds) != len(creds) {
+			return nil, fmt.Errorf("tx %d has %d creds, expected %d", txIndex+1, len(tx.Creds), len(creds))
+		}
+		for credIndex := range tx.Creds {
+			cred, ok := tx.Creds[credIndex].(*secp256k1fx.Credential)
+			if !ok {
+				return nil, fmt.Errorf("expected cred to be of type *secp256k1fx.Credential, got %T", tx.Creds[credIndex])
+			}
+			mergedSigs := creds[credIndex].(*secp256k1fx.Credential).Sigs
+			if len(cred.Sigs) != len(mergedSigs) {
+				return nil, fmt.Errorf("cred %d of tx %d has %d signatures, expected %d", credIndex, txIndex+1, len(cred.Sigs), len(mergedSigs))
+			}
+			for sigIndex, sig := range cred.Sigs {
+				switch {
+				case sig == emptySig:
+				case mergedSigs[sigIndex] == emptySig:
+					mergedSigs[sigIndex] = sig
+				case mergedSigs[sigIndex] != sig:
+					return nil, fmt.Errorf("conflicting signatures for sig %d of cred %d in tx %d", sigIndex, credIndex, txIndex+1)
+				}
+			}
+		}
+	}
+	merged := &txs.Tx{
+		Unsigned: base.Unsigned,
+		Creds:    creds,
+	}
+	if err := merged.Initialize(txs.Codec); err != nil {
+		return nil, fmt.Errorf("error initializing merged tx: %w", err)
+	}
+	return merged, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package txutils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+// copyWithAuthSigs returns a copy of [tx] with the given subnet auth signatures
+func copyWithAuthSigs(t *testing.T, tx *txs.Tx, sigs ...[secp256k1.SignatureLen]byte) *txs.Tx {
+	require := require.New(t)
+	txPath := filepath.Join(t.TempDir(), "tx")
+	require.NoError(SaveToDisk(tx, txPath, false))
+	txCopy, err := LoadFromDisk(txPath)
+	require.NoError(err)
+	txCopy.Creds[len(txCopy.Creds)-1] = &secp256k1fx.Credential{Sigs: sigs}
+	require.NoError(txCopy.Initialize(txs.Codec))
+	return txCopy
+}
+
+func TestMerge(t *testing.T) {
+	require := require.New(t)
+	controlKeys := []string{"a", "b", "c"}
+	tx := newTestAddSubnetValidatorTx(t)
+	emptySig := [secp256k1.SignatureLen]byte{}
+	signedByA := copyWithAuthSigs(t, tx, [secp256k1.SignatureLen]byte{1}, emptySig)
+	signedByC := copyWithAuthSigs(t, tx, emptySig, [secp256k1.SignatureLen]byte{3})
+
+	merged, err := Merge([]*txs.Tx{signedByA, signedByC})
+	require.NoError(err)
+	_, remainingSigners, err := GetRemainingSigners(merged, controlKeys)
+	require.NoError(err)
+	require.Empty(remainingSigners)
+	require.Equal(tx.Unsigned.Bytes(), merged.Unsigned.Bytes())
+
+	// the merged copies are not modified
+	_, remainingSigners, err = GetRemainingSigners(signedByA, controlKeys)
+	require.NoError(err)
+	require.Equal([]string{"c"}, remainingSigners)
+
+	// merging the same signature twice is fine
+	_, err = Merge([]*txs.Tx{signedByA, signedByA, signedByC})
+	require.NoError(err)
+
+	conflicting := copyWithAuthSigs(t, tx, [secp256k1.SignatureLen]byte{2}, emptySig)
+	_, err = Merge([]*txs.Tx{signedByA, conflicting})
+	require.ErrorContains(err, "conflicting signatures")
+
+	otherTx := newTestAddSubnetValidatorTx(t)
+	_, err = Merge([]*txs.Tx{signedByA, otherTx})
+	require.ErrorContains(err, "unsigned tx bytes differ")
+
+	_, err = Merge(nil)
+	require.ErrorIs(err, errNoTxsToMerge)
+}
@@ -6,19 +6,27 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"os/exec"
+	"strings"
 
 	"golang.org/x/exp/slices"
 
 	"github.com/DioneProtocol/odyssey-cli/pkg/constants"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/agent"
 )
 
 // GetSSHConnectionString returns the SSH connection string for the given public IP and certificate file path.
-func GetSSHConnectionString(publicIP, certFilePath string) string {
+// If [knownHostsPath] is given, the host key is verified against that known_hosts file.
+func GetSSHConnectionString(publicIP, certFilePath, knownHostsPath string) string {
+	hostKeyParams := constants.AnsibleSSHUseAgentParams
+	if knownHostsPath != "" {
+		hostKeyParams = fmt.Sprintf(constants.SSHKnownHostsParams, knownHostsPath)
+	}
 	if certFilePath != "" {
-		return fmt.Sprintf("ssh %s %s@%s -i %s", constants.AnsibleSSHShellParams, constants.AnsibleSSHUser, publicIP, certFilePath)
+		return fmt.Sprintf("ssh %s %s %s@%s -i %s", constants.SSHIdentitiesOnlyParams, hostKeyParams, constants.AnsibleSSHUser, publicIP, certFilePath)
 	} else {
-		return fmt.Sprintf("ssh %s %s@%s", constants.AnsibleSSHUseAgentParams, constants.AnsibleSSHUser, publicIP)
+		return fmt.Sprintf("ssh %s %s@%s", hostKeyParams, constants.AnsibleSSHUser, publicIP)
 	}
 }
 
@@ -86,3 +94,30 @@ func ReadSSHAgentIdentityPublicKey(identityName string) (string, error) {
 	}
 	return "", fmt.Errorf("identity %s can't be read", identityName)
 }
+
+// CreateSSHKeyPair creates a new RSA key pair without passphrase, with the private key
+// at [privateKeyPath] and the public key next to it with .pub extension.
+func CreateSSHKeyPair(privateKeyPath string) error {
+	cmd := exec.Command("ssh-keygen", "-t", "rsa", "-f", privateKeyPath, "-C", constants.AnsibleSSHUser, "-b", "2048", "-N", "")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create ssh key pair %s: %w: %s", privateKeyPath, err, out)
+	}
+	return nil
+}
+
+// ReadSSHPublicKey returns in authorized_keys format the public key of the private key at
+// [privateKeyPath], read from the .pub file next to it or derived from the private key.
+func ReadSSHPublicKey(privateKeyPath string) (string, error) {
+	if publicKey, err := os.ReadFile(privateKeyPath + ".pub"); err == nil {
+		return strings.TrimSpace(string(publicKey)), nil
+	}
+	privateKey, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return "", err
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse ssh private key %s: %w", privateKeyPath, err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), nil
+}
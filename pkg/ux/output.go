@@ -40,6 +40,12 @@ func (ul *UserLog) PrintToUser(msg string, args ...interface{}) {
 	ul.log.Info(formattedMsg)
 }
 
+// Writer returns the writer the user messages are printed to, for output built by other
+// writers, as tables. With structured output, it is stderr.
+func (ul *UserLog) Writer() io.Writer {
+	return ul.writer
+}
+
 // PrintWait does some dot printing to entertain the user
 func PrintWait(cancel chan struct{}) {
 	for {
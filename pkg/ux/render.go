@@ -0,0 +1,91 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ux
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat is the format used by listing and status commands to print their results
+type OutputFormat string
+
+const (
+	// OutputTable prints human readable tables and text. This is the default.
+	OutputTable OutputFormat = "table"
+	// OutputJSON prints a single JSON document
+	OutputJSON OutputFormat = "json"
+	// OutputYAML prints a single YAML document
+	OutputYAML OutputFormat = "yaml"
+)
+
+// OutputFormats lists all supported output formats
+var OutputFormats = []OutputFormat{OutputTable, OutputJSON, OutputYAML}
+
+var outputFormat = OutputTable
+
+// ParseOutputFormat validates [s] as one of the supported output formats
+func ParseOutputFormat(s string) (OutputFormat, error) {
+	format := OutputFormat(strings.ToLower(strings.TrimSpace(s)))
+	for _, f := range OutputFormats {
+		if format == f {
+			return f, nil
+		}
+	}
+	valid := make([]string, len(OutputFormats))
+	for i, f := range OutputFormats {
+		valid[i] = string(f)
+	}
+	return "", fmt.Errorf("invalid output format %q (expected one of %s)", s, strings.Join(valid, ", "))
+}
+
+// SetOutputFormat sets the format used by Render
+func SetOutputFormat(format OutputFormat) {
+	outputFormat = format
+}
+
+// GetOutputFormat returns the format used by Render
+func GetOutputFormat() OutputFormat {
+	return outputFormat
+}
+
+// IsStructuredOutput returns true if commands must print a machine readable document
+// instead of tables. In that case, informational messages are not sent to stdout.
+func IsStructuredOutput() bool {
+	return outputFormat != OutputTable
+}
+
+// Render prints [v] to stdout as a JSON or YAML document if a structured output
+// format is set. Otherwise, it calls [printTable] to keep the human readable output.
+// [v] is expected to be a struct, or a slice of structs, with both json and yaml tags,
+// which define the documented schema of the command output.
+func Render(v interface{}, printTable func()) error {
+	if !IsStructuredOutput() {
+		printTable()
+		return nil
+	}
+	return renderStructured(os.Stdout, outputFormat, v)
+}
+
+func renderStructured(w io.Writer, format OutputFormat, v interface{}) error {
+	switch format {
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("output format %q is not a structured format", format)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package ux
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type renderTestItem struct {
+	Name    string `json:"name" yaml:"name"`
+	Healthy bool   `json:"healthy" yaml:"healthy"`
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	require := require.New(t)
+
+	for input, expected := range map[string]OutputFormat{
+		"table": OutputTable,
+		"json":  OutputJSON,
+		"YAML":  OutputYAML,
+		" json": OutputJSON,
+	} {
+		format, err := ParseOutputFormat(input)
+		require.NoError(err)
+		require.Equal(expected, format)
+	}
+
+	_, err := ParseOutputFormat("xml")
+	require.ErrorContains(err, "invalid output format")
+}
+
+func TestRenderStructured(t *testing.T) {
+	require := require.New(t)
+
+	items := []renderTestItem{{Name: "node1", Healthy: true}}
+
+	var buf bytes.Buffer
+	require.NoError(renderStructured(&buf, OutputJSON, items))
+	require.Equal("[\n  {\n    \"name\": \"node1\",\n    \"healthy\": true\n  }\n]\n", buf.String())
+
+	buf.Reset()
+	require.NoError(renderStructured(&buf, OutputYAML, items))
+	require.Equal("- name: node1\n  healthy: true\n", buf.String())
+
+	require.Error(renderStructured(&buf, OutputTable, items))
+}
+
+func TestRenderTable(t *testing.T) {
+	require := require.New(t)
+
+	SetOutputFormat(OutputTable)
+	called := false
+	require.NoError(Render(nil, func() { called = true }))
+	require.True(called)
+}
@@ -17,6 +17,10 @@ const (
 	defaultAirdrop = "Airdrop 1 million tokens to the default address (do not use in production)"
 	customAirdrop  = "Customize your airdrop"
 	extendAirdrop  = "Would you like to airdrop more tokens?"
+
+	airdropPrompt        = "How would you like to distribute funds"
+	airdropAddressPrompt = "Address to airdrop to"
+	airdropAmountPrompt  = "Amount to airdrop (in DIONE units)"
 )
 
 func getDefaultAllocation(defaultAirdropAmount string) (core.GenesisAlloc, error) {
@@ -47,7 +51,7 @@ func getAllocation(
 	allocation := core.GenesisAlloc{}
 
 	airdropType, err := app.Prompt.CaptureList(
-		"How would you like to distribute funds",
+		airdropPrompt,
 		[]string{defaultAirdrop, customAirdrop, goBackMsg},
 	)
 	if err != nil {
@@ -66,7 +70,7 @@ func getAllocation(
 	var addressHex common.Address
 
 	for {
-		addressHex, err = app.Prompt.CaptureAddress("Address to airdrop to")
+		addressHex, err = app.Prompt.CaptureAddress(airdropAddressPrompt)
 		if err != nil {
 			return nil, statemachine.Stop, err
 		}
@@ -0,0 +1,292 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	airdropAddressColumn = "address"
+	airdropBalanceColumn = "balance"
+	airdropCodeColumn    = "code"
+	airdropStorageColumn = "storage"
+
+	// tokenDecimals is the number of decimals of the balances given in token units
+	tokenDecimals = 18
+)
+
+// maxGenesisBalance is the maximum balance, and total of balances, of a genesis
+var maxGenesisBalance = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// AirdropEntry is a row of an airdrop file. Balance is given in token units, and
+// Code and Storage optionally pre-deploy a contract at Address
+type AirdropEntry struct {
+	Address string            `json:"address"`
+	Balance string            `json:"balance"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// AirdropSummary describes the allocation loaded from an airdrop file
+type AirdropSummary struct {
+	Accounts  int
+	Contracts int
+	// Total is the sum of the balances, in wei
+	Total *big.Int
+}
+
+// LoadAirdropFile loads the genesis allocation of the airdrop file at [path]. The
+// file is a JSON array of AirdropEntry, or a CSV file with an address and a balance
+// column, and optional code and storage columns. CSV storage cells are given as
+// space-separated slot=value pairs
+func LoadAirdropFile(path string) (core.GenesisAlloc, AirdropSummary, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, AirdropSummary{}, err
+	}
+	var entries []AirdropEntry
+	if strings.EqualFold(filepath.Ext(path), ".json") || bytes.HasPrefix(bytes.TrimSpace(fileBytes), []byte("[")) {
+		if err := json.Unmarshal(fileBytes, &entries); err != nil {
+			return nil, AirdropSummary{}, fmt.Errorf("invalid airdrop file %s: %w", path, err)
+		}
+	} else {
+		entries, err = parseAirdropCSV(bytes.NewReader(fileBytes))
+		if err != nil {
+			return nil, AirdropSummary{}, fmt.Errorf("invalid airdrop file %s: %w", path, err)
+		}
+	}
+	alloc, summary, err := getAirdropAllocation(entries)
+	if err != nil {
+		return nil, AirdropSummary{}, fmt.Errorf("invalid airdrop file %s: %w", path, err)
+	}
+	return alloc, summary, nil
+}
+
+// parseAirdropCSV reads the airdrop entries of a CSV file, whose first row names the columns
+func parseAirdropCSV(r io.Reader) ([]AirdropEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the file is empty")
+		}
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case airdropAddressColumn, airdropBalanceColumn, airdropCodeColumn, airdropStorageColumn:
+		default:
+			return nil, fmt.Errorf("unknown column %q: the columns are %s, %s, %s and %s",
+				column, airdropAddressColumn, airdropBalanceColumn, airdropCodeColumn, airdropStorageColumn)
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{airdropAddressColumn, airdropBalanceColumn} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing %s column", column)
+		}
+	}
+	cell := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	entries := []AirdropEntry{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		entry := AirdropEntry{
+			Address: cell(record, airdropAddressColumn),
+			Balance: cell(record, airdropBalanceColumn),
+			Code:    cell(record, airdropCodeColumn),
+		}
+		if storage := cell(record, airdropStorageColumn); storage != "" {
+			entry.Storage = map[string]string{}
+			for _, slot := range strings.Fields(storage) {
+				key, value, ok := strings.Cut(slot, "=")
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid storage slot %q, expected slot=value", line, slot)
+				}
+				entry.Storage[key] = value
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// getAirdropAllocation validates [entries] and returns their genesis allocation
+func getAirdropAllocation(entries []AirdropEntry) (core.GenesisAlloc, AirdropSummary, error) {
+	if len(entries) == 0 {
+		return nil, AirdropSummary{}, errors.New("no airdrop entries found")
+	}
+	alloc := core.GenesisAlloc{}
+	summary := AirdropSummary{Total: big.NewInt(0)}
+	for i, entry := range entries {
+		address, account, err := getAirdropAccount(entry)
+		if err != nil {
+			return nil, AirdropSummary{}, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		if _, ok := alloc[address]; ok {
+			return nil, AirdropSummary{}, fmt.Errorf("entry %d: duplicated address %s", i+1, address.Hex())
+		}
+		alloc[address] = account
+		summary.Accounts++
+		if len(account.Code) > 0 {
+			summary.Contracts++
+		}
+		summary.Total.Add(summary.Total, account.Balance)
+		if summary.Total.Cmp(maxGenesisBalance) > 0 {
+			return nil, AirdropSummary{}, fmt.Errorf("entry %d: the total airdrop exceeds the maximum supply", i+1)
+		}
+	}
+	return alloc, summary, nil
+}
+
+func getAirdropAccount(entry AirdropEntry) (common.Address, core.GenesisAccount, error) {
+	address, err := parseChecksummedAddress(entry.Address)
+	if err != nil {
+		return common.Address{}, core.GenesisAccount{}, err
+	}
+	balance, err := parseTokenAmount(entry.Balance)
+	if err != nil {
+		return common.Address{}, core.GenesisAccount{}, fmt.Errorf("invalid balance of %s: %w", address.Hex(), err)
+	}
+	account := core.GenesisAccount{Balance: balance}
+	if entry.Code != "" {
+		account.Code, err = hexutil.Decode(entry.Code)
+		if err != nil {
+			return common.Address{}, core.GenesisAccount{}, fmt.Errorf("invalid code of %s: %w", address.Hex(), err)
+		}
+	}
+	if len(entry.Storage) > 0 {
+		if len(account.Code) == 0 {
+			return common.Address{}, core.GenesisAccount{}, fmt.Errorf("storage of %s is given without code", address.Hex())
+		}
+		account.Storage = map[common.Hash]common.Hash{}
+		for key, value := range entry.Storage {
+			keyHash, err := parseStorageHash(key)
+			if err != nil {
+				return common.Address{}, core.GenesisAccount{}, fmt.Errorf("invalid storage slot of %s: %w", address.Hex(), err)
+			}
+			valueHash, err := parseStorageHash(value)
+			if err != nil {
+				return common.Address{}, core.GenesisAccount{}, fmt.Errorf("invalid storage value of %s: %w", address.Hex(), err)
+			}
+			account.Storage[keyHash] = valueHash
+		}
+	}
+	if balance.Sign() == 0 && len(account.Code) == 0 {
+		return common.Address{}, core.GenesisAccount{}, fmt.Errorf("%s has no balance nor code", address.Hex())
+	}
+	return address, account, nil
+}
+
+// parseChecksummedAddress parses the hex address [s]. Mixed-case addresses must have
+// a valid EIP-55 checksum
+func parseChecksummedAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	address := common.HexToAddress(s)
+	hexDigits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if hexDigits != strings.ToLower(hexDigits) && hexDigits != strings.ToUpper(hexDigits) &&
+		hexDigits != strings.TrimPrefix(address.Hex(), "0x") {
+		return common.Address{}, fmt.Errorf("invalid checksum of address %s, expected %s", s, address.Hex())
+	}
+	return address, nil
+}
+
+// parseTokenAmount parses the amount [s] given in token units, with up to 18 decimals,
+// and returns it in wei
+func parseTokenAmount(s string) (*big.Int, error) {
+	integerPart, fractionalPart, _ := strings.Cut(s, ".")
+	if integerPart == "" && fractionalPart == "" {
+		return nil, errors.New("empty amount")
+	}
+	if len(fractionalPart) > tokenDecimals {
+		return nil, fmt.Errorf("%s has more than %d decimals", s, tokenDecimals)
+	}
+	digits := integerPart + fractionalPart + strings.Repeat("0", tokenDecimals-len(fractionalPart))
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return nil, fmt.Errorf("%q is not a positive decimal number", s)
+		}
+	}
+	amount, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a positive decimal number", s)
+	}
+	if amount.Cmp(maxGenesisBalance) > 0 {
+		return nil, fmt.Errorf("%s exceeds the maximum supply", s)
+	}
+	return amount, nil
+}
+
+func parseStorageHash(s string) (common.Hash, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return common.Hash{}, fmt.Errorf("%q is not a 0x prefixed hex value", s)
+	}
+	hexDigits := strings.TrimPrefix(s, "0x")
+	if len(hexDigits)%2 == 1 {
+		hexDigits = "0" + hexDigits
+	}
+	b, err := hexutil.Decode("0x" + hexDigits)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("%s is longer than %d bytes", s, common.HashLength)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// getAirdropFileAllocation is the airdrop step of the genesis creation when an airdrop
+// file is given
+func getAirdropFileAllocation(path string) (core.GenesisAlloc, statemachine.StateDirection, error) {
+	alloc, summary, err := LoadAirdropFile(path)
+	if err != nil {
+		return nil, statemachine.Stop, err
+	}
+	printAirdropSummary(path, summary)
+	return alloc, statemachine.Forward, nil
+}
+
+// printAirdropSummary prints the allocation loaded from airdrop file [path]
+func printAirdropSummary(path string, summary AirdropSummary) {
+	ux.Logger.PrintToUser("Airdrop loaded from %s:", path)
+	ux.Logger.PrintToUser("  Accounts:  %d", summary.Accounts)
+	if summary.Contracts > 0 {
+		ux.Logger.PrintToUser("  Contracts: %d", summary.Contracts)
+	}
+	ux.Logger.PrintToUser("  Total:     %s tokens", formatUnits(summary.Total, tokenDecimals))
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var testContractAddress = common.HexToAddress("0x0200000000000000000000000000000000000001")
+
+func writeAirdropFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadAirdropFileCSV(t *testing.T) {
+	require := setupTest(t)
+	path := writeAirdropFile(t, "airdrop.csv", `address,balance,code,storage
+# team allocation
+0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,1000000
+0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc,0.5
+0x0200000000000000000000000000000000000001,0,0x6080,0x0=0x1 0x01=0xff
+`)
+	alloc, summary, err := LoadAirdropFile(path)
+	require.NoError(err)
+	require.Len(alloc, 3)
+	require.Equal(3, summary.Accounts)
+	require.Equal(1, summary.Contracts)
+
+	expectedBalance, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+	require.Equal(expectedBalance, alloc[testAirdropAddress].Balance)
+	require.Equal(big.NewInt(500000000000000000), alloc[common.HexToAddress("0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc")].Balance)
+	expectedTotal, _ := new(big.Int).SetString("1000000500000000000000000", 10)
+	require.Equal(expectedTotal, summary.Total)
+
+	contract := alloc[testContractAddress]
+	require.Equal([]byte{0x60, 0x80}, contract.Code)
+	require.Equal(common.BigToHash(big.NewInt(1)), contract.Storage[common.Hash{}])
+	require.Equal(common.BigToHash(big.NewInt(255)), contract.Storage[common.BigToHash(big.NewInt(1))])
+	require.Equal(0, contract.Balance.Sign())
+}
+
+func TestLoadAirdropFileJSON(t *testing.T) {
+	require := setupTest(t)
+	path := writeAirdropFile(t, "airdrop.json", `[
+	{"address": "0x098B69E43b1720Bd12378225519d74e5F3aD0eA5", "balance": "10"},
+	{"address": "0x0200000000000000000000000000000000000001", "balance": "1.25", "code": "0x6080", "storage": {"0x00": "0x02"}}
+]`)
+	alloc, summary, err := LoadAirdropFile(path)
+	require.NoError(err)
+	require.Len(alloc, 2)
+	require.Equal(1, summary.Contracts)
+	require.Equal(new(big.Int).Mul(big.NewInt(10), oneDione), alloc[testAirdropAddress].Balance)
+	require.Equal(big.NewInt(1250000000000000000), alloc[testContractAddress].Balance)
+	require.Equal(common.BigToHash(big.NewInt(2)), alloc[testContractAddress].Storage[common.Hash{}])
+}
+
+func TestLoadAirdropFileInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "empty",
+			content:     "",
+			expectedErr: "the file is empty",
+		},
+		{
+			name:        "no entries",
+			content:     "address,balance\n",
+			expectedErr: "no airdrop entries found",
+		},
+		{
+			name:        "missing column",
+			content:     "address\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5\n",
+			expectedErr: "missing balance column",
+		},
+		{
+			name:        "unknown column",
+			content:     "address,balance,nonce\n",
+			expectedErr: `unknown column "nonce"`,
+		},
+		{
+			name:        "invalid address",
+			content:     "address,balance\n0x098B69E43b1720Bd1237822551,1\n",
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid checksum",
+			content:     "address,balance\n0x098b69E43b1720Bd12378225519d74e5F3aD0eA5,1\n",
+			expectedErr: "invalid checksum",
+		},
+		{
+			name:        "negative balance",
+			content:     "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,-1\n",
+			expectedErr: "not a positive decimal number",
+		},
+		{
+			name:        "too many decimals",
+			content:     "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,0.0000000000000000001\n",
+			expectedErr: "more than 18 decimals",
+		},
+		{
+			name:        "zero balance",
+			content:     "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,0\n",
+			expectedErr: "has no balance nor code",
+		},
+		{
+			name:        "storage without code",
+			content:     "address,balance,storage\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,1,0x0=0x1\n",
+			expectedErr: "storage of 0x098B69E43b1720Bd12378225519d74e5F3aD0eA5 is given without code",
+		},
+		{
+			name:        "invalid storage",
+			content:     "address,balance,code,storage\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,1,0x60,0x0\n",
+			expectedErr: "expected slot=value",
+		},
+		{
+			name: "duplicated address",
+			content: "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,1\n" +
+				"0x098b69e43b1720bd12378225519d74e5f3ad0ea5,2\n",
+			expectedErr: "entry 2: duplicated address",
+		},
+		{
+			name: "total overflow",
+			content: "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,6" + strings.Repeat("0", 58) + "\n" +
+				"0x8db97c7cece249c2b98bdc0226cc4c2a57bf52fc,6" + strings.Repeat("0", 58) + "\n",
+			expectedErr: "the total airdrop exceeds the maximum supply",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := setupTest(t)
+			_, _, err := LoadAirdropFile(writeAirdropFile(t, "airdrop.csv", tt.content))
+			require.ErrorContains(err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGetAirdropFileAllocation(t *testing.T) {
+	require := setupTest(t)
+	path := writeAirdropFile(t, "airdrop.csv", "address,balance\n0x098B69E43b1720Bd12378225519d74e5F3aD0eA5,1\n")
+	alloc, direction, err := getAirdropFileAllocation(path)
+	require.NoError(err)
+	require.Equal(statemachine.Forward, direction)
+	require.NoError(ensureAdminsHaveBalance([]common.Address{testAirdropAddress}, alloc))
+	require.Error(ensureAdminsHaveBalance([]common.Address{testContractAddress}, alloc))
+}
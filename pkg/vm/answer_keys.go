@@ -0,0 +1,61 @@
+// Copyright (C) 2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+package vm
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
+	"github.com/DioneProtocol/subnet-evm/params"
+)
+
+// AnswerKeys are the stable answers file keys of the Subnet-EVM and custom VM creation prompts
+var AnswerKeys = prompts.AnswerKeys{
+	chainIDPrompt:       "evm.chainId",
+	tokenSymbolPrompt:   "evm.tokenSymbol",
+	customVersionPrompt: "evm.customVersion",
+
+	fmt.Sprintf(vmVersionPrompt, "Subnet-EVM"): "evm.version",
+
+	feeConfigPrompt:             "fees.preset",
+	setGasLimit:                 "fees.gasLimit",
+	setBlockRate:                "fees.targetBlockRate",
+	setMinBaseFee:               "fees.minBaseFee",
+	setTargetGas:                "fees.targetGas",
+	setBaseFeeChangeDenominator: "fees.baseFeeChangeDenominator",
+	setMinBlockGas:              "fees.minBlockGasCost",
+	setMaxBlockGas:              "fees.maxBlockGasCost",
+	setGasStep:                  "fees.blockGasCostStep",
+
+	airdropPrompt:        "airdrop.type",
+	airdropAddressPrompt: "airdrop.addresses",
+	airdropAmountPrompt:  "airdrop.amounts",
+	extendAirdrop:        "airdrop.more",
+
+	addPrecompilePrompt:           "precompiles.add",
+	addMorePrecompilesPrompt:      "precompiles.addMore",
+	choosePrecompilePrompt:        "precompiles.selected",
+	nativeMinterAdminsPrompt:      "precompiles.nativeMinter.admins",
+	nativeMinterEnabledPrompt:     "precompiles.nativeMinter.enabled",
+	contractDeployerAdminsPrompt:  "precompiles.contractDeployer.admins",
+	contractDeployerEnabledPrompt: "precompiles.contractDeployer.enabled",
+	txAllowListAdminsPrompt:       "precompiles.txAllowList.admins",
+	txAllowListEnabledPrompt:      "precompiles.txAllowList.enabled",
+	feeManagerAdminsPrompt:        "precompiles.feeManager.admins",
+	feeManagerEnabledPrompt:       "precompiles.feeManager.enabled",
+	rewardManagerAdminsPrompt:     "precompiles.rewardManager.admins",
+	rewardManagerEnabledPrompt:    "precompiles.rewardManager.enabled",
+	burnFeesPrompt:                "precompiles.rewardManager.burnFees",
+	allowFeeRecipientsPrompt:      "precompiles.rewardManager.allowFeeRecipients",
+	rewardAddressPrompt:           "precompiles.rewardManager.rewardAddress",
+	warpQuorumPrompt:              "precompiles.warp.quorum",
+
+	fmt.Sprintf(warpDefaultQuorumPrompt, params.WarpDefaultQuorumNumerator): "precompiles.warp.defaultQuorum",
+
+	vmBinarySourcePrompt:    "customVM.binarySource",
+	vmBinaryPathPrompt:      "customVM.binaryPath",
+	customGenesisPrompt:     "customVM.genesisPath",
+	vmRepoURLPrompt:         "customVM.repoURL",
+	vmRepoBranchPrompt:      "customVM.branch",
+	vmRepoBuildScriptPrompt: "customVM.buildScript",
+}
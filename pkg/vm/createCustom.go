@@ -17,6 +17,15 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 )
 
+const (
+	vmBinarySourcePrompt    = "How do you want to set up the VM binary?"
+	vmBinaryPathPrompt      = "Enter path to VM binary"
+	customGenesisPrompt     = "Enter path to custom genesis"
+	vmRepoURLPrompt         = "Source code repository URL"
+	vmRepoBranchPrompt      = "Branch"
+	vmRepoBuildScriptPrompt = "Build script"
+)
+
 func CreateCustomSubnetConfig(
 	app *application.Odyssey,
 	subnetName string,
@@ -49,14 +58,14 @@ func CreateCustomSubnetConfig(
 		githubOption := "Download and build from a git repository (recommended for cloud deployments)"
 		localOption := "I already have a VM binary (local network deployments only)"
 		options := []string{githubOption, localOption}
-		option, err := app.Prompt.CaptureList("How do you want to set up the VM binary?", options)
+		option, err := app.Prompt.CaptureList(vmBinarySourcePrompt, options)
 		if err != nil {
 			return nil, &models.Sidecar{}, err
 		}
 		if option == githubOption {
 			useRepo = true
 		} else {
-			vmPath, err = app.Prompt.CaptureExistingFilepath("Enter path to VM binary")
+			vmPath, err = app.Prompt.CaptureExistingFilepath(vmBinaryPathPrompt)
 			if err != nil {
 				return nil, &models.Sidecar{}, err
 			}
@@ -89,7 +98,7 @@ func CreateCustomSubnetConfig(
 func loadCustomGenesis(app *application.Odyssey, genesisPath string) ([]byte, error) {
 	var err error
 	if genesisPath == "" {
-		genesisPath, err = app.Prompt.CaptureExistingFilepath("Enter path to custom genesis")
+		genesisPath, err = app.Prompt.CaptureExistingFilepath(customGenesisPrompt)
 		if err != nil {
 			return nil, err
 		}
@@ -109,7 +118,7 @@ func SetCustomVMSourceCodeFields(app *application.Odyssey, sc *models.Sidecar, c
 		}
 	}
 	if customVMRepoURL == "" {
-		customVMRepoURL, err = app.Prompt.CaptureURL("Source code repository URL")
+		customVMRepoURL, err = app.Prompt.CaptureURL(vmRepoURLPrompt)
 		if err != nil {
 			return err
 		}
@@ -122,7 +131,7 @@ func SetCustomVMSourceCodeFields(app *application.Odyssey, sc *models.Sidecar, c
 		}
 	}
 	if customVMBranch == "" {
-		customVMBranch, err = app.Prompt.CaptureRepoBranch("Branch", customVMRepoURL)
+		customVMBranch, err = app.Prompt.CaptureRepoBranch(vmRepoBranchPrompt, customVMRepoURL)
 		if err != nil {
 			return err
 		}
@@ -135,7 +144,7 @@ func SetCustomVMSourceCodeFields(app *application.Odyssey, sc *models.Sidecar, c
 		}
 	}
 	if customVMBuildScript == "" {
-		customVMBuildScript, err = app.Prompt.CaptureRepoFile("Build script", customVMRepoURL, customVMBranch)
+		customVMBuildScript, err = app.Prompt.CaptureRepoFile(vmRepoBuildScriptPrompt, customVMRepoURL, customVMBranch)
 		if err != nil {
 			return err
 		}
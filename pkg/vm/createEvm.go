@@ -15,6 +15,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/models"
 	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/subnet-evm/commontype"
 	"github.com/DioneProtocol/subnet-evm/core"
 	"github.com/DioneProtocol/subnet-evm/params"
 	"github.com/DioneProtocol/subnet-evm/precompile/contracts/txallowlist"
@@ -29,6 +30,8 @@ func CreateEvmSubnetConfig(
 	subnetEVMChainID uint64,
 	subnetEVMTokenName string,
 	useSubnetEVMDefaults bool,
+	airdropFile string,
+	feeConfig *commontype.FeeConfig,
 ) ([]byte, *models.Sidecar, error) {
 	var (
 		genesisBytes []byte
@@ -37,16 +40,25 @@ func CreateEvmSubnetConfig(
 	)
 
 	if genesisPath == "" {
-		genesisBytes, sc, err = createEvmGenesis(app, subnetName, subnetEVMVersion, subnetEVMChainID, subnetEVMTokenName, useSubnetEVMDefaults)
+		genesisBytes, sc, err = createEvmGenesis(app, subnetName, subnetEVMVersion, subnetEVMChainID, subnetEVMTokenName, useSubnetEVMDefaults, airdropFile, feeConfig)
 		if err != nil {
 			return nil, &models.Sidecar{}, err
 		}
 	} else {
+		if airdropFile != "" {
+			return nil, &models.Sidecar{}, errors.New("an airdrop file can't be used with an imported genesis")
+		}
+		if feeConfig != nil {
+			return nil, &models.Sidecar{}, errors.New("a fee config can't be used with an imported genesis")
+		}
 		ux.Logger.PrintToUser("Importing genesis")
 		genesisBytes, err = os.ReadFile(genesisPath)
 		if err != nil {
 			return nil, &models.Sidecar{}, err
 		}
+		if err := VerifyGenesisPrecompiles(genesisBytes); err != nil {
+			return nil, &models.Sidecar{}, err
+		}
 
 		subnetEVMVersion, err = getVMVersion(app, "Subnet-EVM", constants.SubnetEVMRepoName, subnetEVMVersion, false)
 		if err != nil {
@@ -78,6 +90,8 @@ func createEvmGenesis(
 	subnetEVMChainID uint64,
 	subnetEVMTokenName string,
 	useSubnetEVMDefaults bool,
+	airdropFile string,
+	feeConfig *commontype.FeeConfig,
 ) ([]byte, *models.Sidecar, error) {
 	ux.Logger.PrintToUser("creating subnet %s", subnetName)
 
@@ -111,9 +125,18 @@ func createEvmGenesis(
 		case descriptorsState:
 			chainID, tokenName, vmVersion, direction, err = getDescriptors(app, subnetEVMVersion, subnetEVMChainID, subnetEVMTokenName)
 		case feeState:
-			*conf, direction, err = GetFeeConfig(*conf, app, useSubnetEVMDefaults)
+			if feeConfig != nil {
+				conf.FeeConfig = *feeConfig
+				direction = statemachine.Forward
+			} else {
+				*conf, direction, err = GetFeeConfig(*conf, app, useSubnetEVMDefaults)
+			}
 		case airdropState:
-			allocation, direction, err = getEVMAllocation(app, useSubnetEVMDefaults)
+			if airdropFile != "" {
+				allocation, direction, err = getAirdropFileAllocation(airdropFile)
+			} else {
+				allocation, direction, err = getEVMAllocation(app, useSubnetEVMDefaults)
+			}
 		case precompilesState:
 			*conf, direction, err = getPrecompiles(*conf, app, useSubnetEVMDefaults)
 		default:
@@ -195,5 +218,5 @@ func ensureAdminsHaveBalance(admins []common.Address, alloc core.GenesisAlloc) e
 
 // In own function to facilitate testing
 func getEVMAllocation(app *application.Odyssey, useDefaults bool) (core.GenesisAlloc, statemachine.StateDirection, error) {
-	return getAllocation(app, defaultEvmAirdropAmount, oneDione, "Amount to airdrop (in DIONE units)", useDefaults)
+	return getAllocation(app, defaultEvmAirdropAmount, oneDione, airdropAmountPrompt, useDefaults)
 }
@@ -0,0 +1,498 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/DioneProtocol/subnet-evm/precompile/allowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/contract"
+	"github.com/DioneProtocol/subnet-evm/precompile/modules"
+	"github.com/DioneProtocol/subnet-evm/precompile/precompileconfig"
+	"github.com/DioneProtocol/subnet-evm/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	PrecompilePromptString    = "string"
+	PrecompilePromptBool      = "bool"
+	PrecompilePromptUint64    = "uint64"
+	PrecompilePromptBigInt    = "bigint"
+	PrecompilePromptAddress   = "address"
+	PrecompilePromptAddresses = "addresses"
+	PrecompilePromptAllowList = "allowList"
+
+	blockTimestampParam   = "blockTimestamp"
+	disableParam          = "disable"
+	adminAddressesParam   = "adminAddresses"
+	enabledAddressesParam = "enabledAddresses"
+)
+
+var (
+	precompilePromptTypes = []string{
+		PrecompilePromptString,
+		PrecompilePromptBool,
+		PrecompilePromptUint64,
+		PrecompilePromptBigInt,
+		PrecompilePromptAddress,
+		PrecompilePromptAddresses,
+		PrecompilePromptAllowList,
+	}
+
+	customPrecompilesLock sync.RWMutex
+	// customPrecompiles are the precompiles registered by descriptors, keyed by config key
+	customPrecompiles = map[string]*customPrecompile{}
+	// registeredCustomModules are the addresses of the modules registered into subnet-evm
+	// for the descriptors. subnet-evm modules can't be unregistered
+	registeredCustomModules = map[string]common.Address{}
+)
+
+type customPrecompile struct {
+	descriptor models.PrecompileDescriptor
+	address    common.Address
+	schema     *jsonSchema
+}
+
+// CustomPrecompileConfig is the config of a precompile registered by a descriptor.
+// Its params are kept as decoded JSON, and are checked against the descriptor schema
+type CustomPrecompileConfig struct {
+	precompileconfig.Upgrade
+	Params map[string]interface{}
+
+	key string
+}
+
+// newCustomPrecompileConfig returns the config of the precompile with [key], enabled
+// at [blockTimestamp] with [params]
+func newCustomPrecompileConfig(key string, blockTimestamp *uint64, params map[string]interface{}) (*CustomPrecompileConfig, error) {
+	config := &CustomPrecompileConfig{
+		Upgrade: precompileconfig.Upgrade{BlockTimestamp: blockTimestamp},
+		key:     key,
+	}
+	// params are stored as decoded JSON, as when the config is read from a file
+	paramsBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	config.Params, err = decodeJSONObject(paramsBytes)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Key returns the config key of the precompile
+func (c *CustomPrecompileConfig) Key() string {
+	return c.key
+}
+
+// Equal returns true if [other] configures the same precompile with the same params
+func (c *CustomPrecompileConfig) Equal(other precompileconfig.Config) bool {
+	o, ok := other.(*CustomPrecompileConfig)
+	if !ok || c.key != o.key || !c.Upgrade.Equal(&o.Upgrade) {
+		return false
+	}
+	paramsBytes, err := json.Marshal(c.Params)
+	if err != nil {
+		return false
+	}
+	otherParamsBytes, err := json.Marshal(o.Params)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(paramsBytes, otherParamsBytes)
+}
+
+// Verify checks the params against the schema of the precompile descriptor
+func (c *CustomPrecompileConfig) Verify(precompileconfig.ChainConfig) error {
+	precompile, ok := getCustomPrecompile(c.key)
+	if !ok {
+		return fmt.Errorf("precompile %s is not registered", c.key)
+	}
+	if c.IsDisabled() || precompile.schema == nil {
+		return nil
+	}
+	params := c.Params
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	return precompile.schema.validate(c.key, params)
+}
+
+// AllowListConfig returns the allow list of the precompile, if its params have one
+func (c *CustomPrecompileConfig) AllowListConfig() (allowlist.AllowListConfig, bool) {
+	hasAllowList := false
+	for _, param := range []string{adminAddressesParam, "managerAddresses", enabledAddressesParam} {
+		if _, ok := c.Params[param]; ok {
+			hasAllowList = true
+		}
+	}
+	if !hasAllowList {
+		return allowlist.AllowListConfig{}, false
+	}
+	paramsBytes, err := json.Marshal(c.Params)
+	if err != nil {
+		return allowlist.AllowListConfig{}, false
+	}
+	var allowList allowlist.AllowListConfig
+	if err := json.Unmarshal(paramsBytes, &allowList); err != nil {
+		return allowlist.AllowListConfig{}, false
+	}
+	return allowList, true
+}
+
+func (c *CustomPrecompileConfig) MarshalJSON() ([]byte, error) {
+	fields := map[string]interface{}{}
+	for param, value := range c.Params {
+		fields[param] = value
+	}
+	fields[blockTimestampParam] = c.BlockTimestamp
+	if c.Disable {
+		fields[disableParam] = true
+	}
+	return json.Marshal(fields)
+}
+
+func (c *CustomPrecompileConfig) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Upgrade); err != nil {
+		return err
+	}
+	params, err := decodeJSONObject(data)
+	if err != nil {
+		return err
+	}
+	delete(params, blockTimestampParam)
+	delete(params, disableParam)
+	c.Params = params
+	return nil
+}
+
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// customPrecompileConfigurator makes the configs of a precompile registered by a descriptor
+type customPrecompileConfigurator struct {
+	key string
+}
+
+func (c *customPrecompileConfigurator) MakeConfig() precompileconfig.Config {
+	return &CustomPrecompileConfig{key: c.key}
+}
+
+// Configure does nothing, as the CLI does not execute the precompiles
+func (*customPrecompileConfigurator) Configure(
+	precompileconfig.ChainConfig,
+	precompileconfig.Config,
+	contract.StateDB,
+	contract.ConfigurationBlockContext,
+) error {
+	return nil
+}
+
+// ValidatePrecompileDescriptor checks that [descriptor] is well formed, and that its
+// config key, address and name are not used by other precompiles
+func ValidatePrecompileDescriptor(descriptor models.PrecompileDescriptor) error {
+	customPrecompilesLock.RLock()
+	defer customPrecompilesLock.RUnlock()
+	if _, err := parsePrecompileDescriptor(descriptor); err != nil {
+		return err
+	}
+	for key, precompile := range customPrecompiles {
+		if key == descriptor.ConfigKey {
+			continue
+		}
+		if precompile.descriptor.Name == descriptor.Name {
+			return fmt.Errorf("name %s is already used by precompile %s", descriptor.Name, key)
+		}
+		if precompile.address == common.HexToAddress(descriptor.Address) {
+			return fmt.Errorf("address %s is already used by precompile %s", descriptor.Address, key)
+		}
+	}
+	return nil
+}
+
+// SetCustomPrecompiles makes the precompiles of [descriptors] known to the CLI and to
+// subnet-evm, replacing the previously set ones, so that their configs can be read
+// and written in genesis and upgrade files
+func SetCustomPrecompiles(descriptors map[string]models.PrecompileDescriptor) error {
+	customPrecompilesLock.Lock()
+	defer customPrecompilesLock.Unlock()
+	keys := make([]string, 0, len(descriptors))
+	for key := range descriptors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	precompiles := map[string]*customPrecompile{}
+	for _, key := range keys {
+		descriptor := descriptors[key]
+		if descriptor.ConfigKey != key {
+			return fmt.Errorf("precompile descriptor %s has config key %s", key, descriptor.ConfigKey)
+		}
+		precompile, err := parsePrecompileDescriptor(descriptor)
+		if err != nil {
+			return fmt.Errorf("invalid precompile descriptor %s: %w", key, err)
+		}
+		precompiles[key] = precompile
+	}
+	for _, key := range keys {
+		address := precompiles[key].address
+		if registeredAddress, ok := registeredCustomModules[key]; ok {
+			if registeredAddress != address {
+				return fmt.Errorf("precompile %s is already registered at address %s", key, registeredAddress.Hex())
+			}
+			continue
+		}
+		module := modules.Module{
+			ConfigKey:    key,
+			Address:      address,
+			Configurator: &customPrecompileConfigurator{key: key},
+		}
+		if err := modules.RegisterModule(module); err != nil {
+			return fmt.Errorf("invalid precompile descriptor %s: %w", key, err)
+		}
+		registeredCustomModules[key] = address
+	}
+	customPrecompiles = precompiles
+	return nil
+}
+
+// parsePrecompileDescriptor checks [descriptor] against the precompiles of subnet-evm.
+// Must be called with customPrecompilesLock held
+func parsePrecompileDescriptor(descriptor models.PrecompileDescriptor) (*customPrecompile, error) {
+	if descriptor.Name == "" {
+		return nil, errors.New("the precompile name is required")
+	}
+	for _, name := range builtinPrecompiles {
+		if descriptor.Name == name {
+			return nil, fmt.Errorf("name %s is reserved for a built-in precompile", name)
+		}
+	}
+	if descriptor.ConfigKey == "" {
+		return nil, errors.New("the config key is required")
+	}
+	if module, ok := modules.GetPrecompileModule(descriptor.ConfigKey); ok {
+		if _, isCustom := registeredCustomModules[descriptor.ConfigKey]; !isCustom {
+			return nil, fmt.Errorf("config key %s is used by a built-in precompile", module.ConfigKey)
+		}
+	}
+	if !common.IsHexAddress(descriptor.Address) {
+		return nil, fmt.Errorf("invalid address %q", descriptor.Address)
+	}
+	address := common.HexToAddress(descriptor.Address)
+	if !modules.ReservedAddress(address) {
+		return nil, fmt.Errorf("address %s is not in a range reserved for precompiles", address.Hex())
+	}
+	if module, ok := modules.GetPrecompileModuleByAddress(address); ok && module.ConfigKey != descriptor.ConfigKey {
+		return nil, fmt.Errorf("address %s is used by precompile %s", address.Hex(), module.ConfigKey)
+	}
+	precompile := &customPrecompile{
+		descriptor: descriptor,
+		address:    address,
+	}
+	if len(descriptor.Schema) > 0 && !bytes.Equal(bytes.TrimSpace(descriptor.Schema), []byte("null")) {
+		schema, err := parseJSONSchema(descriptor.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema: %w", err)
+		}
+		precompile.schema = schema
+	}
+	params := map[string]bool{}
+	for i, prompt := range descriptor.Prompts {
+		promptParams := []string{prompt.Key}
+		switch prompt.Type {
+		case PrecompilePromptAllowList:
+			promptParams = []string{adminAddressesParam, enabledAddressesParam}
+		case PrecompilePromptString, PrecompilePromptBool, PrecompilePromptUint64, PrecompilePromptBigInt,
+			PrecompilePromptAddress, PrecompilePromptAddresses:
+			if prompt.Key == "" {
+				return nil, fmt.Errorf("prompt %d: the key is required", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("prompt %d: unknown type %q, expected one of %v", i+1, prompt.Type, precompilePromptTypes)
+		}
+		for _, param := range promptParams {
+			if param == blockTimestampParam || param == disableParam {
+				return nil, fmt.Errorf("prompt %d: %s is set by the CLI", i+1, param)
+			}
+			if params[param] {
+				return nil, fmt.Errorf("prompt %d: %s is already set by another prompt", i+1, param)
+			}
+			if schema := precompile.schema; schema != nil && schema.Properties[param] == nil &&
+				schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+				return nil, fmt.Errorf("prompt %d: %s is not a property of the schema", i+1, param)
+			}
+			params[param] = true
+		}
+	}
+	return precompile, nil
+}
+
+func getCustomPrecompile(key string) (*customPrecompile, bool) {
+	customPrecompilesLock.RLock()
+	defer customPrecompilesLock.RUnlock()
+	precompile, ok := customPrecompiles[key]
+	return precompile, ok
+}
+
+// GetCustomPrecompileDescriptors returns the descriptors of the registered precompiles,
+// sorted by name
+func GetCustomPrecompileDescriptors() []models.PrecompileDescriptor {
+	customPrecompilesLock.RLock()
+	defer customPrecompilesLock.RUnlock()
+	descriptors := make([]models.PrecompileDescriptor, 0, len(customPrecompiles))
+	for _, precompile := range customPrecompiles {
+		descriptors = append(descriptors, precompile.descriptor)
+	}
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Name < descriptors[j].Name })
+	return descriptors
+}
+
+// GetCustomPrecompileDescriptorByName returns the descriptor of the registered precompile named [name]
+func GetCustomPrecompileDescriptorByName(name string) (models.PrecompileDescriptor, bool) {
+	for _, descriptor := range GetCustomPrecompileDescriptors() {
+		if descriptor.Name == name {
+			return descriptor, true
+		}
+	}
+	return models.PrecompileDescriptor{}, false
+}
+
+// ConfigureCustomPrecompile prompts the params of the registered precompile with [configKey],
+// and returns its config, enabled at [blockTimestamp]
+func ConfigureCustomPrecompile(app *application.Odyssey, configKey string, blockTimestamp uint64) (*CustomPrecompileConfig, bool, error) {
+	precompile, ok := getCustomPrecompile(configKey)
+	if !ok {
+		return nil, false, fmt.Errorf("precompile %s is not registered", configKey)
+	}
+	descriptor := precompile.descriptor
+	info := ""
+	if descriptor.Description != "" {
+		info = "\n" + descriptor.Description + "\n\n"
+		ux.Logger.PrintToUser(info)
+	}
+	params := map[string]interface{}{}
+	for _, prompt := range descriptor.Prompts {
+		if prompt.Optional {
+			param := prompt.Key
+			if prompt.Type == PrecompilePromptAllowList {
+				param = "the allow list"
+			}
+			yes, err := app.Prompt.CaptureNoYes(fmt.Sprintf("Set %s?", param))
+			if err != nil {
+				return nil, false, err
+			}
+			if !yes {
+				continue
+			}
+		}
+		promptStr := prompt.Prompt
+		if promptStr == "" {
+			promptStr = fmt.Sprintf("Enter %s", prompt.Key)
+		}
+		var (
+			value interface{}
+			err   error
+		)
+		switch prompt.Type {
+		case PrecompilePromptAllowList:
+			adminPrompt := fmt.Sprintf("Configure %s admin addresses", descriptor.Name)
+			enabledPrompt := fmt.Sprintf("Configure %s enabled addresses", descriptor.Name)
+			admins, enabled, cancelled, err := getAdminAndEnabledAddresses(adminPrompt, enabledPrompt, info, app)
+			if err != nil || cancelled {
+				return nil, cancelled, err
+			}
+			params[adminAddressesParam] = admins
+			params[enabledAddressesParam] = enabled
+			continue
+		case PrecompilePromptAddresses:
+			addresses, cancelled, err := getAddressList(promptStr, info, app)
+			if err != nil || cancelled {
+				return nil, cancelled, err
+			}
+			value = addresses
+		case PrecompilePromptAddress:
+			value, err = app.Prompt.CaptureAddress(promptStr)
+		case PrecompilePromptBool:
+			value, err = app.Prompt.CaptureYesNo(promptStr)
+		case PrecompilePromptUint64:
+			value, err = app.Prompt.CaptureUint64(promptStr)
+		case PrecompilePromptBigInt:
+			value, err = app.Prompt.CapturePositiveBigInt(promptStr)
+		case PrecompilePromptString:
+			value, err = app.Prompt.CaptureString(promptStr)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		params[prompt.Key] = value
+	}
+	config, err := newCustomPrecompileConfig(configKey, utils.NewUint64(blockTimestamp), params)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := config.Verify(nil); err != nil {
+		return nil, false, fmt.Errorf("invalid %s params: %w", descriptor.Name, err)
+	}
+	return config, false, nil
+}
+
+// VerifyGenesisPrecompiles checks the precompile configs of the genesis [genesisBytes].
+// Configs of precompiles that are neither built-in nor registered are errors, as they
+// would be dropped when the genesis is read
+func VerifyGenesisPrecompiles(genesisBytes []byte) error {
+	var rawGenesis struct {
+		Config map[string]json.RawMessage `json:"config"`
+	}
+	if err := json.Unmarshal(genesisBytes, &rawGenesis); err != nil {
+		return fmt.Errorf("invalid genesis: %w", err)
+	}
+	keys := make([]string, 0, len(rawGenesis.Config))
+	for key := range rawGenesis.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		// precompile config keys end in Config, as feeConfig which is not one
+		if !strings.HasSuffix(key, "Config") || key == "feeConfig" {
+			continue
+		}
+		if _, ok := modules.GetPrecompileModule(key); !ok {
+			return fmt.Errorf("unknown precompile %s: register its descriptor with subnet precompile add", key)
+		}
+	}
+	var genesis core.Genesis
+	if err := json.Unmarshal(genesisBytes, &genesis); err != nil {
+		return fmt.Errorf("invalid genesis: %w", err)
+	}
+	if genesis.Config == nil {
+		return nil
+	}
+	for _, key := range keys {
+		config, ok := genesis.Config.GenesisPrecompiles[key]
+		if !ok {
+			continue
+		}
+		if err := config.Verify(genesis.Config); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	return nil
+}
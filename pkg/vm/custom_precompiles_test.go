@@ -0,0 +1,304 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/DioneProtocol/subnet-evm/x/warp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrecompileKey = "helloWorldConfig"
+
+var testPrecompileDescriptor = models.PrecompileDescriptor{
+	Name:      "Hello World",
+	ConfigKey: testPrecompileKey,
+	Address:   "0x0300000000000000000000000000000000000000",
+	Schema: json.RawMessage(`{
+		"type": "object",
+		"properties": {
+			"greeting": {"type": "string", "maxLength": 8},
+			"adminAddresses": {"type": "array", "items": {"type": "string"}},
+			"enabledAddresses": {"type": "array", "items": {"type": "string"}}
+		},
+		"required": ["greeting"],
+		"additionalProperties": false
+	}`),
+	Prompts: []models.PrecompilePrompt{
+		{Key: "greeting", Type: PrecompilePromptString},
+		{Type: PrecompilePromptAllowList, Optional: true},
+	},
+}
+
+func setupCustomPrecompileTest(t *testing.T) *require.Assertions {
+	require := setupTest(t)
+	require.NoError(SetCustomPrecompiles(map[string]models.PrecompileDescriptor{
+		testPrecompileKey: testPrecompileDescriptor,
+	}))
+	t.Cleanup(func() {
+		require.NoError(SetCustomPrecompiles(nil))
+	})
+	return require
+}
+
+func TestCustomPrecompileGenesis(t *testing.T) {
+	require := setupCustomPrecompileTest(t)
+
+	genesisBytes := []byte(`{
+		"config": {
+			"chainId": 888888,
+			"feeConfig": {"gasLimit": 8000000, "minBaseFee": 25000000000, "targetGas": 15000000,
+				"baseFeeChangeDenominator": 36, "minBlockGasCost": 0, "maxBlockGasCost": 1000000,
+				"targetBlockRate": 2, "blockGasCostStep": 200000},
+			"helloWorldConfig": {"blockTimestamp": 0, "greeting": "hello", "adminAddresses": ["0x098B69E43b1720Bd12378225519d74e5F3aD0eA5"]},
+			"warpConfig": {"blockTimestamp": 0, "quorumNumerator": 80}
+		},
+		"alloc": {},
+		"gasLimit": "0x7A1200",
+		"difficulty": "0x0"
+	}`)
+	require.NoError(VerifyGenesisPrecompiles(genesisBytes))
+
+	var genesis core.Genesis
+	require.NoError(json.Unmarshal(genesisBytes, &genesis))
+	warpConfig, ok := genesis.Config.GenesisPrecompiles[warp.ConfigKey].(*warp.Config)
+	require.True(ok)
+	require.Equal(uint64(80), warpConfig.QuorumNumerator)
+	customConfig, ok := genesis.Config.GenesisPrecompiles[testPrecompileKey].(*CustomPrecompileConfig)
+	require.True(ok)
+	require.Equal(testPrecompileKey, customConfig.Key())
+	require.Equal(uint64(0), *customConfig.Timestamp())
+	require.Equal("hello", customConfig.Params["greeting"])
+	allowList, ok := customConfig.AllowListConfig()
+	require.True(ok)
+	require.Equal(testAirdropAddress, allowList.AdminAddresses[0])
+
+	// the config survives a round trip through the genesis
+	marshalled, err := json.Marshal(&genesis)
+	require.NoError(err)
+	var roundTripped core.Genesis
+	require.NoError(json.Unmarshal(marshalled, &roundTripped))
+	require.True(customConfig.Equal(roundTripped.Config.GenesisPrecompiles[testPrecompileKey]))
+}
+
+func TestVerifyGenesisPrecompilesErrors(t *testing.T) {
+	require := setupCustomPrecompileTest(t)
+
+	tests := []struct {
+		name          string
+		config        string
+		expectedError string
+	}{
+		{
+			name:          "unknown precompile",
+			config:        `"fooConfig": {"blockTimestamp": 0}`,
+			expectedError: "unknown precompile fooConfig: register its descriptor with subnet precompile add",
+		},
+		{
+			name:          "missing param",
+			config:        `"helloWorldConfig": {"blockTimestamp": 0}`,
+			expectedError: "invalid helloWorldConfig: helloWorldConfig.greeting is required",
+		},
+		{
+			name:          "invalid param",
+			config:        `"helloWorldConfig": {"blockTimestamp": 0, "greeting": "good morning"}`,
+			expectedError: "invalid helloWorldConfig: helloWorldConfig.greeting must have at most 8 characters",
+		},
+		{
+			name:          "unknown param",
+			config:        `"helloWorldConfig": {"blockTimestamp": 0, "greeting": "hi", "farewell": "bye"}`,
+			expectedError: "invalid helloWorldConfig: helloWorldConfig.farewell is not allowed",
+		},
+		{
+			name:          "invalid warp quorum",
+			config:        `"warpConfig": {"blockTimestamp": 0, "quorumNumerator": 101}`,
+			expectedError: "invalid warpConfig: cannot specify quorum numerator (101) > quorum denominator (100)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesisBytes := []byte(`{"config": {"chainId": 888888, ` + tt.config + `}, "alloc": {}, "gasLimit": "0x7A1200", "difficulty": "0x0"}`)
+			require.EqualError(VerifyGenesisPrecompiles(genesisBytes), tt.expectedError)
+		})
+	}
+
+	// disabling a precompile needs no params
+	require.NoError(VerifyGenesisPrecompiles([]byte(`{"config": {"helloWorldConfig": {"blockTimestamp": 0, "disable": true}}, "alloc": {}, "gasLimit": "0x7A1200", "difficulty": "0x0"}`)))
+}
+
+func TestValidatePrecompileDescriptor(t *testing.T) {
+	require := setupCustomPrecompileTest(t)
+
+	tests := []struct {
+		name          string
+		update        func(*models.PrecompileDescriptor)
+		expectedError string
+	}{
+		{
+			name:   "valid",
+			update: func(*models.PrecompileDescriptor) {},
+		},
+		{
+			name:          "built-in name",
+			update:        func(d *models.PrecompileDescriptor) { d.Name = Warp },
+			expectedError: "name Warp Messaging is reserved for a built-in precompile",
+		},
+		{
+			name:          "built-in config key",
+			update:        func(d *models.PrecompileDescriptor) { d.ConfigKey = "txAllowListConfig" },
+			expectedError: "config key txAllowListConfig is used by a built-in precompile",
+		},
+		{
+			name: "built-in address",
+			update: func(d *models.PrecompileDescriptor) {
+				d.ConfigKey = "fooConfig"
+				d.Address = "0x0200000000000000000000000000000000000002"
+			},
+			expectedError: "address 0x0200000000000000000000000000000000000002 is used by precompile txAllowListConfig",
+		},
+		{
+			name:          "registered address",
+			update:        func(d *models.PrecompileDescriptor) { d.ConfigKey = "fooConfig" },
+			expectedError: "address 0x0300000000000000000000000000000000000000 is used by precompile helloWorldConfig",
+		},
+		{
+			name:          "address out of the reserved ranges",
+			update:        func(d *models.PrecompileDescriptor) { d.Address = testAirdropAddress.Hex() },
+			expectedError: "address 0x098B69E43b1720Bd12378225519d74e5F3aD0eA5 is not in a range reserved for precompiles",
+		},
+		{
+			name:          "invalid schema",
+			update:        func(d *models.PrecompileDescriptor) { d.Schema = json.RawMessage(`{"type": "text"}`) },
+			expectedError: `invalid schema: schema: unknown type "text"`,
+		},
+		{
+			name: "unknown prompt type",
+			update: func(d *models.PrecompileDescriptor) {
+				d.Prompts = []models.PrecompilePrompt{{Key: "greeting", Type: "int"}}
+			},
+			expectedError: `prompt 1: unknown type "int", expected one of [string bool uint64 bigint address addresses allowList]`,
+		},
+		{
+			name: "prompt of an upgrade param",
+			update: func(d *models.PrecompileDescriptor) {
+				d.Prompts = []models.PrecompilePrompt{{Key: "blockTimestamp", Type: PrecompilePromptUint64}}
+			},
+			expectedError: "prompt 1: blockTimestamp is set by the CLI",
+		},
+		{
+			name: "prompt of an unknown param",
+			update: func(d *models.PrecompileDescriptor) {
+				d.Prompts = []models.PrecompilePrompt{{Key: "farewell", Type: PrecompilePromptString}}
+			},
+			expectedError: "prompt 1: farewell is not a property of the schema",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			descriptor := testPrecompileDescriptor
+			tt.update(&descriptor)
+			err := ValidatePrecompileDescriptor(descriptor)
+			if tt.expectedError == "" {
+				require.NoError(err)
+			} else {
+				require.EqualError(err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestConfigureCustomPrecompile(t *testing.T) {
+	require := setupCustomPrecompileTest(t)
+	app := application.New()
+	mockPrompt := &mocks.Prompter{}
+	app.Prompt = mockPrompt
+
+	mockPrompt.On("CaptureString", "Enter greeting").Return("hello", nil).Once()
+	mockPrompt.On("CaptureNoYes", "Set the allow list?").Return(false, nil).Once()
+
+	config, cancelled, err := ConfigureCustomPrecompile(app, testPrecompileKey, 100)
+	require.NoError(err)
+	require.False(cancelled)
+	require.Equal(uint64(100), *config.Timestamp())
+	require.Equal(map[string]interface{}{"greeting": "hello"}, config.Params)
+	configBytes, err := json.Marshal(config)
+	require.NoError(err)
+	require.JSONEq(`{"blockTimestamp": 100, "greeting": "hello"}`, string(configBytes))
+
+	mockPrompt.On("CaptureString", "Enter greeting").Return("good morning", nil).Once()
+	mockPrompt.On("CaptureNoYes", "Set the allow list?").Return(false, nil).Once()
+	_, _, err = ConfigureCustomPrecompile(app, testPrecompileKey, 100)
+	require.EqualError(err, "invalid Hello World params: helloWorldConfig.greeting must have at most 8 characters")
+}
+
+func TestConfigureWarp(t *testing.T) {
+	require := setupTest(t)
+	app := application.New()
+	mockPrompt := &mocks.Prompter{}
+	app.Prompt = mockPrompt
+
+	mockPrompt.On("CaptureYesNo", mock.Anything).Return(true, nil).Once()
+	config, err := ConfigureWarp(app, 0)
+	require.NoError(err)
+	require.Equal(uint64(0), config.QuorumNumerator)
+
+	mockPrompt.On("CaptureYesNo", mock.Anything).Return(false, nil).Once()
+	mockPrompt.On("CaptureUint64Compare", warpQuorumPrompt, mock.Anything).Return(uint64(80), nil).Once()
+	config, err = ConfigureWarp(app, 0)
+	require.NoError(err)
+	require.Equal(uint64(80), config.QuorumNumerator)
+	require.NoError(config.Verify(nil))
+}
+
+func TestJSONSchemaValidate(t *testing.T) {
+	require := setupTest(t)
+
+	schema, err := parseJSONSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "pattern": "^[a-z]+$"},
+			"rate": {"type": "integer", "minimum": 1, "maximum": 100},
+			"mode": {"enum": ["fast", "slow"]},
+			"owners": {"type": "array", "minItems": 1, "items": {"type": "string"}},
+			"limit": {"type": ["integer", "null"]}
+		}
+	}`))
+	require.NoError(err)
+
+	tests := []struct {
+		value         string
+		expectedError string
+	}{
+		{value: `{"name": "abc", "rate": 10, "mode": "fast", "owners": ["a"], "limit": null}`},
+		{value: `{"name": "ABC"}`, expectedError: "config.name must match ^[a-z]+$"},
+		{value: `{"rate": 1.5}`, expectedError: "config.rate must be of type integer"},
+		{value: `{"rate": 0}`, expectedError: "config.rate must be at least 1"},
+		{value: `{"rate": 101}`, expectedError: "config.rate must be at most 100"},
+		{value: `{"mode": "medium"}`, expectedError: `config.mode must be one of "fast", "slow"`},
+		{value: `{"owners": []}`, expectedError: "config.owners must have at least 1 items"},
+		{value: `{"owners": [1]}`, expectedError: "config.owners[0] must be of type string"},
+		{value: `{"limit": "none"}`, expectedError: "config.limit must be of type integer or null"},
+		{value: `[]`, expectedError: "config must be of type object"},
+	}
+	for _, tt := range tests {
+		var value interface{}
+		decoder := json.NewDecoder(strings.NewReader(tt.value))
+		decoder.UseNumber()
+		require.NoError(decoder.Decode(&value))
+		err = schema.validate("config", value)
+		if tt.expectedError == "" {
+			require.NoError(err, tt.value)
+		} else {
+			require.EqualError(err, tt.expectedError, tt.value)
+		}
+	}
+}
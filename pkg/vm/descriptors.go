@@ -14,12 +14,19 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 )
 
+const (
+	chainIDPrompt       = "ChainId"
+	tokenSymbolPrompt   = "Token symbol"
+	vmVersionPrompt     = "What version of %s would you like?"
+	customVersionPrompt = "Pick the version for this VM"
+)
+
 func getChainID(app *application.Odyssey, subnetEVMChainID uint64) (*big.Int, error) {
 	if subnetEVMChainID != 0 {
 		return new(big.Int).SetUint64(subnetEVMChainID), nil
 	}
 	ux.Logger.PrintToUser("Enter your subnet's ChainId. It can be any positive integer.")
-	return app.Prompt.CapturePositiveBigInt("ChainId")
+	return app.Prompt.CapturePositiveBigInt(chainIDPrompt)
 }
 
 func getTokenName(app *application.Odyssey, subnetEVMTokenName string) (string, error) {
@@ -27,7 +34,7 @@ func getTokenName(app *application.Odyssey, subnetEVMTokenName string) (string,
 		return subnetEVMTokenName, nil
 	}
 	ux.Logger.PrintToUser("Select a symbol for your subnet's native token")
-	tokenName, err := app.Prompt.CaptureString("Token symbol")
+	tokenName, err := app.Prompt.CaptureString(tokenSymbolPrompt)
 	if err != nil {
 		return "", err
 	}
@@ -70,7 +77,7 @@ func askForVMVersion(
 		useLatest = "Use latest version"
 		useCustom = "Specify custom version"
 	)
-	defaultPrompt := fmt.Sprintf("What version of %s would you like?", vmName)
+	defaultPrompt := fmt.Sprintf(vmVersionPrompt, vmName)
 
 	versionOptions := []string{useLatest, useCustom}
 	if addGoBackOption {
@@ -103,7 +110,7 @@ func askForVMVersion(
 	if err != nil {
 		return "", statemachine.Stop, err
 	}
-	version, err := app.Prompt.CaptureList("Pick the version for this VM", versions)
+	version, err := app.Prompt.CaptureList(customVersionPrompt, versions)
 	if err != nil {
 		return "", statemachine.Stop, err
 	}
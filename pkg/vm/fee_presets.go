@@ -0,0 +1,201 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
+	"github.com/DioneProtocol/subnet-evm/commontype"
+	"github.com/DioneProtocol/subnet-evm/params"
+	"github.com/olekukonko/tablewriter"
+)
+
+const (
+	LowFeePreset    = "low"
+	MediumFeePreset = "medium"
+	HighFeePreset   = "high"
+)
+
+// typicalTransactions are the gas used by common transactions, shown by the fee preview.
+// Contract gas use varies with the contract, so these are approximations
+var typicalTransactions = []struct {
+	name string
+	gas  uint64
+}{
+	{"Native transfer", params.TxGas},
+	{"ERC-20 transfer", 65_000},
+	{"NFT mint", 120_000},
+	{"DEX swap", 180_000},
+	{"Contract deployment", 1_500_000},
+}
+
+// TransactionCost is the estimated cost of a typical transaction
+type TransactionCost struct {
+	Transaction string `json:"transaction" yaml:"transaction"`
+	Gas         uint64 `json:"gas" yaml:"gas"`
+	// Cost is the cost at the min base fee, in tokens
+	Cost string `json:"cost" yaml:"cost"`
+	// PerSecond is the number of transactions per second that use the target gas
+	PerSecond uint64 `json:"perSecond" yaml:"perSecond"`
+}
+
+// FeePreview estimates the economics of a fee config
+type FeePreview struct {
+	GasLimit           uint64 `json:"gasLimit" yaml:"gasLimit"`
+	TargetBlockRate    uint64 `json:"targetBlockRate" yaml:"targetBlockRate"`
+	TargetGasPerSecond uint64 `json:"targetGasPerSecond" yaml:"targetGasPerSecond"`
+	// MinBaseFee is given in gwei
+	MinBaseFee string `json:"minBaseFee" yaml:"minBaseFee"`
+	// MaxBaseFeeIncrease is the base fee increase every 10 seconds of full blocks, in percents
+	MaxBaseFeeIncrease string `json:"maxBaseFeeIncrease" yaml:"maxBaseFeeIncrease"`
+	// MinBlockFee is the fee paid by each block besides its transactions, in tokens
+	MinBlockFee  string            `json:"minBlockFee" yaml:"minBlockFee"`
+	Transactions []TransactionCost `json:"transactions" yaml:"transactions"`
+}
+
+// GetBuiltinFeePresets returns the fee configs of the throughput options of the create
+// wizard, keyed by preset name
+func GetBuiltinFeePresets() map[string]commontype.FeeConfig {
+	presets := map[string]commontype.FeeConfig{}
+	for name, targetGas := range map[string]*big.Int{
+		LowFeePreset:    slowTarget,
+		MediumFeePreset: mediumTarget,
+		HighFeePreset:   fastTarget,
+	} {
+		feeConfig := StarterFeeConfig
+		feeConfig.TargetGas = targetGas
+		presets[name] = feeConfig
+	}
+	return presets
+}
+
+// GetFeePreset returns the fee config of the built-in or saved preset [name]
+func GetFeePreset(app *application.Odyssey, name string) (commontype.FeeConfig, error) {
+	if feeConfig, ok := GetBuiltinFeePresets()[name]; ok {
+		return feeConfig, nil
+	}
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	if err != nil {
+		return commontype.FeeConfig{}, err
+	}
+	feeConfig, ok := feePresetsConfig.Presets[name]
+	if !ok {
+		return commontype.FeeConfig{}, fmt.Errorf("unknown fee preset %s: use one of %s", name, strings.Join(getFeePresetNames(feePresetsConfig.Presets), ", "))
+	}
+	return feeConfig, nil
+}
+
+// getFeePresetNames returns the names of the built-in presets, followed by the sorted
+// names of the saved [presets]
+func getFeePresetNames(presets map[string]commontype.FeeConfig) []string {
+	names := []string{LowFeePreset, MediumFeePreset, HighFeePreset}
+	savedNames := make([]string, 0, len(presets))
+	for name := range presets {
+		savedNames = append(savedNames, name)
+	}
+	sort.Strings(savedNames)
+	return append(names, savedNames...)
+}
+
+// LoadFeeConfigFile loads the fee config of the JSON file at [path]. All the fields of
+// the fee config are required
+func LoadFeeConfigFile(path string) (commontype.FeeConfig, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return commontype.FeeConfig{}, err
+	}
+	var feeConfig commontype.FeeConfig
+	decoder := json.NewDecoder(bytes.NewReader(fileBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&feeConfig); err != nil {
+		return commontype.FeeConfig{}, fmt.Errorf("invalid fee config file %s: %w", path, err)
+	}
+	if err := feeConfig.Verify(); err != nil {
+		return commontype.FeeConfig{}, fmt.Errorf("invalid fee config file %s: %w", path, err)
+	}
+	return feeConfig, nil
+}
+
+// PreviewFeeConfig estimates the cost of typical transactions at the min base fee of
+// [feeConfig], and how many of them fit in its target gas
+func PreviewFeeConfig(feeConfig commontype.FeeConfig) FeePreview {
+	targetGasPerSecond := new(big.Int).Div(feeConfig.TargetGas, new(big.Int).SetUint64(params.RollupWindow))
+	preview := FeePreview{
+		GasLimit:           feeConfig.GasLimit.Uint64(),
+		TargetBlockRate:    feeConfig.TargetBlockRate,
+		TargetGasPerSecond: targetGasPerSecond.Uint64(),
+		MinBaseFee:         formatUnits(feeConfig.MinBaseFee, 9),
+		MinBlockFee:        formatUnits(new(big.Int).Mul(feeConfig.MinBlockGasCost, feeConfig.MinBaseFee), tokenDecimals),
+		MaxBaseFeeIncrease: "0",
+	}
+	// the base fee rises when the gas used in a 10s window exceeds the target gas. The most
+	// gas that can be used is a full block every target block rate
+	fullGas := new(big.Int).Mul(feeConfig.GasLimit, new(big.Int).SetUint64(params.RollupWindow/feeConfig.TargetBlockRate))
+	if feeConfig.TargetBlockRate > params.RollupWindow {
+		fullGas = new(big.Int).Set(feeConfig.GasLimit)
+	}
+	if fullGas.Cmp(feeConfig.TargetGas) > 0 {
+		increase := new(big.Rat).SetFrac(
+			new(big.Int).Mul(new(big.Int).Sub(fullGas, feeConfig.TargetGas), big.NewInt(100)),
+			new(big.Int).Mul(feeConfig.TargetGas, feeConfig.BaseFeeChangeDenominator),
+		)
+		preview.MaxBaseFeeIncrease = trimDecimals(increase.FloatString(2))
+	}
+	for _, tx := range typicalTransactions {
+		gas := new(big.Int).SetUint64(tx.gas)
+		preview.Transactions = append(preview.Transactions, TransactionCost{
+			Transaction: tx.name,
+			Gas:         tx.gas,
+			Cost:        formatUnits(new(big.Int).Mul(gas, feeConfig.MinBaseFee), tokenDecimals),
+			PerSecond:   new(big.Int).Div(targetGasPerSecond, gas).Uint64(),
+		})
+	}
+	return preview
+}
+
+// PrintFeePreview prints [preview] for the user
+func PrintFeePreview(preview FeePreview) {
+	ux.Logger.PrintToUser("Block gas limit:        %d", preview.GasLimit)
+	ux.Logger.PrintToUser("Target block rate:      %ds", preview.TargetBlockRate)
+	ux.Logger.PrintToUser("Target gas per second:  %d", preview.TargetGasPerSecond)
+	ux.Logger.PrintToUser("Min base fee:           %s gwei", preview.MinBaseFee)
+	ux.Logger.PrintToUser("Base fee increase:      up to %s%% every 10s of full blocks", preview.MaxBaseFeeIncrease)
+	if preview.MinBlockFee != "0" {
+		ux.Logger.PrintToUser("Min block fee:          %s tokens", preview.MinBlockFee)
+	}
+	table := tablewriter.NewWriter(ux.Logger.Writer())
+	table.SetHeader([]string{"Transaction", "Gas", "Cost at Min Base Fee (tokens)", "Max per Second at Target Gas"})
+	table.SetRowLine(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, tx := range preview.Transactions {
+		table.Append([]string{
+			tx.Transaction,
+			fmt.Sprint(tx.Gas),
+			tx.Cost,
+			fmt.Sprint(tx.PerSecond),
+		})
+	}
+	table.Render()
+}
+
+// formatUnits formats [amount] with [decimals] decimals, without trailing zeros
+func formatUnits(amount *big.Int, decimals int) string {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	return trimDecimals(new(big.Rat).SetFrac(amount, unit).FloatString(decimals))
+}
+
+func trimDecimals(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-cli/internal/mocks"
+	"github.com/DioneProtocol/odyssey-cli/pkg/application"
+	"github.com/DioneProtocol/odyssey-cli/pkg/models"
+	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/subnet-evm/commontype"
+	"github.com/DioneProtocol/subnet-evm/params"
+	"github.com/stretchr/testify/mock"
+)
+
+const testFeeConfig = `{
+	"gasLimit": 15000000,
+	"targetBlockRate": 2,
+	"minBaseFee": 1000000000,
+	"targetGas": 30000000,
+	"baseFeeChangeDenominator": 48,
+	"minBlockGasCost": 0,
+	"maxBlockGasCost": 10000000,
+	"blockGasCostStep": 500000
+}`
+
+func TestPreviewFeeConfig(t *testing.T) {
+	require := setupTest(t)
+	preview := PreviewFeeConfig(StarterFeeConfig)
+	require.Equal(uint64(8_000_000), preview.GasLimit)
+	require.Equal(uint64(1_500_000), preview.TargetGasPerSecond)
+	require.Equal("25", preview.MinBaseFee)
+	// 40M gas of full blocks in 10s against a 15M target, with a denominator of 36
+	require.Equal("4.63", preview.MaxBaseFeeIncrease)
+	require.Equal("0", preview.MinBlockFee)
+	require.Len(preview.Transactions, len(typicalTransactions))
+	transfer := preview.Transactions[0]
+	require.Equal(params.TxGas, transfer.Gas)
+	require.Equal("0.000525", transfer.Cost)
+	require.Equal(uint64(71), transfer.PerSecond)
+}
+
+func TestLoadFeeConfigFile(t *testing.T) {
+	require := setupTest(t)
+	feeConfig, err := LoadFeeConfigFile(writeAirdropFile(t, "fee.json", testFeeConfig))
+	require.NoError(err)
+	require.Equal(big.NewInt(15_000_000), feeConfig.GasLimit)
+	require.Equal(big.NewInt(30_000_000), feeConfig.TargetGas)
+	require.Equal(uint64(2), feeConfig.TargetBlockRate)
+
+	path := writeAirdropFile(t, "missing.json", `{"gasLimit": 15000000, "targetBlockRate": 2}`)
+	_, err = LoadFeeConfigFile(path)
+	require.ErrorContains(err, "invalid fee config file "+path)
+
+	path = writeAirdropFile(t, "unknown.json", `{"gasLimit": 15000000, "gasPrice": 1}`)
+	_, err = LoadFeeConfigFile(path)
+	require.ErrorContains(err, `unknown field "gasPrice"`)
+}
+
+func TestGetFeePreset(t *testing.T) {
+	require := setupTest(t)
+	app := application.New()
+	app.Setup(t.TempDir(), logging.NoLog{}, nil, nil, nil)
+
+	feeConfig, err := GetFeePreset(app, HighFeePreset)
+	require.NoError(err)
+	require.Equal(fastTarget, feeConfig.TargetGas)
+
+	saved, err := LoadFeeConfigFile(writeAirdropFile(t, "fee.json", testFeeConfig))
+	require.NoError(err)
+	require.NoError(app.WriteFeePresetsConfig(models.FeePresetsConfig{
+		Presets: map[string]commontype.FeeConfig{"gaming": saved},
+	}))
+	feeConfig, err = GetFeePreset(app, "gaming")
+	require.NoError(err)
+	require.Equal(saved, feeConfig)
+
+	_, err = GetFeePreset(app, "defi")
+	require.EqualError(err, "unknown fee preset defi: use one of low, medium, high, gaming")
+}
+
+func TestGetFeeConfigSavedPreset(t *testing.T) {
+	require := setupTest(t)
+	app := application.New()
+	mockPrompt := &mocks.Prompter{}
+	app.Setup(t.TempDir(), logging.NoLog{}, nil, mockPrompt, nil)
+
+	saved, err := LoadFeeConfigFile(writeAirdropFile(t, "fee.json", testFeeConfig))
+	require.NoError(err)
+	require.NoError(app.WriteFeePresetsConfig(models.FeePresetsConfig{
+		Presets: map[string]commontype.FeeConfig{"gaming": saved},
+	}))
+
+	mockPrompt.On("CaptureList", feeConfigPrompt, mock.MatchedBy(func(options []string) bool {
+		for _, option := range options {
+			if option == savedFeePresetPrefix+"gaming" {
+				return true
+			}
+		}
+		return false
+	})).Return(savedFeePresetPrefix+"gaming", nil).Once()
+
+	config, direction, err := GetFeeConfig(params.ChainConfig{}, app, false)
+	require.NoError(err)
+	require.Equal(statemachine.Forward, direction)
+	require.Equal(saved, config.FeeConfig)
+	mockPrompt.AssertExpectations(t)
+}
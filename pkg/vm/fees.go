@@ -4,6 +4,10 @@
 package vm
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/DioneProtocol/odyssey-cli/pkg/application"
 	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
 	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
@@ -11,6 +15,21 @@ import (
 	"github.com/DioneProtocol/subnet-evm/params"
 )
 
+const (
+	feeConfigPrompt = "How would you like to set fees"
+
+	setGasLimit                 = "Set gas limit"
+	setBlockRate                = "Set target block rate"
+	setMinBaseFee               = "Set min base fee"
+	setTargetGas                = "Set target gas"
+	setBaseFeeChangeDenominator = "Set base fee change denominator"
+	setMinBlockGas              = "Set min block gas cost"
+	setMaxBlockGas              = "Set max block gas cost"
+	setGasStep                  = "Set block gas cost step"
+
+	savedFeePresetPrefix = "Saved preset: "
+)
+
 func GetFeeConfig(config params.ChainConfig, app *application.Odyssey, useDefault bool) (
 	params.ChainConfig,
 	statemachine.StateDirection,
@@ -21,15 +40,6 @@ func GetFeeConfig(config params.ChainConfig, app *application.Odyssey, useDefaul
 		useMedium = "Medium disk use / Medium Throughput 2 mil   gas/s"
 		useSlow   = "Low disk use    / Low Throughput    1.5 mil gas/s (D-Chain's setting)"
 		customFee = "Customize fee config"
-
-		setGasLimit                 = "Set gas limit"
-		setBlockRate                = "Set target block rate"
-		setMinBaseFee               = "Set min base fee"
-		setTargetGas                = "Set target gas"
-		setBaseFeeChangeDenominator = "Set base fee change denominator"
-		setMinBlockGas              = "Set min block gas cost"
-		setMaxBlockGas              = "Set max block gas cost"
-		setGasStep                  = "Set block gas cost step"
 	)
 
 	config.FeeConfig = StarterFeeConfig
@@ -39,10 +49,24 @@ func GetFeeConfig(config params.ChainConfig, app *application.Odyssey, useDefaul
 		return config, statemachine.Forward, nil
 	}
 
-	feeConfigOptions := []string{useSlow, useMedium, useFast, customFee, goBackMsg}
+	feePresetsConfig, err := app.LoadFeePresetsConfig()
+	if err != nil {
+		return config, statemachine.Stop, err
+	}
+	savedPresetNames := make([]string, 0, len(feePresetsConfig.Presets))
+	for name := range feePresetsConfig.Presets {
+		savedPresetNames = append(savedPresetNames, name)
+	}
+	sort.Strings(savedPresetNames)
+
+	feeConfigOptions := []string{useSlow, useMedium, useFast}
+	for _, name := range savedPresetNames {
+		feeConfigOptions = append(feeConfigOptions, savedFeePresetPrefix+name)
+	}
+	feeConfigOptions = append(feeConfigOptions, customFee, goBackMsg)
 
 	feeDefault, err := app.Prompt.CaptureList(
-		"How would you like to set fees",
+		feeConfigPrompt,
 		feeConfigOptions,
 	)
 	if err != nil {
@@ -61,8 +85,15 @@ func GetFeeConfig(config params.ChainConfig, app *application.Odyssey, useDefaul
 		return config, statemachine.Forward, nil
 	case goBackMsg:
 		return config, statemachine.Backward, nil
-	default:
+	case customFee:
 		ux.Logger.PrintToUser("Customizing fee config")
+	default:
+		feeConfig, ok := feePresetsConfig.Presets[strings.TrimPrefix(feeDefault, savedFeePresetPrefix)]
+		if !ok {
+			return config, statemachine.Stop, fmt.Errorf("unexpected fee config option %q", feeDefault)
+		}
+		config.FeeConfig = feeConfig
+		return config, statemachine.Forward, nil
 	}
 
 	gasLimit, err := app.Prompt.CapturePositiveBigInt(setGasLimit)
@@ -0,0 +1,123 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	GenesisAdded   = "added"
+	GenesisRemoved = "removed"
+	GenesisChanged = "changed"
+)
+
+// GenesisChange is a difference between two genesis, at the JSON path Path
+type GenesisChange struct {
+	Path   string `json:"path" yaml:"path"`
+	Change string `json:"change" yaml:"change"`
+	Old    string `json:"old,omitempty" yaml:"old,omitempty"`
+	New    string `json:"new,omitempty" yaml:"new,omitempty"`
+}
+
+// DiffGenesis returns the changes from genesis [a] to genesis [b], sorted by path.
+// The genesis are compared by value, so key order, number formats and address cases
+// are not changes. Lists of values, as the allow list addresses, are compared as sets
+func DiffGenesis(a *core.Genesis, b *core.Genesis) ([]GenesisChange, error) {
+	aValues, err := flattenGenesis(a)
+	if err != nil {
+		return nil, err
+	}
+	bValues, err := flattenGenesis(b)
+	if err != nil {
+		return nil, err
+	}
+	changes := []GenesisChange{}
+	for path, aValue := range aValues {
+		bValue, ok := bValues[path]
+		switch {
+		case !ok:
+			changes = append(changes, GenesisChange{Path: path, Change: GenesisRemoved, Old: aValue})
+		case aValue != bValue:
+			changes = append(changes, GenesisChange{Path: path, Change: GenesisChanged, Old: aValue, New: bValue})
+		}
+	}
+	for path, bValue := range bValues {
+		if _, ok := aValues[path]; !ok {
+			changes = append(changes, GenesisChange{Path: path, Change: GenesisAdded, New: bValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+// flattenGenesis maps the JSON paths of the values of [genesis] to them
+func flattenGenesis(genesis *core.Genesis) (map[string]string, error) {
+	genesisBytes, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	decoder := json.NewDecoder(bytes.NewReader(genesisBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	flattenJSON("", "", tree, values)
+	return values, nil
+}
+
+func flattenJSON(path string, key string, node interface{}, values map[string]string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for childKey, child := range n {
+			flattenJSON(joinJSONPath(path, childKey), childKey, child, values)
+		}
+	case []interface{}:
+		for i, child := range n {
+			switch child.(type) {
+			case map[string]interface{}, []interface{}:
+				flattenJSON(fmt.Sprintf("%s[%d]", path, i), key, child, values)
+			default:
+				// lists of values are sets, as the allow list addresses
+				values[fmt.Sprintf("%s[%s]", path, formatJSONValue(key, child))] = "present"
+			}
+		}
+	case nil:
+	default:
+		values[path] = formatJSONValue(key, n)
+	}
+}
+
+func joinJSONPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// formatJSONValue formats the value of [key] for display. Balances are shown as
+// decimals, and code by its size and hash
+func formatJSONValue(key string, value interface{}) string {
+	s := fmt.Sprint(value)
+	switch key {
+	case "code":
+		if code, err := hexutil.Decode(s); err == nil {
+			return fmt.Sprintf("%d bytes, hash %s", len(code), crypto.Keccak256Hash(code).Hex())
+		}
+	case "balance":
+		if balance, err := hexutil.DecodeBig(s); err == nil {
+			return balance.String()
+		}
+	}
+	return s
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/DioneProtocol/subnet-evm/precompile/allowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/deployerallowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/feemanager"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/nativeminter"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/rewardmanager"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/txallowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/precompileconfig"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+
+	lintRuleGenesis     = "genesis"
+	lintRuleChainID     = "chain-id"
+	lintRuleFeeConfig   = "fee-config"
+	lintRuleAllocation  = "allocation"
+	lintRuleAllowList   = "allow-list"
+	lintRuleMemberFunds = "member-balance"
+	lintRulePrecompiles = "precompiles"
+)
+
+// KnownChainIDs are the EVM chain IDs of public chains that Subnet-EVM chains should not reuse
+var KnownChainIDs = map[uint64]string{
+	1:        "Ethereum Mainnet",
+	5:        "Ethereum Goerli",
+	56:       "BNB Smart Chain",
+	137:      "Polygon",
+	43112:    "Dione local D-Chain",
+	43113:    "Avalanche Fuji C-Chain",
+	43114:    "Avalanche C-Chain",
+	131313:   "Dione Testnet D-Chain",
+	153153:   "Dione Mainnet D-Chain",
+	11155111: "Ethereum Sepolia",
+}
+
+// LintFinding is an issue found in a genesis by LintGenesis
+type LintFinding struct {
+	Severity string `json:"severity" yaml:"severity"`
+	Rule     string `json:"rule" yaml:"rule"`
+	Message  string `json:"message" yaml:"message"`
+}
+
+type genesisLinter struct {
+	genesis  *core.Genesis
+	findings []LintFinding
+	// balances are the genesis balances, including the initial mint of the native minter
+	balances map[common.Address]*big.Int
+}
+
+// LintGenesis checks a Subnet-EVM genesis for mistakes that make the chain unusable
+// or unsafe. [knownChainIDs] maps the chain IDs that the genesis must not reuse to
+// the name of their chain. Findings are sorted with errors first
+func LintGenesis(genesis *core.Genesis, knownChainIDs map[uint64]string) []LintFinding {
+	l := &genesisLinter{genesis: genesis}
+	if genesis.Config == nil {
+		l.addError(lintRuleGenesis, "the genesis has no chain config: it is not a Subnet-EVM genesis")
+		return l.findings
+	}
+	l.lintChainID(knownChainIDs)
+	if l.lintFeeConfig() {
+		if err := genesis.Verify(); err != nil {
+			l.addError(lintRuleGenesis, err.Error())
+		}
+	}
+	l.lintAllocation()
+	l.lintAllowLists()
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return l.findings[i].Severity == LintError && l.findings[j].Severity != LintError
+	})
+	return l.findings
+}
+
+func (l *genesisLinter) addError(rule string, format string, args ...interface{}) {
+	l.findings = append(l.findings, LintFinding{Severity: LintError, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *genesisLinter) addWarning(rule string, format string, args ...interface{}) {
+	l.findings = append(l.findings, LintFinding{Severity: LintWarning, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *genesisLinter) lintChainID(knownChainIDs map[uint64]string) {
+	chainID := l.genesis.Config.ChainID
+	if chainID == nil || chainID.Sign() <= 0 {
+		l.addError(lintRuleChainID, "the chain ID must be a positive integer")
+		return
+	}
+	if !chainID.IsUint64() {
+		return
+	}
+	if chainName, ok := knownChainIDs[chainID.Uint64()]; ok {
+		l.addError(lintRuleChainID, "chain ID %s is already used by %s: wallets can't tell the chains apart", chainID, chainName)
+	}
+}
+
+// lintFeeConfig returns false if the fee config is invalid, and the genesis can't be verified
+func (l *genesisLinter) lintFeeConfig() bool {
+	feeConfig := l.genesis.Config.FeeConfig
+	if err := feeConfig.Verify(); err != nil {
+		l.addError(lintRuleFeeConfig, err.Error())
+		return false
+	}
+	if feeConfig.TargetGas.Cmp(feeConfig.GasLimit) < 0 {
+		l.addWarning(lintRuleFeeConfig,
+			"target gas %s is lower than the block gas limit %s: a single full block exceeds the target and raises the base fee",
+			feeConfig.TargetGas, feeConfig.GasLimit)
+	}
+	if feeConfig.MinBaseFee.Sign() == 0 {
+		l.addWarning(lintRuleFeeConfig, "the min base fee is 0: the chain can be spammed with free transactions")
+	}
+	return true
+}
+
+func (l *genesisLinter) lintAllocation() {
+	l.balances = map[common.Address]*big.Int{}
+	total := big.NewInt(0)
+	for address, account := range l.genesis.Alloc {
+		if account.Balance == nil || account.Balance.Sign() == 0 {
+			continue
+		}
+		l.addBalance(address, account.Balance)
+		total.Add(total, account.Balance)
+	}
+	if minterConfig, ok := l.genesis.Config.GenesisPrecompiles[nativeminter.ConfigKey].(*nativeminter.Config); ok {
+		for address, amount := range minterConfig.InitialMint {
+			if amount == nil {
+				continue
+			}
+			l.addBalance(address, (*big.Int)(amount))
+			total.Add(total, (*big.Int)(amount))
+		}
+	}
+	if _, ok := l.genesis.Alloc[PrefundedEwoqAddress]; ok {
+		l.addWarning(lintRuleAllocation, "the default address %s is funded: its private key is public, do not use it in production", PrefundedEwoqAddress.Hex())
+	}
+	switch {
+	case total.Sign() == 0 && l.genesis.Config.FeeConfig.MinBaseFee != nil && l.genesis.Config.FeeConfig.MinBaseFee.Sign() > 0:
+		l.addError(lintRuleAllocation, "no address has a balance: no transaction can pay fees")
+	case total.Cmp(maxGenesisBalance) > 0:
+		l.addError(lintRuleAllocation, "the total supply %s exceeds the maximum supply", total)
+	}
+}
+
+func (l *genesisLinter) addBalance(address common.Address, amount *big.Int) {
+	if l.balances[address] == nil {
+		l.balances[address] = big.NewInt(0)
+	}
+	l.balances[address].Add(l.balances[address], amount)
+}
+
+func (l *genesisLinter) hasBalance(address common.Address) bool {
+	return l.balances[address] != nil && l.balances[address].Sign() > 0
+}
+
+func (l *genesisLinter) lintAllowLists() {
+	precompiles := l.genesis.Config.GenesisPrecompiles
+	keys := make([]string, 0, len(precompiles))
+	for key := range precompiles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var txAllowed map[common.Address]bool
+	if txConfig, ok := precompiles[txallowlist.ConfigKey].(*txallowlist.Config); ok {
+		txAllowed = allowListMembers(txConfig.AllowListConfig)
+		l.lintTxAllowList(txConfig.AllowListConfig, txAllowed)
+	}
+	for _, key := range keys {
+		allowList, ok := getAllowListConfig(precompiles[key])
+		if !ok {
+			continue
+		}
+		if key != txallowlist.ConfigKey && len(allowListMembers(allowList)) == 0 {
+			l.addWarning(lintRulePrecompiles, "%s is enabled but no address can use it", key)
+		}
+		// admins, managers and enabled addresses all need to transact to use the precompile
+		for _, members := range []struct {
+			role      string
+			addresses []common.Address
+		}{
+			{"admin", allowList.AdminAddresses},
+			{"manager", allowList.ManagerAddresses},
+			{"enabled address", allowList.EnabledAddresses},
+		} {
+			for _, address := range members.addresses {
+				if !l.hasBalance(address) {
+					l.addWarning(lintRuleMemberFunds, "%s %s %s has no balance to pay for its transactions", key, members.role, address.Hex())
+				}
+				if txAllowed != nil && key != txallowlist.ConfigKey && !txAllowed[address] {
+					l.addWarning(lintRuleAllowList, "%s %s %s is not allowed to transact by %s", key, members.role, address.Hex(), txallowlist.ConfigKey)
+				}
+			}
+		}
+	}
+}
+
+// lintTxAllowList checks that some address can transact, and change the allow list
+func (l *genesisLinter) lintTxAllowList(allowList allowlist.AllowListConfig, txAllowed map[common.Address]bool) {
+	if len(txAllowed) == 0 {
+		l.addError(lintRuleAllowList, "%s has no addresses: no address can transact", txallowlist.ConfigKey)
+		return
+	}
+	funded := false
+	for address := range txAllowed {
+		if l.hasBalance(address) {
+			funded = true
+			break
+		}
+	}
+	if !funded {
+		l.addError(lintRuleAllowList, "none of the addresses of %s has a balance: no address can transact", txallowlist.ConfigKey)
+	}
+	if len(allowList.AdminAddresses) == 0 && len(allowList.ManagerAddresses) == 0 {
+		l.addWarning(lintRuleAllowList, "%s has no admins or managers: the addresses allowed to transact can never change", txallowlist.ConfigKey)
+	}
+}
+
+func allowListMembers(allowList allowlist.AllowListConfig) map[common.Address]bool {
+	members := map[common.Address]bool{}
+	for _, addresses := range [][]common.Address{allowList.AdminAddresses, allowList.ManagerAddresses, allowList.EnabledAddresses} {
+		for _, address := range addresses {
+			members[address] = true
+		}
+	}
+	return members
+}
+
+func getAllowListConfig(config precompileconfig.Config) (allowlist.AllowListConfig, bool) {
+	switch c := config.(type) {
+	case *txallowlist.Config:
+		return c.AllowListConfig, true
+	case *deployerallowlist.Config:
+		return c.AllowListConfig, true
+	case *nativeminter.Config:
+		return c.AllowListConfig, true
+	case *feemanager.Config:
+		return c.AllowListConfig, true
+	case *rewardmanager.Config:
+		return c.AllowListConfig, true
+	case *CustomPrecompileConfig:
+		return c.AllowListConfig()
+	}
+	return allowlist.AllowListConfig{}, false
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/DioneProtocol/subnet-evm/core"
+	"github.com/DioneProtocol/subnet-evm/params"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/deployerallowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/contracts/txallowlist"
+	"github.com/DioneProtocol/subnet-evm/precompile/precompileconfig"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var testAdminAddress = common.HexToAddress("0x5Aa01B3b5877255cE50cc55e8986a7a5fe29C70e")
+
+// newTestGenesis returns a valid genesis funding testAirdropAddress
+func newTestGenesis() *core.Genesis {
+	config := *params.SubnetEVMDefaultChainConfig
+	config.ChainID = big.NewInt(888888)
+	config.FeeConfig = StarterFeeConfig
+	config.GenesisPrecompiles = params.Precompiles{}
+	return &core.Genesis{
+		Config:     &config,
+		Difficulty: Difficulty,
+		GasLimit:   StarterFeeConfig.GasLimit.Uint64(),
+		Alloc: core.GenesisAlloc{
+			testAirdropAddress: {Balance: new(big.Int).Set(oneDione)},
+		},
+	}
+}
+
+func requireFinding(t *testing.T, findings []LintFinding, severity string, rule string, message string) {
+	for _, finding := range findings {
+		if finding.Severity == severity && finding.Rule == rule && finding.Message == message {
+			return
+		}
+	}
+	require.Fail(t, "finding not found", "%s %s %q not in %v", severity, rule, message, findings)
+}
+
+func TestLintGenesisValid(t *testing.T) {
+	require := require.New(t)
+	require.Empty(LintGenesis(newTestGenesis(), KnownChainIDs))
+}
+
+func TestLintGenesisChainID(t *testing.T) {
+	genesis := newTestGenesis()
+	genesis.Config.ChainID = big.NewInt(131313)
+	findings := LintGenesis(genesis, KnownChainIDs)
+	requireFinding(t, findings, LintError, lintRuleChainID, "chain ID 131313 is already used by Dione Testnet D-Chain: wallets can't tell the chains apart")
+
+	genesis.Config.ChainID = big.NewInt(0)
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintError, lintRuleChainID, "the chain ID must be a positive integer")
+}
+
+func TestLintGenesisFeeConfig(t *testing.T) {
+	require := require.New(t)
+	genesis := newTestGenesis()
+	genesis.Config.FeeConfig.TargetGas = big.NewInt(1_000_000)
+	genesis.Config.FeeConfig.MinBaseFee = big.NewInt(0)
+	findings := LintGenesis(genesis, KnownChainIDs)
+	requireFinding(t, findings, LintWarning, lintRuleFeeConfig,
+		"target gas 1000000 is lower than the block gas limit 8000000: a single full block exceeds the target and raises the base fee")
+	requireFinding(t, findings, LintWarning, lintRuleFeeConfig, "the min base fee is 0: the chain can be spammed with free transactions")
+
+	genesis = newTestGenesis()
+	genesis.GasLimit = 1
+	findings = LintGenesis(genesis, KnownChainIDs)
+	require.Len(findings, 1)
+	require.Equal(LintError, findings[0].Severity)
+	require.Equal(lintRuleGenesis, findings[0].Rule)
+
+	genesis = newTestGenesis()
+	genesis.Config.FeeConfig.GasLimit = nil
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintError, lintRuleFeeConfig, "gasLimit cannot be nil")
+}
+
+func TestLintGenesisAllocation(t *testing.T) {
+	genesis := newTestGenesis()
+	genesis.Alloc = core.GenesisAlloc{PrefundedEwoqAddress: {Balance: oneDione}}
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintWarning, lintRuleAllocation,
+		"the default address 0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC is funded: its private key is public, do not use it in production")
+
+	genesis.Alloc = core.GenesisAlloc{}
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintError, lintRuleAllocation, "no address has a balance: no transaction can pay fees")
+}
+
+func TestLintGenesisTxAllowListLockout(t *testing.T) {
+	require := require.New(t)
+	zero := uint64(0)
+	genesis := newTestGenesis()
+	genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, []common.Address{testAdminAddress}, nil, nil)
+	findings := LintGenesis(genesis, KnownChainIDs)
+	requireFinding(t, findings, LintError, lintRuleAllowList, "none of the addresses of txAllowListConfig has a balance: no address can transact")
+	requireFinding(t, findings, LintWarning, lintRuleMemberFunds,
+		"txAllowListConfig admin "+testAdminAddress.Hex()+" has no balance to pay for its transactions")
+	// errors are listed first
+	require.Equal(LintError, findings[0].Severity)
+
+	genesis.Alloc[testAdminAddress] = core.GenesisAccount{Balance: oneDione}
+	require.Empty(LintGenesis(genesis, KnownChainIDs))
+
+	genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, nil, []common.Address{testAdminAddress}, nil)
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintWarning, lintRuleAllowList,
+		"txAllowListConfig has no admins or managers: the addresses allowed to transact can never change")
+
+	genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, nil, nil, nil)
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintError, lintRuleAllowList, "txAllowListConfig has no addresses: no address can transact")
+}
+
+func TestLintGenesisPrecompileAdmins(t *testing.T) {
+	zero := uint64(0)
+	genesis := newTestGenesis()
+	genesis.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, []common.Address{testAirdropAddress}, nil, nil)
+	genesis.Config.GenesisPrecompiles[deployerallowlist.ConfigKey] = deployerallowlist.NewConfig(&zero, []common.Address{testAdminAddress}, nil, nil)
+	findings := LintGenesis(genesis, KnownChainIDs)
+	requireFinding(t, findings, LintWarning, lintRuleAllowList,
+		"contractDeployerAllowListConfig admin "+testAdminAddress.Hex()+" is not allowed to transact by txAllowListConfig")
+	requireFinding(t, findings, LintWarning, lintRuleMemberFunds,
+		"contractDeployerAllowListConfig admin "+testAdminAddress.Hex()+" has no balance to pay for its transactions")
+
+	genesis.Config.GenesisPrecompiles[deployerallowlist.ConfigKey] = deployerallowlist.NewConfig(&zero, nil, []common.Address{testAdminAddress}, nil)
+	findings = LintGenesis(genesis, KnownChainIDs)
+	requireFinding(t, findings, LintWarning, lintRuleAllowList,
+		"contractDeployerAllowListConfig enabled address "+testAdminAddress.Hex()+" is not allowed to transact by txAllowListConfig")
+	requireFinding(t, findings, LintWarning, lintRuleMemberFunds,
+		"contractDeployerAllowListConfig enabled address "+testAdminAddress.Hex()+" has no balance to pay for its transactions")
+
+	genesis.Config.GenesisPrecompiles[deployerallowlist.ConfigKey] = &deployerallowlist.Config{Upgrade: precompileconfig.Upgrade{BlockTimestamp: &zero}}
+	requireFinding(t, LintGenesis(genesis, KnownChainIDs), LintWarning, lintRulePrecompiles, "contractDeployerAllowListConfig is enabled but no address can use it")
+}
+
+func TestDiffGenesis(t *testing.T) {
+	require := require.New(t)
+	zero := uint64(0)
+	a := newTestGenesis()
+	a.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, []common.Address{testAirdropAddress}, nil, nil)
+
+	changes, err := DiffGenesis(a, a)
+	require.NoError(err)
+	require.Empty(changes)
+
+	b := newTestGenesis()
+	b.Config.ChainID = big.NewInt(999999)
+	b.Config.GenesisPrecompiles[txallowlist.ConfigKey] = txallowlist.NewConfig(&zero, []common.Address{testAdminAddress}, nil, nil)
+	b.Alloc[testAirdropAddress] = core.GenesisAccount{Balance: big.NewInt(5)}
+	b.Alloc[testContractAddress] = core.GenesisAccount{Balance: big.NewInt(0), Code: []byte{0x60, 0x80}}
+
+	changes, err = DiffGenesis(a, b)
+	require.NoError(err)
+	require.Equal([]GenesisChange{
+		{Path: "alloc.0200000000000000000000000000000000000001.balance", Change: GenesisAdded, New: "0"},
+		{Path: "alloc.0200000000000000000000000000000000000001.code", Change: GenesisAdded, New: "2 bytes, hash " + crypto.Keccak256Hash([]byte{0x60, 0x80}).Hex()},
+		{Path: "alloc.098b69e43b1720bd12378225519d74e5f3ad0ea5.balance", Change: GenesisChanged, Old: "1000000000000000000", New: "5"},
+		{Path: "config.chainId", Change: GenesisChanged, Old: "888888", New: "999999"},
+		{Path: "config.txAllowListConfig.adminAddresses[0x098b69e43b1720bd12378225519d74e5f3ad0ea5]", Change: GenesisRemoved, Old: "present"},
+		{Path: "config.txAllowListConfig.adminAddresses[0x5aa01b3b5877255ce50cc55e8986a7a5fe29c70e]", Change: GenesisAdded, New: "present"},
+	}, changes)
+}
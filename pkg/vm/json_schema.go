@@ -0,0 +1,249 @@
+// Copyright (C) 2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	schemaObject  = "object"
+	schemaArray   = "array"
+	schemaString  = "string"
+	schemaNumber  = "number"
+	schemaInteger = "integer"
+	schemaBoolean = "boolean"
+	schemaNull    = "null"
+)
+
+// jsonSchema is the subset of JSON schema used to describe precompile params: the
+// type, properties, required, additionalProperties, items, enum, minimum, maximum,
+// minLength, maxLength, pattern, minItems and maxItems keywords. Other keywords are ignored
+type jsonSchema struct {
+	Type                 schemaTypes            `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Enum                 []json.RawMessage      `json:"enum,omitempty"`
+	Minimum              *json.Number           `json:"minimum,omitempty"`
+	Maximum              *json.Number           `json:"maximum,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// schemaTypes is the type keyword, given as a type name or a list of them
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = schemaTypes{name}
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return fmt.Errorf("type must be a type name or a list of them")
+	}
+	*t = names
+	return nil
+}
+
+// parseJSONSchema parses and checks the schema [schemaBytes]
+func parseJSONSchema(schemaBytes []byte) (*jsonSchema, error) {
+	var schema jsonSchema
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		return nil, err
+	}
+	if err := schema.compile("schema"); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func (s *jsonSchema) compile(path string) error {
+	for _, t := range s.Type {
+		switch t {
+		case schemaObject, schemaArray, schemaString, schemaNumber, schemaInteger, schemaBoolean, schemaNull:
+		default:
+			return fmt.Errorf("%s: unknown type %q", path, t)
+		}
+	}
+	for _, bound := range []*json.Number{s.Minimum, s.Maximum} {
+		if bound == nil {
+			continue
+		}
+		if _, ok := new(big.Float).SetString(bound.String()); !ok {
+			return fmt.Errorf("%s: invalid bound %s", path, bound)
+		}
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern: %w", path, err)
+		}
+		s.pattern = pattern
+	}
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("%s.%s: missing schema", path, name)
+		}
+		if err := property.compile(path + "." + name); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile(path + "[]")
+	}
+	return nil
+}
+
+// validate checks that [value], decoded with json.Decoder.UseNumber, matches the schema
+func (s *jsonSchema) validate(path string, value interface{}) error {
+	if len(s.Type) > 0 && !s.matchesType(value) {
+		return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, " or "))
+	}
+	if len(s.Enum) > 0 {
+		if err := s.validateEnum(path, value); err != nil {
+			return err
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return s.validateObject(path, v)
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			return fmt.Errorf("%s must have at least %d items", path, *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			return fmt.Errorf("%s must have at most %d items", path, *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := len([]rune(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Errorf("%s must have at least %d characters", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Errorf("%s must have at most %d characters", path, *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fmt.Errorf("%s must match %s", path, s.Pattern)
+		}
+	case json.Number:
+		n, _ := new(big.Float).SetString(v.String())
+		if s.Minimum != nil {
+			minimum, _ := new(big.Float).SetString(s.Minimum.String())
+			if n.Cmp(minimum) < 0 {
+				return fmt.Errorf("%s must be at least %s", path, s.Minimum)
+			}
+		}
+		if s.Maximum != nil {
+			maximum, _ := new(big.Float).SetString(s.Maximum.String())
+			if n.Cmp(maximum) > 0 {
+				return fmt.Errorf("%s must be at most %s", path, s.Maximum)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) validateObject(path string, object map[string]interface{}) error {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			return fmt.Errorf("%s is required", joinJSONPath(path, name))
+		}
+	}
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s is not allowed", joinJSONPath(path, name))
+			}
+			continue
+		}
+		if err := property.validate(joinJSONPath(path, name), object[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) validateEnum(path string, value interface{}) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	options := make([]string, 0, len(s.Enum))
+	for _, option := range s.Enum {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, option); err != nil {
+			return err
+		}
+		if bytes.Equal(compacted.Bytes(), valueBytes) {
+			return nil
+		}
+		options = append(options, compacted.String())
+	}
+	return fmt.Errorf("%s must be one of %s", path, strings.Join(options, ", "))
+}
+
+func (s *jsonSchema) matchesType(value interface{}) bool {
+	for _, t := range s.Type {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if t == schemaObject {
+				return true
+			}
+		case []interface{}:
+			if t == schemaArray {
+				return true
+			}
+		case string:
+			if t == schemaString {
+				return true
+			}
+		case bool:
+			if t == schemaBoolean {
+				return true
+			}
+		case nil:
+			if t == schemaNull {
+				return true
+			}
+		case json.Number:
+			if t == schemaNumber {
+				return true
+			}
+			if t == schemaInteger {
+				if _, ok := new(big.Int).SetString(v.String(), 10); ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
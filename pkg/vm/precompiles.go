@@ -10,6 +10,7 @@ import (
 	"github.com/DioneProtocol/odyssey-cli/pkg/application"
 	"github.com/DioneProtocol/odyssey-cli/pkg/prompts"
 	"github.com/DioneProtocol/odyssey-cli/pkg/statemachine"
+	"github.com/DioneProtocol/odyssey-cli/pkg/ux"
 	"github.com/DioneProtocol/subnet-evm/params"
 	"github.com/DioneProtocol/subnet-evm/precompile/allowlist"
 	"github.com/DioneProtocol/subnet-evm/precompile/contracts/deployerallowlist"
@@ -19,6 +20,7 @@ import (
 	"github.com/DioneProtocol/subnet-evm/precompile/contracts/txallowlist"
 	"github.com/DioneProtocol/subnet-evm/precompile/precompileconfig"
 	"github.com/DioneProtocol/subnet-evm/utils"
+	"github.com/DioneProtocol/subnet-evm/x/warp"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -30,6 +32,31 @@ const (
 	TxAllowList       = "Transaction Allow List"
 	FeeManager        = "Manage Fee Settings"
 	RewardManager     = "RewardManagerConfig"
+	Warp              = "Warp Messaging"
+)
+
+// builtinPrecompiles are the precompiles of subnet-evm that the CLI can configure
+var builtinPrecompiles = []string{NativeMint, ContractAllowList, TxAllowList, FeeManager, RewardManager, Warp}
+
+const (
+	addPrecompilePrompt           = "Advanced: Would you like to add a custom precompile to modify the EVM?"
+	addMorePrecompilesPrompt      = "Would you like to add additional precompiles?"
+	choosePrecompilePrompt        = "Choose precompile"
+	rewardManagerAdminsPrompt     = "Configure reward manager admins"
+	rewardManagerEnabledPrompt    = "Configure reward manager enabled addresses"
+	burnFeesPrompt                = "Should fees be burnt?"
+	allowFeeRecipientsPrompt      = "Allow block producers to claim fees?"
+	rewardAddressPrompt           = "Provide the address to which fees will be sent to"
+	contractDeployerAdminsPrompt  = "Configure contract deployment admin allow list"
+	contractDeployerEnabledPrompt = "Configure contract deployment enabled addresses list"
+	txAllowListAdminsPrompt       = "Configure transaction allow list admin addresses"
+	txAllowListEnabledPrompt      = "Configure transaction allow list enabled addresses"
+	nativeMinterAdminsPrompt      = "Configure native minting allow list"
+	nativeMinterEnabledPrompt     = "Configure native minting enabled addresses"
+	feeManagerAdminsPrompt        = "Configure fee manager allow list"
+	feeManagerEnabledPrompt       = "Configure fee manager enabled addresses"
+	warpDefaultQuorumPrompt       = "Use the default quorum of %d%% of the stake to verify Warp messages?"
+	warpQuorumPrompt              = "Enter the percentage of the stake required to verify Warp messages"
 )
 
 func PrecompileToUpgradeString(p Precompile) string {
@@ -44,15 +71,30 @@ func PrecompileToUpgradeString(p Precompile) string {
 		return "feeManagerConfig"
 	case RewardManager:
 		return "rewardManagerConfig"
+	case Warp:
+		return warp.ConfigKey
 	default:
+		if descriptor, ok := GetCustomPrecompileDescriptorByName(string(p)); ok {
+			return descriptor.ConfigKey
+		}
 		return ""
 	}
 }
 
+// GetPrecompileNames returns the names of the precompiles that can be configured: the
+// built-in ones, and the ones registered by descriptors
+func GetPrecompileNames() []string {
+	names := append([]string{}, builtinPrecompiles...)
+	for _, descriptor := range GetCustomPrecompileDescriptors() {
+		names = append(names, descriptor.Name)
+	}
+	return names
+}
+
 func configureRewardManager(app *application.Odyssey) (rewardmanager.Config, bool, error) {
 	config := rewardmanager.Config{}
-	adminPrompt := "Configure reward manager admins"
-	enabledPrompt := "Configure reward manager enabled addresses"
+	adminPrompt := rewardManagerAdminsPrompt
+	enabledPrompt := rewardManagerEnabledPrompt
 	info := "\nThis precompile allows to configure the fee reward mechanism " +
 		"on your subnet, including burning or sending fees.\n\n"
 
@@ -79,7 +121,7 @@ func configureRewardManager(app *application.Odyssey) (rewardmanager.Config, boo
 func ConfigureInitialRewardConfig(app *application.Odyssey) (*rewardmanager.InitialRewardConfig, error) {
 	config := &rewardmanager.InitialRewardConfig{}
 
-	burnPrompt := "Should fees be burnt?"
+	burnPrompt := burnFeesPrompt
 	burnFees, err := app.Prompt.CaptureYesNo(burnPrompt)
 	if err != nil {
 		return config, err
@@ -88,7 +130,7 @@ func ConfigureInitialRewardConfig(app *application.Odyssey) (*rewardmanager.Init
 		return config, nil
 	}
 
-	feeRcpdPrompt := "Allow block producers to claim fees?"
+	feeRcpdPrompt := allowFeeRecipientsPrompt
 	allowFeeRecipients, err := app.Prompt.CaptureYesNo(feeRcpdPrompt)
 	if err != nil {
 		return config, err
@@ -98,7 +140,7 @@ func ConfigureInitialRewardConfig(app *application.Odyssey) (*rewardmanager.Init
 		return config, nil
 	}
 
-	rewardPrompt := "Provide the address to which fees will be sent to"
+	rewardPrompt := rewardAddressPrompt
 	rewardAddress, err := app.Prompt.CaptureAddress(rewardPrompt)
 	if err != nil {
 		return config, err
@@ -122,8 +164,8 @@ func getAddressList(initialPrompt string, info string, app *application.Odyssey)
 
 func configureContractAllowList(app *application.Odyssey) (deployerallowlist.Config, bool, error) {
 	config := deployerallowlist.Config{}
-	adminPrompt := "Configure contract deployment admin allow list"
-	enabledPrompt := "Configure contract deployment enabled addresses list"
+	adminPrompt := contractDeployerAdminsPrompt
+	enabledPrompt := contractDeployerEnabledPrompt
 	info := "\nThis precompile restricts who has the ability to deploy contracts " +
 		"on your subnet.\n\n"
 
@@ -145,8 +187,8 @@ func configureContractAllowList(app *application.Odyssey) (deployerallowlist.Con
 
 func configureTransactionAllowList(app *application.Odyssey) (txallowlist.Config, bool, error) {
 	config := txallowlist.Config{}
-	adminPrompt := "Configure transaction allow list admin addresses"
-	enabledPrompt := "Configure transaction allow list enabled addresses"
+	adminPrompt := txAllowListAdminsPrompt
+	enabledPrompt := txAllowListEnabledPrompt
 	info := "\nThis precompile restricts who has the ability to issue transactions " +
 		"on your subnet.\n\n"
 
@@ -189,8 +231,8 @@ func getAdminAndEnabledAddresses(adminPrompt, enabledPrompt, info string, app *a
 
 func configureMinterList(app *application.Odyssey) (nativeminter.Config, bool, error) {
 	config := nativeminter.Config{}
-	adminPrompt := "Configure native minting allow list"
-	enabledPrompt := "Configure native minting enabled addresses"
+	adminPrompt := nativeMinterAdminsPrompt
+	enabledPrompt := nativeMinterEnabledPrompt
 	info := "\nThis precompile allows admins to permit designated contracts to mint the native token " +
 		"on your subnet.\n\n"
 
@@ -211,8 +253,8 @@ func configureMinterList(app *application.Odyssey) (nativeminter.Config, bool, e
 
 func configureFeeConfigAllowList(app *application.Odyssey) (feemanager.Config, bool, error) {
 	config := feemanager.Config{}
-	adminPrompt := "Configure fee manager allow list"
-	enabledPrompt := "Configure native minting enabled addresses"
+	adminPrompt := feeManagerAdminsPrompt
+	enabledPrompt := feeManagerEnabledPrompt
 	info := "\nThis precompile allows admins to adjust chain gas and fee parameters without " +
 		"performing a hardfork.\n\n"
 
@@ -232,6 +274,36 @@ func configureFeeConfigAllowList(app *application.Odyssey) (feemanager.Config, b
 	return config, cancelled, nil
 }
 
+// ConfigureWarp prompts the quorum required to verify Warp messages, and returns the
+// config of the Warp precompile enabled at [blockTimestamp]
+func ConfigureWarp(app *application.Odyssey, blockTimestamp uint64) (*warp.Config, error) {
+	ux.Logger.PrintToUser("\nThis precompile allows the subnet to send and verify messages signed by " +
+		"the validators of other subnets.\n")
+	useDefault, err := app.Prompt.CaptureYesNo(fmt.Sprintf(warpDefaultQuorumPrompt, params.WarpDefaultQuorumNumerator))
+	if err != nil {
+		return nil, err
+	}
+	if useDefault {
+		return warp.NewDefaultConfig(utils.NewUint64(blockTimestamp)), nil
+	}
+	quorum, err := app.Prompt.CaptureUint64Compare(warpQuorumPrompt, []prompts.Comparator{
+		{
+			Label: "minimum quorum",
+			Type:  prompts.MoreThanEq,
+			Value: params.WarpQuorumNumeratorMinimum,
+		},
+		{
+			Label: "quorum denominator",
+			Type:  prompts.LessThanEq,
+			Value: params.WarpQuorumDenominator,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return warp.NewConfig(utils.NewUint64(blockTimestamp), quorum), nil
+}
+
 func removePrecompile(arr []string, s string) ([]string, error) {
 	for i, val := range arr {
 		if val == s {
@@ -254,11 +326,11 @@ func getPrecompiles(config params.ChainConfig, app *application.Odyssey, useDefa
 
 	first := true
 
-	remainingPrecompiles := []string{NativeMint, ContractAllowList, TxAllowList, FeeManager, RewardManager, cancel}
+	remainingPrecompiles := append(GetPrecompileNames(), cancel)
 
 	for {
-		firstStr := "Advanced: Would you like to add a custom precompile to modify the EVM?"
-		secondStr := "Would you like to add additional precompiles?"
+		firstStr := addPrecompilePrompt
+		secondStr := addMorePrecompilesPrompt
 
 		var promptStr string
 		if promptStr = secondStr; first {
@@ -279,7 +351,7 @@ func getPrecompiles(config params.ChainConfig, app *application.Odyssey, useDefa
 		}
 
 		precompileDecision, err := app.Prompt.CaptureList(
-			"Choose precompile",
+			choosePrecompilePrompt,
 			remainingPrecompiles,
 		)
 		if err != nil {
@@ -347,9 +419,36 @@ func getPrecompiles(config params.ChainConfig, app *application.Odyssey, useDefa
 					return config, statemachine.Stop, err
 				}
 			}
+		case Warp:
+			warpConfig, err := ConfigureWarp(app, 0)
+			if err != nil {
+				return config, statemachine.Stop, err
+			}
+			config.GenesisPrecompiles[warp.ConfigKey] = warpConfig
+			remainingPrecompiles, err = removePrecompile(remainingPrecompiles, Warp)
+			if err != nil {
+				return config, statemachine.Stop, err
+			}
 
 		case cancel:
 			return config, statemachine.Forward, nil
+
+		default:
+			descriptor, ok := GetCustomPrecompileDescriptorByName(precompileDecision)
+			if !ok {
+				return config, statemachine.Stop, fmt.Errorf("unexpected precompile %q", precompileDecision)
+			}
+			customConfig, cancelled, err := ConfigureCustomPrecompile(app, descriptor.ConfigKey, 0)
+			if err != nil {
+				return config, statemachine.Stop, err
+			}
+			if !cancelled {
+				config.GenesisPrecompiles[descriptor.ConfigKey] = customConfig
+				remainingPrecompiles, err = removePrecompile(remainingPrecompiles, precompileDecision)
+				if err != nil {
+					return config, statemachine.Stop, err
+				}
+			}
 		}
 
 		// When all precompiles have been added, the len of remainingPrecompiles will be 1